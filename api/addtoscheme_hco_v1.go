@@ -0,0 +1,10 @@
+package api
+
+import (
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+)
+
+func init() {
+	// Register the types with the Scheme so the components can map objects to GroupVersionKinds and back
+	AddToSchemes = append(AddToSchemes, hcov1.SchemeBuilder.AddToScheme)
+}
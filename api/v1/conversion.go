@@ -0,0 +1,600 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+// DeprecatedFieldsAnnotation holds the values of the v1beta1 fields that were removed from the v1 API. HCO uses it to
+// restore these values when converting back to v1beta1.
+const DeprecatedFieldsAnnotation = "hco.kubevirt.io/v1beta1-deprecated-fields"
+
+// deprecatedFields is the content of the DeprecatedFieldsAnnotation annotation. The feature gates are only kept if
+// they are different from their default values.
+type deprecatedFields struct {
+	LocalStorageClassName     string           `json:"localStorageClassName,omitempty"`
+	VddkInitImage             *string          `json:"vddkInitImage,omitempty"`
+	TektonPipelinesNamespace  *string          `json:"tektonPipelinesNamespace,omitempty"`
+	TektonTasksNamespace      *string          `json:"tektonTasksNamespace,omitempty"`
+	DeployTektonTaskResources *bool            `json:"deployTektonTaskResources,omitempty"`
+	NonRoot                   *bool            `json:"nonRoot,omitempty"`
+	EnableManagedTenantQuota  *bool            `json:"enableManagedTenantQuota,omitempty"`
+	MediatedDevicesTypes      []string         `json:"mediatedDevicesTypes,omitempty"`
+	NodeMediatedDevicesTypes  map[int][]string `json:"nodeMediatedDevicesTypes,omitempty"`
+}
+
+func (df deprecatedFields) isEmpty() bool {
+	return df.LocalStorageClassName == "" &&
+		df.VddkInitImage == nil &&
+		df.TektonPipelinesNamespace == nil &&
+		df.TektonTasksNamespace == nil &&
+		df.DeployTektonTaskResources == nil &&
+		df.NonRoot == nil &&
+		df.EnableManagedTenantQuota == nil &&
+		len(df.MediatedDevicesTypes) == 0 &&
+		len(df.NodeMediatedDevicesTypes) == 0
+}
+
+// ConvertTo converts this HyperConverged to the Hub version (v1beta1).
+func (src *HyperConverged) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1beta1.HyperConverged)
+	if !ok {
+		return fmt.Errorf("unexpected hub type %T", dstRaw)
+	}
+
+	dst.TypeMeta = src.TypeMeta
+	dst.APIVersion = v1beta1.SchemeGroupVersion.String()
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+
+	var df deprecatedFields
+	if value, exists := dst.Annotations[DeprecatedFieldsAnnotation]; exists {
+		// a broken annotation must not block reading the object; the deprecated fields are just not restored
+		_ = json.Unmarshal([]byte(value), &df)
+		delete(dst.Annotations, DeprecatedFieldsAnnotation)
+		if len(dst.Annotations) == 0 {
+			dst.Annotations = nil
+		}
+	}
+
+	convertSpecToHub(&src.Spec, &dst.Spec, df)
+	convertStatusToHub(&src.Status, &dst.Status)
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
+func (dst *HyperConverged) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1beta1.HyperConverged)
+	if !ok {
+		return fmt.Errorf("unexpected hub type %T", srcRaw)
+	}
+
+	dst.TypeMeta = src.TypeMeta
+	dst.APIVersion = SchemeGroupVersion.String()
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+
+	df := convertSpecFromHub(&src.Spec, &dst.Spec)
+	convertStatusFromHub(&src.Status, &dst.Status)
+
+	delete(dst.Annotations, DeprecatedFieldsAnnotation)
+	if !df.isEmpty() {
+		dfBytes, err := json.Marshal(df)
+		if err != nil {
+			return err
+		}
+
+		if dst.Annotations == nil {
+			dst.Annotations = make(map[string]string)
+		}
+		dst.Annotations[DeprecatedFieldsAnnotation] = string(dfBytes)
+	} else if len(dst.Annotations) == 0 {
+		dst.Annotations = nil
+	}
+
+	return nil
+}
+
+func convertSpecToHub(src *HyperConvergedSpec, dst *v1beta1.HyperConvergedSpec, df deprecatedFields) {
+	dst.LocalStorageClassName = df.LocalStorageClassName //nolint SA1019
+	dst.TuningPolicy = v1beta1.HyperConvergedTuningPolicy(src.TuningPolicy)
+	dst.Infra = v1beta1.HyperConvergedConfig(src.Infra)
+	dst.Workloads = v1beta1.HyperConvergedConfig(src.Workloads)
+	dst.FeatureGates = convertFeatureGatesToHub(src.FeatureGates, df)
+	dst.LiveMigrationConfig = v1beta1.LiveMigrationConfigurations(src.LiveMigrationConfig)
+	dst.PermittedHostDevices = convertPermittedHostDevicesToHub(src.PermittedHostDevices)
+	dst.MediatedDevicesConfiguration = convertMediatedDevicesConfigurationToHub(src.MediatedDevicesConfiguration, df)
+	dst.CertConfig = v1beta1.HyperConvergedCertConfig{
+		CA:     v1beta1.CertRotateConfigCA(src.CertConfig.CA),
+		Server: v1beta1.CertRotateConfigServer(src.CertConfig.Server),
+	}
+	dst.ResourceRequirements = (*v1beta1.OperandResourceRequirements)(src.ResourceRequirements)
+	dst.ScratchSpaceStorageClass = src.Storage.ScratchSpaceStorageClass
+	dst.VddkInitImage = df.VddkInitImage //nolint SA1019
+	dst.DefaultCPUModel = src.DefaultCPUModel
+	dst.DefaultRuntimeClass = src.DefaultRuntimeClass
+	dst.ObsoleteCPUs = (*v1beta1.HyperConvergedObsoleteCPUs)(src.ObsoleteCPUs)
+	dst.CommonTemplatesNamespace = src.CommonTemplatesNamespace
+	dst.StorageImport = (*v1beta1.StorageImportConfig)(src.Storage.Import)
+	dst.WorkloadUpdateStrategy = v1beta1.HyperConvergedWorkloadUpdateStrategy(src.WorkloadUpdateStrategy)
+	dst.DataImportCronTemplates = convertSlice(src.DataImportCronTemplates, func(in DataImportCronTemplate) v1beta1.DataImportCronTemplate {
+		return v1beta1.DataImportCronTemplate(in)
+	})
+	dst.FilesystemOverhead = src.Storage.FilesystemOverhead
+	dst.UninstallStrategy = v1beta1.HyperConvergedUninstallStrategy(src.UninstallStrategy)
+	dst.LogVerbosityConfig = (*v1beta1.LogVerbosityConfiguration)(src.LogVerbosityConfig)
+	dst.TLSSecurityProfile = src.TLSSecurityProfile
+	dst.TektonPipelinesNamespace = df.TektonPipelinesNamespace //nolint SA1019
+	dst.TektonTasksNamespace = df.TektonTasksNamespace         //nolint SA1019
+	dst.KubeSecondaryDNSNameServerIP = src.Networking.KubeSecondaryDNSNameServerIP
+	dst.EvictionStrategy = src.EvictionStrategy
+	dst.VMStateStorageClass = src.Storage.VMStateStorageClass
+	dst.VirtualMachineOptions = (*v1beta1.VirtualMachineOptions)(src.VirtualMachineOptions)
+	dst.CommonBootImageNamespace = src.CommonBootImageNamespace
+	dst.KSMConfiguration = src.KSMConfiguration
+	dst.NetworkBinding = src.Networking.NetworkBinding
+	dst.ApplicationAwareConfig = (*v1beta1.ApplicationAwareConfigurations)(src.ApplicationAwareConfig)
+	dst.HostPathProvisioner = convertHostPathProvisionerToHub(src.HostPathProvisioner)
+	dst.HigherWorkloadDensity = (*v1beta1.HigherWorkloadDensityConfiguration)(src.HigherWorkloadDensity)
+	dst.OperandOverrides = convertOperandOverridesToHub(src.OperandOverrides)
+	dst.ReconcilePolicy = convertReconcilePolicyToHub(src.ReconcilePolicy)
+	dst.AlertSilences = convertAlertSilencesToHub(src.AlertSilences)
+}
+
+func convertSpecFromHub(src *v1beta1.HyperConvergedSpec, dst *HyperConvergedSpec) deprecatedFields {
+	df := deprecatedFields{
+		LocalStorageClassName:    src.LocalStorageClassName,    //nolint SA1019
+		VddkInitImage:            src.VddkInitImage,            //nolint SA1019
+		TektonPipelinesNamespace: src.TektonPipelinesNamespace, //nolint SA1019
+		TektonTasksNamespace:     src.TektonTasksNamespace,     //nolint SA1019
+	}
+
+	dst.TuningPolicy = HyperConvergedTuningPolicy(src.TuningPolicy)
+	dst.Infra = HyperConvergedConfig(src.Infra)
+	dst.Workloads = HyperConvergedConfig(src.Workloads)
+	dst.FeatureGates = convertFeatureGatesFromHub(src.FeatureGates, &df)
+	dst.LiveMigrationConfig = LiveMigrationConfigurations(src.LiveMigrationConfig)
+	dst.PermittedHostDevices = convertPermittedHostDevicesFromHub(src.PermittedHostDevices)
+	dst.MediatedDevicesConfiguration = convertMediatedDevicesConfigurationFromHub(src.MediatedDevicesConfiguration, &df)
+	dst.CertConfig = HyperConvergedCertConfig{
+		CA:     CertRotateConfigCA(src.CertConfig.CA),
+		Server: CertRotateConfigServer(src.CertConfig.Server),
+	}
+	dst.ResourceRequirements = (*OperandResourceRequirements)(src.ResourceRequirements)
+	dst.DefaultCPUModel = src.DefaultCPUModel
+	dst.DefaultRuntimeClass = src.DefaultRuntimeClass
+	dst.ObsoleteCPUs = (*HyperConvergedObsoleteCPUs)(src.ObsoleteCPUs)
+	dst.CommonTemplatesNamespace = src.CommonTemplatesNamespace
+	dst.WorkloadUpdateStrategy = HyperConvergedWorkloadUpdateStrategy(src.WorkloadUpdateStrategy)
+	dst.DataImportCronTemplates = convertSlice(src.DataImportCronTemplates, func(in v1beta1.DataImportCronTemplate) DataImportCronTemplate {
+		return DataImportCronTemplate(in)
+	})
+	dst.UninstallStrategy = HyperConvergedUninstallStrategy(src.UninstallStrategy)
+	dst.LogVerbosityConfig = (*LogVerbosityConfiguration)(src.LogVerbosityConfig)
+	dst.TLSSecurityProfile = src.TLSSecurityProfile
+	dst.EvictionStrategy = src.EvictionStrategy
+	dst.VirtualMachineOptions = (*VirtualMachineOptions)(src.VirtualMachineOptions)
+	dst.CommonBootImageNamespace = src.CommonBootImageNamespace
+	dst.KSMConfiguration = src.KSMConfiguration
+	dst.ApplicationAwareConfig = (*ApplicationAwareConfigurations)(src.ApplicationAwareConfig)
+	dst.HostPathProvisioner = convertHostPathProvisionerFromHub(src.HostPathProvisioner)
+	dst.HigherWorkloadDensity = (*HigherWorkloadDensityConfiguration)(src.HigherWorkloadDensity)
+	dst.OperandOverrides = convertOperandOverridesFromHub(src.OperandOverrides)
+	dst.ReconcilePolicy = convertReconcilePolicyFromHub(src.ReconcilePolicy)
+	dst.AlertSilences = convertAlertSilencesFromHub(src.AlertSilences)
+
+	dst.Storage = StorageConfig{
+		ScratchSpaceStorageClass: src.ScratchSpaceStorageClass,
+		VMStateStorageClass:      src.VMStateStorageClass,
+		FilesystemOverhead:       src.FilesystemOverhead,
+		Import:                   (*StorageImportConfig)(src.StorageImport),
+	}
+
+	dst.Networking = NetworkingConfig{
+		KubeSecondaryDNSNameServerIP: src.KubeSecondaryDNSNameServerIP,
+		NetworkBinding:               src.NetworkBinding,
+	}
+
+	return df
+}
+
+// The removed feature gates are restored to their default values, unless a different value was kept in the
+// DeprecatedFieldsAnnotation annotation.
+func convertFeatureGatesToHub(src HyperConvergedFeatureGates, df deprecatedFields) v1beta1.HyperConvergedFeatureGates {
+	return v1beta1.HyperConvergedFeatureGates{
+		DownwardMetrics:                  src.DownwardMetrics,
+		WithHostPassthroughCPU:           src.WithHostPassthroughCPU,
+		EnableCommonBootImageImport:      src.EnableCommonBootImageImport,
+		DeployTektonTaskResources:        ptr.To(ptr.Deref(df.DeployTektonTaskResources, false)),
+		DeployVMConsoleProxy:             src.DeployVMConsoleProxy,
+		DeployKubeSecondaryDNS:           src.DeployKubeSecondaryDNS,
+		DeployKubevirtIpamController:     src.DeployKubevirtIpamController,
+		NonRoot:                          ptr.To(ptr.Deref(df.NonRoot, true)),
+		DisableMDevConfiguration:         src.DisableMDevConfiguration,
+		PersistentReservation:            src.PersistentReservation,
+		EnableManagedTenantQuota:         ptr.To(ptr.Deref(df.EnableManagedTenantQuota, false)),
+		AutoResourceLimits:               src.AutoResourceLimits,
+		AlignCPUs:                        src.AlignCPUs,
+		EnableApplicationAwareQuota:      src.EnableApplicationAwareQuota,
+		PrimaryUserDefinedNetworkBinding: src.PrimaryUserDefinedNetworkBinding,
+		EnableHostPathProvisioner:        src.EnableHostPathProvisioner,
+	}
+}
+
+func convertFeatureGatesFromHub(src v1beta1.HyperConvergedFeatureGates, df *deprecatedFields) HyperConvergedFeatureGates {
+	if src.DeployTektonTaskResources != nil && *src.DeployTektonTaskResources { //nolint SA1019
+		df.DeployTektonTaskResources = ptr.To(true)
+	}
+
+	if src.NonRoot != nil && !*src.NonRoot { //nolint SA1019
+		df.NonRoot = ptr.To(false)
+	}
+
+	if src.EnableManagedTenantQuota != nil && *src.EnableManagedTenantQuota { //nolint SA1019
+		df.EnableManagedTenantQuota = ptr.To(true)
+	}
+
+	return HyperConvergedFeatureGates{
+		DownwardMetrics:                  src.DownwardMetrics,
+		WithHostPassthroughCPU:           src.WithHostPassthroughCPU,
+		EnableCommonBootImageImport:      src.EnableCommonBootImageImport,
+		DeployVMConsoleProxy:             src.DeployVMConsoleProxy,
+		DeployKubeSecondaryDNS:           src.DeployKubeSecondaryDNS,
+		DeployKubevirtIpamController:     src.DeployKubevirtIpamController,
+		DisableMDevConfiguration:         src.DisableMDevConfiguration,
+		PersistentReservation:            src.PersistentReservation,
+		AutoResourceLimits:               src.AutoResourceLimits,
+		AlignCPUs:                        src.AlignCPUs,
+		EnableApplicationAwareQuota:      src.EnableApplicationAwareQuota,
+		PrimaryUserDefinedNetworkBinding: src.PrimaryUserDefinedNetworkBinding,
+		EnableHostPathProvisioner:        src.EnableHostPathProvisioner,
+	}
+}
+
+func convertPermittedHostDevicesToHub(src *PermittedHostDevices) *v1beta1.PermittedHostDevices {
+	if src == nil {
+		return nil
+	}
+
+	return &v1beta1.PermittedHostDevices{
+		PciHostDevices: convertSlice(src.PciHostDevices, func(in PciHostDevice) v1beta1.PciHostDevice {
+			return v1beta1.PciHostDevice(in)
+		}),
+		USBHostDevices: convertSlice(src.USBHostDevices, func(in USBHostDevice) v1beta1.USBHostDevice {
+			return v1beta1.USBHostDevice{
+				ResourceName: in.ResourceName,
+				Selectors: convertSlice(in.Selectors, func(sel USBSelector) v1beta1.USBSelector {
+					return v1beta1.USBSelector(sel)
+				}),
+				ExternalResourceProvider: in.ExternalResourceProvider,
+				Disabled:                 in.Disabled,
+			}
+		}),
+		MediatedDevices: convertSlice(src.MediatedDevices, func(in MediatedHostDevice) v1beta1.MediatedHostDevice {
+			return v1beta1.MediatedHostDevice(in)
+		}),
+	}
+}
+
+func convertPermittedHostDevicesFromHub(src *v1beta1.PermittedHostDevices) *PermittedHostDevices {
+	if src == nil {
+		return nil
+	}
+
+	return &PermittedHostDevices{
+		PciHostDevices: convertSlice(src.PciHostDevices, func(in v1beta1.PciHostDevice) PciHostDevice {
+			return PciHostDevice(in)
+		}),
+		USBHostDevices: convertSlice(src.USBHostDevices, func(in v1beta1.USBHostDevice) USBHostDevice {
+			return USBHostDevice{
+				ResourceName: in.ResourceName,
+				Selectors: convertSlice(in.Selectors, func(sel v1beta1.USBSelector) USBSelector {
+					return USBSelector(sel)
+				}),
+				ExternalResourceProvider: in.ExternalResourceProvider,
+				Disabled:                 in.Disabled,
+			}
+		}),
+		MediatedDevices: convertSlice(src.MediatedDevices, func(in v1beta1.MediatedHostDevice) MediatedHostDevice {
+			return MediatedHostDevice(in)
+		}),
+	}
+}
+
+func convertMediatedDevicesConfigurationToHub(src *MediatedDevicesConfiguration, df deprecatedFields) *v1beta1.MediatedDevicesConfiguration {
+	if src == nil {
+		return nil
+	}
+
+	dst := &v1beta1.MediatedDevicesConfiguration{
+		MediatedDeviceTypes:  src.MediatedDeviceTypes,
+		MediatedDevicesTypes: df.MediatedDevicesTypes, //nolint SA1019
+	}
+
+	if src.NodeMediatedDeviceTypes != nil {
+		dst.NodeMediatedDeviceTypes = make([]v1beta1.NodeMediatedDeviceTypesConfig, len(src.NodeMediatedDeviceTypes))
+		for i, nodeConf := range src.NodeMediatedDeviceTypes {
+			dst.NodeMediatedDeviceTypes[i] = v1beta1.NodeMediatedDeviceTypesConfig{
+				NodeSelector:         nodeConf.NodeSelector,
+				MediatedDeviceTypes:  nodeConf.MediatedDeviceTypes,
+				MediatedDevicesTypes: df.NodeMediatedDevicesTypes[i], //nolint SA1019
+			}
+		}
+	}
+
+	return dst
+}
+
+func convertMediatedDevicesConfigurationFromHub(src *v1beta1.MediatedDevicesConfiguration, df *deprecatedFields) *MediatedDevicesConfiguration {
+	if src == nil {
+		return nil
+	}
+
+	df.MediatedDevicesTypes = src.MediatedDevicesTypes //nolint SA1019
+
+	dst := &MediatedDevicesConfiguration{
+		MediatedDeviceTypes: src.MediatedDeviceTypes,
+	}
+
+	if src.NodeMediatedDeviceTypes != nil {
+		dst.NodeMediatedDeviceTypes = make([]NodeMediatedDeviceTypesConfig, len(src.NodeMediatedDeviceTypes))
+		for i, nodeConf := range src.NodeMediatedDeviceTypes {
+			if len(nodeConf.MediatedDevicesTypes) > 0 { //nolint SA1019
+				if df.NodeMediatedDevicesTypes == nil {
+					df.NodeMediatedDevicesTypes = make(map[int][]string)
+				}
+				df.NodeMediatedDevicesTypes[i] = nodeConf.MediatedDevicesTypes //nolint SA1019
+			}
+
+			dst.NodeMediatedDeviceTypes[i] = NodeMediatedDeviceTypesConfig{
+				NodeSelector:        nodeConf.NodeSelector,
+				MediatedDeviceTypes: nodeConf.MediatedDeviceTypes,
+			}
+		}
+	}
+
+	return dst
+}
+
+func convertOperandOverridesToHub(src *OperandOverrides) *v1beta1.OperandOverrides {
+	if src == nil {
+		return nil
+	}
+
+	convert := func(in *OperandOverride) *v1beta1.OperandOverride {
+		if in == nil {
+			return nil
+		}
+		return &v1beta1.OperandOverride{
+			Patches: convertSlice(in.Patches, func(p OperandPatch) v1beta1.OperandPatch {
+				return v1beta1.OperandPatch(p)
+			}),
+		}
+	}
+
+	return &v1beta1.OperandOverrides{
+		KubeVirt:            convert(src.KubeVirt),
+		CDI:                 convert(src.CDI),
+		NetworkAddonsConfig: convert(src.NetworkAddonsConfig),
+		SSP:                 convert(src.SSP),
+		AAQ:                 convert(src.AAQ),
+		HostPathProvisioner: convert(src.HostPathProvisioner),
+	}
+}
+
+func convertOperandOverridesFromHub(src *v1beta1.OperandOverrides) *OperandOverrides {
+	if src == nil {
+		return nil
+	}
+
+	convert := func(in *v1beta1.OperandOverride) *OperandOverride {
+		if in == nil {
+			return nil
+		}
+		return &OperandOverride{
+			Patches: convertSlice(in.Patches, func(p v1beta1.OperandPatch) OperandPatch {
+				return OperandPatch(p)
+			}),
+		}
+	}
+
+	return &OperandOverrides{
+		KubeVirt:            convert(src.KubeVirt),
+		CDI:                 convert(src.CDI),
+		NetworkAddonsConfig: convert(src.NetworkAddonsConfig),
+		SSP:                 convert(src.SSP),
+		AAQ:                 convert(src.AAQ),
+		HostPathProvisioner: convert(src.HostPathProvisioner),
+	}
+}
+
+func convertHostPathProvisionerToHub(src *HostPathProvisionerConfig) *v1beta1.HostPathProvisionerConfig {
+	if src == nil {
+		return nil
+	}
+
+	return &v1beta1.HostPathProvisionerConfig{
+		StoragePools: convertSlice(src.StoragePools, func(in HostPathStoragePool) v1beta1.HostPathStoragePool {
+			return v1beta1.HostPathStoragePool(in)
+		}),
+		NodePlacement: src.NodePlacement,
+	}
+}
+
+func convertHostPathProvisionerFromHub(src *v1beta1.HostPathProvisionerConfig) *HostPathProvisionerConfig {
+	if src == nil {
+		return nil
+	}
+
+	return &HostPathProvisionerConfig{
+		StoragePools: convertSlice(src.StoragePools, func(in v1beta1.HostPathStoragePool) HostPathStoragePool {
+			return HostPathStoragePool(in)
+		}),
+		NodePlacement: src.NodePlacement,
+	}
+}
+
+func convertReconcilePolicyToHub(src *ReconcilePolicy) *v1beta1.ReconcilePolicy {
+	if src == nil {
+		return nil
+	}
+
+	dst := &v1beta1.ReconcilePolicy{
+		Paused:           src.Paused,
+		OutOfBandChanges: v1beta1.OutOfBandChangesPolicy(src.OutOfBandChanges),
+	}
+
+	if src.Operands != nil {
+		dst.Operands = &v1beta1.OperandManagementStates{
+			KubeVirt:            v1beta1.ManagementState(src.Operands.KubeVirt),
+			CDI:                 v1beta1.ManagementState(src.Operands.CDI),
+			NetworkAddonsConfig: v1beta1.ManagementState(src.Operands.NetworkAddonsConfig),
+			SSP:                 v1beta1.ManagementState(src.Operands.SSP),
+			AAQ:                 v1beta1.ManagementState(src.Operands.AAQ),
+			HostPathProvisioner: v1beta1.ManagementState(src.Operands.HostPathProvisioner),
+		}
+	}
+
+	return dst
+}
+
+func convertReconcilePolicyFromHub(src *v1beta1.ReconcilePolicy) *ReconcilePolicy {
+	if src == nil {
+		return nil
+	}
+
+	dst := &ReconcilePolicy{
+		Paused:           src.Paused,
+		OutOfBandChanges: OutOfBandChangesPolicy(src.OutOfBandChanges),
+	}
+
+	if src.Operands != nil {
+		dst.Operands = &OperandManagementStates{
+			KubeVirt:            ManagementState(src.Operands.KubeVirt),
+			CDI:                 ManagementState(src.Operands.CDI),
+			NetworkAddonsConfig: ManagementState(src.Operands.NetworkAddonsConfig),
+			SSP:                 ManagementState(src.Operands.SSP),
+			AAQ:                 ManagementState(src.Operands.AAQ),
+			HostPathProvisioner: ManagementState(src.Operands.HostPathProvisioner),
+		}
+	}
+
+	return dst
+}
+
+func convertAlertSilencesToHub(src *AlertSilencesConfig) *v1beta1.AlertSilencesConfig {
+	if src == nil {
+		return nil
+	}
+
+	return &v1beta1.AlertSilencesConfig{
+		DisableBuiltInSilences: src.DisableBuiltInSilences,
+		Silences: convertSlice(src.Silences, func(in AlertSilence) v1beta1.AlertSilence {
+			return v1beta1.AlertSilence{
+				Name: in.Name,
+				Matchers: convertSlice(in.Matchers, func(in AlertSilenceMatcher) v1beta1.AlertSilenceMatcher {
+					return v1beta1.AlertSilenceMatcher(in)
+				}),
+				Comment:  in.Comment,
+				Duration: in.Duration,
+			}
+		}),
+	}
+}
+
+func convertAlertSilencesFromHub(src *v1beta1.AlertSilencesConfig) *AlertSilencesConfig {
+	if src == nil {
+		return nil
+	}
+
+	return &AlertSilencesConfig{
+		DisableBuiltInSilences: src.DisableBuiltInSilences,
+		Silences: convertSlice(src.Silences, func(in v1beta1.AlertSilence) AlertSilence {
+			return AlertSilence{
+				Name: in.Name,
+				Matchers: convertSlice(in.Matchers, func(in v1beta1.AlertSilenceMatcher) AlertSilenceMatcher {
+					return AlertSilenceMatcher(in)
+				}),
+				Comment:  in.Comment,
+				Duration: in.Duration,
+			}
+		}),
+	}
+}
+
+func convertStatusToHub(src *HyperConvergedStatus, dst *v1beta1.HyperConvergedStatus) {
+	dst.Conditions = src.Conditions
+	dst.RelatedObjects = src.RelatedObjects
+	dst.Versions = convertSlice(src.Versions, func(in Version) v1beta1.Version {
+		return v1beta1.Version(in)
+	})
+	dst.ObservedGeneration = src.ObservedGeneration
+	dst.DataImportSchedule = src.DataImportSchedule
+	dst.DataImportCronTemplates = convertSlice(src.DataImportCronTemplates, func(in DataImportCronTemplateStatus) v1beta1.DataImportCronTemplateStatus {
+		return v1beta1.DataImportCronTemplateStatus{
+			DataImportCronTemplate: v1beta1.DataImportCronTemplate(in.DataImportCronTemplate),
+			Status:                 v1beta1.DataImportCronStatus(in.Status),
+		}
+	})
+	dst.SystemHealthStatus = src.SystemHealthStatus
+	dst.OperandOverrides = convertSlice(src.OperandOverrides, func(in OperandOverrideStatus) v1beta1.OperandOverrideStatus {
+		return v1beta1.OperandOverrideStatus(in)
+	})
+	dst.Components = convertSlice(src.Components, func(in ComponentStatus) v1beta1.ComponentStatus {
+		return v1beta1.ComponentStatus(in)
+	})
+	dst.UpgradePatchHistory = convertSlice(src.UpgradePatchHistory, func(in UpgradePatchRecord) v1beta1.UpgradePatchRecord {
+		return v1beta1.UpgradePatchRecord(in)
+	})
+	dst.UpgradeProgress = (*v1beta1.UpgradeProgress)(src.UpgradeProgress)
+	dst.AlertSilences = convertSlice(src.AlertSilences, func(in AlertSilenceStatus) v1beta1.AlertSilenceStatus {
+		return v1beta1.AlertSilenceStatus(in)
+	})
+}
+
+func convertStatusFromHub(src *v1beta1.HyperConvergedStatus, dst *HyperConvergedStatus) {
+	dst.Conditions = src.Conditions
+	dst.RelatedObjects = src.RelatedObjects
+	dst.Versions = convertSlice(src.Versions, func(in v1beta1.Version) Version {
+		return Version(in)
+	})
+	dst.ObservedGeneration = src.ObservedGeneration
+	dst.DataImportSchedule = src.DataImportSchedule
+	dst.DataImportCronTemplates = convertSlice(src.DataImportCronTemplates, func(in v1beta1.DataImportCronTemplateStatus) DataImportCronTemplateStatus {
+		return DataImportCronTemplateStatus{
+			DataImportCronTemplate: DataImportCronTemplate(in.DataImportCronTemplate),
+			Status:                 DataImportCronStatus(in.Status),
+		}
+	})
+	dst.SystemHealthStatus = src.SystemHealthStatus
+	dst.OperandOverrides = convertSlice(src.OperandOverrides, func(in v1beta1.OperandOverrideStatus) OperandOverrideStatus {
+		return OperandOverrideStatus(in)
+	})
+	dst.Components = convertSlice(src.Components, func(in v1beta1.ComponentStatus) ComponentStatus {
+		return ComponentStatus(in)
+	})
+	dst.UpgradePatchHistory = convertSlice(src.UpgradePatchHistory, func(in v1beta1.UpgradePatchRecord) UpgradePatchRecord {
+		return UpgradePatchRecord(in)
+	})
+	dst.UpgradeProgress = (*UpgradeProgress)(src.UpgradeProgress)
+	dst.AlertSilences = convertSlice(src.AlertSilences, func(in v1beta1.AlertSilenceStatus) AlertSilenceStatus {
+		return AlertSilenceStatus(in)
+	})
+}
+
+func convertSlice[S, D any](src []S, convert func(S) D) []D {
+	if src == nil {
+		return nil
+	}
+
+	dst := make([]D, len(src))
+	for i, item := range src {
+		dst[i] = convert(item)
+	}
+
+	return dst
+}
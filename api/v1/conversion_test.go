@@ -0,0 +1,155 @@
+package v1_test
+
+import (
+	"math/rand"
+	"time"
+
+	fuzz "github.com/google/gofuzz"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+const fuzzIterations = 500
+
+var _ = Describe("HyperConverged conversion", func() {
+	var fuzzer *fuzz.Fuzzer
+
+	BeforeEach(func() {
+		seed := time.Now().UnixNano()
+		GinkgoWriter.Printf("fuzzer seed: %d\n", seed)
+
+		fuzzer = fuzz.New().
+			RandSource(rand.NewSource(seed)).
+			NilChance(0.3).
+			NumElements(0, 3).
+			Funcs(
+				// the CRD always sets defaults for these feature gates, so they are never nil in a stored object
+				func(fg *v1beta1.HyperConvergedFeatureGates, c fuzz.Continue) {
+					c.FuzzNoCustom(fg)
+					fg.DeployTektonTaskResources = ptr.To(c.RandBool()) //nolint SA1019
+					fg.NonRoot = ptr.To(c.RandBool())                   //nolint SA1019
+					fg.EnableManagedTenantQuota = ptr.To(c.RandBool())  //nolint SA1019
+				},
+			)
+	})
+
+	It("should not lose data in a v1beta1 -> v1 -> v1beta1 round trip", func() {
+		for range fuzzIterations {
+			original := &v1beta1.HyperConverged{}
+			fuzzer.Fuzz(original)
+			original.TypeMeta = metav1.TypeMeta{}
+
+			spoke := &hcov1.HyperConverged{}
+			Expect(spoke.ConvertFrom(original.DeepCopy())).To(Succeed())
+
+			hub := &v1beta1.HyperConverged{}
+			Expect(spoke.ConvertTo(hub)).To(Succeed())
+			hub.TypeMeta = metav1.TypeMeta{}
+
+			Expect(apiequality.Semantic.DeepEqual(original, hub)).To(BeTrue(), "round trip mismatch:\noriginal: %+v\nresult:   %+v", original, hub)
+		}
+	})
+
+	It("should not lose data in a v1 -> v1beta1 -> v1 round trip", func() {
+		for range fuzzIterations {
+			original := &hcov1.HyperConverged{}
+			fuzzer.Fuzz(original)
+			original.TypeMeta = metav1.TypeMeta{}
+			delete(original.Annotations, hcov1.DeprecatedFieldsAnnotation)
+
+			hub := &v1beta1.HyperConverged{}
+			Expect(original.DeepCopy().ConvertTo(hub)).To(Succeed())
+
+			spoke := &hcov1.HyperConverged{}
+			Expect(spoke.ConvertFrom(hub)).To(Succeed())
+			spoke.TypeMeta = metav1.TypeMeta{}
+
+			Expect(apiequality.Semantic.DeepEqual(original, spoke)).To(BeTrue(), "round trip mismatch:\noriginal: %+v\nresult:   %+v", original, spoke)
+		}
+	})
+
+	Context("regrouped fields", func() {
+		It("should move the storage and the networking fields", func() {
+			hub := &v1beta1.HyperConverged{
+				Spec: v1beta1.HyperConvergedSpec{
+					ScratchSpaceStorageClass:     ptr.To("scratch"),
+					VMStateStorageClass:          ptr.To("vmstate"),
+					StorageImport:                &v1beta1.StorageImportConfig{InsecureRegistries: []string{"registry"}},
+					KubeSecondaryDNSNameServerIP: ptr.To("1.2.3.4"),
+				},
+			}
+
+			spoke := &hcov1.HyperConverged{}
+			Expect(spoke.ConvertFrom(hub)).To(Succeed())
+
+			Expect(spoke.APIVersion).To(Equal(hcov1.SchemeGroupVersion.String()))
+			Expect(spoke.Spec.Storage.ScratchSpaceStorageClass).To(HaveValue(Equal("scratch")))
+			Expect(spoke.Spec.Storage.VMStateStorageClass).To(HaveValue(Equal("vmstate")))
+			Expect(spoke.Spec.Storage.Import.InsecureRegistries).To(ConsistOf("registry"))
+			Expect(spoke.Spec.Networking.KubeSecondaryDNSNameServerIP).To(HaveValue(Equal("1.2.3.4")))
+			Expect(spoke.Annotations).ToNot(HaveKey(hcov1.DeprecatedFieldsAnnotation))
+		})
+	})
+
+	Context("deprecated fields", func() {
+		It("should keep the deprecated fields in an annotation", func() {
+			hub := &v1beta1.HyperConverged{
+				Spec: v1beta1.HyperConvergedSpec{
+					VddkInitImage: ptr.To("vddk"), //nolint SA1019
+					FeatureGates: v1beta1.HyperConvergedFeatureGates{
+						NonRoot: ptr.To(false), //nolint SA1019
+					},
+					MediatedDevicesConfiguration: &v1beta1.MediatedDevicesConfiguration{
+						MediatedDevicesTypes: []string{"nvidia-222"}, //nolint SA1019
+					},
+				},
+			}
+
+			spoke := &hcov1.HyperConverged{}
+			Expect(spoke.ConvertFrom(hub)).To(Succeed())
+			Expect(spoke.Annotations).To(HaveKeyWithValue(hcov1.DeprecatedFieldsAnnotation,
+				`{"vddkInitImage":"vddk","nonRoot":false,"mediatedDevicesTypes":["nvidia-222"]}`))
+
+			restored := &v1beta1.HyperConverged{}
+			Expect(spoke.ConvertTo(restored)).To(Succeed())
+			Expect(restored.Annotations).To(BeEmpty())
+			Expect(restored.Spec.VddkInitImage).To(HaveValue(Equal("vddk")))                                    //nolint SA1019
+			Expect(restored.Spec.FeatureGates.NonRoot).To(HaveValue(BeFalse()))                                 //nolint SA1019
+			Expect(restored.Spec.MediatedDevicesConfiguration.MediatedDevicesTypes).To(ConsistOf("nvidia-222")) //nolint SA1019
+		})
+
+		It("should set the default values of the removed feature gates", func() {
+			spoke := &hcov1.HyperConverged{}
+
+			hub := &v1beta1.HyperConverged{}
+			Expect(spoke.ConvertTo(hub)).To(Succeed())
+
+			Expect(hub.APIVersion).To(Equal(v1beta1.SchemeGroupVersion.String()))
+			Expect(hub.Spec.FeatureGates.NonRoot).To(HaveValue(BeTrue()))                    //nolint SA1019
+			Expect(hub.Spec.FeatureGates.DeployTektonTaskResources).To(HaveValue(BeFalse())) //nolint SA1019
+			Expect(hub.Spec.FeatureGates.EnableManagedTenantQuota).To(HaveValue(BeFalse()))  //nolint SA1019
+		})
+
+		It("should ignore a broken annotation", func() {
+			spoke := &hcov1.HyperConverged{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						hcov1.DeprecatedFieldsAnnotation: "not a json",
+						"other":                          "value",
+					},
+				},
+			}
+
+			hub := &v1beta1.HyperConverged{}
+			Expect(spoke.ConvertTo(hub)).To(Succeed())
+			Expect(hub.Annotations).To(Equal(map[string]string{"other": "value"}))
+		})
+	})
+})
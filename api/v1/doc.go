@@ -0,0 +1,5 @@
+// package v1 contains API Schema definitions for the hco v1 API group
+// +k8s:deepcopy-gen=package,register
+// +k8s:defaulter-gen=TypeMeta
+// +groupName=hco.kubevirt.io
+package v1
@@ -0,0 +1,1293 @@
+package v1
+
+import (
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	aaqv1alpha1 "kubevirt.io/application-aware-quota/staging/src/kubevirt.io/application-aware-quota-api/pkg/apis/core/v1alpha1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
+)
+
+// HyperConvergedName is the name of the HyperConverged resource that will be reconciled
+const HyperConvergedName = "kubevirt-hyperconverged"
+
+type HyperConvergedUninstallStrategy string
+
+const (
+	HyperConvergedUninstallStrategyRemoveWorkloads                HyperConvergedUninstallStrategy = "RemoveWorkloads"
+	HyperConvergedUninstallStrategyBlockUninstallIfWorkloadsExist HyperConvergedUninstallStrategy = "BlockUninstallIfWorkloadsExist"
+)
+
+type HyperConvergedTuningPolicy string
+
+// HyperConvergedAnnotationTuningPolicy defines a static configuration of the kubevirt query per seconds (qps) and burst values
+// through annotation values.
+const (
+	HyperConvergedAnnotationTuningPolicy HyperConvergedTuningPolicy = "annotation"
+	HyperConvergedHighBurstProfile       HyperConvergedTuningPolicy = "highBurst"
+)
+
+// HyperConvergedSpec defines the desired state of HyperConverged
+// +k8s:openapi-gen=true
+type HyperConvergedSpec struct {
+	// TuningPolicy allows to configure the mode in which the RateLimits of kubevirt are set.
+	// If TuningPolicy is not present the default kubevirt values are used.
+	// It can be set to `annotation` for fine-tuning the kubevirt queryPerSeconds (qps) and burst values.
+	// Qps and burst values are taken from the annotation hco.kubevirt.io/tuningPolicy
+	// +kubebuilder:validation:Enum=annotation;highBurst
+	// +optional
+	TuningPolicy HyperConvergedTuningPolicy `json:"tuningPolicy,omitempty"`
+
+	// infra HyperConvergedConfig influences the pod configuration (currently only placement)
+	// for all the infra components needed on the virtualization enabled cluster
+	// but not necessarily directly on each node running VMs/VMIs.
+	// +optional
+	Infra HyperConvergedConfig `json:"infra,omitempty"`
+
+	// workloads HyperConvergedConfig influences the pod configuration (currently only placement) of components
+	// which need to be running on a node where virtualization workloads should be able to run.
+	// Changes to Workloads HyperConvergedConfig can be applied only without existing workload.
+	// +optional
+	Workloads HyperConvergedConfig `json:"workloads,omitempty"`
+
+	// featureGates is a map of feature gate flags. Setting a flag to `true` will enable
+	// the feature. Setting `false` or removing the feature gate, disables the feature.
+	// +kubebuilder:default={"downwardMetrics": false, "withHostPassthroughCPU": false, "enableCommonBootImageImport": true, "deployVmConsoleProxy": false, "deployKubeSecondaryDNS": false, "deployKubevirtIpamController": false, "disableMDevConfiguration": false, "persistentReservation": false, "autoResourceLimits": false, "enableApplicationAwareQuota": false, "primaryUserDefinedNetworkBinding": false, "enableHostPathProvisioner": false}
+	// +optional
+	FeatureGates HyperConvergedFeatureGates `json:"featureGates,omitempty"`
+
+	// Live migration limits and timeouts are applied so that migration processes do not
+	// overwhelm the cluster.
+	// +kubebuilder:default={"completionTimeoutPerGiB": 800, "parallelOutboundMigrationsPerNode": 2, "progressTimeout": 150, "allowAutoConverge": false, "allowPostCopy": false}
+	// +optional
+	LiveMigrationConfig LiveMigrationConfigurations `json:"liveMigrationConfig,omitempty"`
+
+	// PermittedHostDevices holds information about devices allowed for passthrough
+	// +optional
+	PermittedHostDevices *PermittedHostDevices `json:"permittedHostDevices,omitempty"`
+
+	// MediatedDevicesConfiguration holds information about MDEV types to be defined on nodes, if available
+	// +optional
+	MediatedDevicesConfiguration *MediatedDevicesConfiguration `json:"mediatedDevicesConfiguration,omitempty"`
+
+	// certConfig holds the rotation policy for internal, self-signed certificates
+	// +kubebuilder:default={"ca": {"duration": "48h0m0s", "renewBefore": "24h0m0s"}, "server": {"duration": "24h0m0s", "renewBefore": "12h0m0s"}}
+	// +optional
+	CertConfig HyperConvergedCertConfig `json:"certConfig,omitempty"`
+
+	// ResourceRequirements describes the resource requirements for the operand workloads.
+	// +kubebuilder:validation:XValidation:rule="!has(self.vmiCPUAllocationRatio) || self.vmiCPUAllocationRatio != 1",message="Automatic CPU limits are incompatible with a VMI CPU allocation ratio of 1"
+	// +optional
+	ResourceRequirements *OperandResourceRequirements `json:"resourceRequirements,omitempty"`
+
+	// DefaultCPUModel defines a cluster default for CPU model: default CPU model is set when VMI doesn't have any CPU model.
+	// When VMI has CPU model set, then VMI's CPU model is preferred.
+	// When default CPU model is not set and VMI's CPU model is not set too, host-model will be set.
+	// Default CPU model can be changed when kubevirt is running.
+	// +optional
+	DefaultCPUModel *string `json:"defaultCPUModel,omitempty"`
+
+	// DefaultRuntimeClass defines a cluster default for the RuntimeClass to be used for VMIs pods if not set there.
+	// Default RuntimeClass can be changed when kubevirt is running, existing VMIs are not impacted till
+	// the next restart/live-migration when they are eventually going to consume the new default RuntimeClass.
+	// +optional
+	DefaultRuntimeClass *string `json:"defaultRuntimeClass,omitempty"`
+
+	// ObsoleteCPUs allows avoiding scheduling of VMs for obsolete CPU models
+	// +optional
+	ObsoleteCPUs *HyperConvergedObsoleteCPUs `json:"obsoleteCPUs,omitempty"`
+
+	// CommonTemplatesNamespace defines namespace in which common templates will
+	// be deployed. It overrides the default openshift namespace.
+	// +optional
+	CommonTemplatesNamespace *string `json:"commonTemplatesNamespace,omitempty"`
+
+	// WorkloadUpdateStrategy defines at the cluster level how to handle automated workload updates
+	// +kubebuilder:default={"batchEvictionSize": 10, "batchEvictionInterval": "1m0s"}
+	WorkloadUpdateStrategy HyperConvergedWorkloadUpdateStrategy `json:"workloadUpdateStrategy,omitempty"`
+
+	// DataImportCronTemplates holds list of data import cron templates (golden images)
+	// +optional
+	// +listType=atomic
+	DataImportCronTemplates []DataImportCronTemplate `json:"dataImportCronTemplates,omitempty"`
+
+	// UninstallStrategy defines how to proceed on uninstall when workloads (VirtualMachines, DataVolumes) still exist.
+	// BlockUninstallIfWorkloadsExist will prevent the CR from being removed when workloads still exist.
+	// BlockUninstallIfWorkloadsExist is the safest choice to protect your workloads from accidental data loss, so it's strongly advised.
+	// RemoveWorkloads will cause all the workloads to be cascading deleted on uninstallation.
+	// WARNING: please notice that RemoveWorkloads will cause your workloads to be deleted as soon as this CR will be, even accidentally, deleted.
+	// Please correctly consider the implications of this option before setting it.
+	// BlockUninstallIfWorkloadsExist is the default behaviour.
+	// +kubebuilder:default=BlockUninstallIfWorkloadsExist
+	// +default="BlockUninstallIfWorkloadsExist"
+	// +kubebuilder:validation:Enum=RemoveWorkloads;BlockUninstallIfWorkloadsExist
+	// +optional
+	UninstallStrategy HyperConvergedUninstallStrategy `json:"uninstallStrategy,omitempty"`
+
+	// LogVerbosityConfig configures the verbosity level of Kubevirt's different components. The higher
+	// the value - the higher the log verbosity.
+	// +optional
+	LogVerbosityConfig *LogVerbosityConfiguration `json:"logVerbosityConfig,omitempty"`
+
+	// TLSSecurityProfile specifies the settings for TLS connections to be propagated to all kubevirt-hyperconverged components.
+	// If unset, the hyperconverged cluster operator will consume the value set on the APIServer CR on OCP/OKD or Intermediate if on vanilla k8s.
+	// Note that only Old, Intermediate and Custom profiles are currently supported, and the maximum available
+	// MinTLSVersions is VersionTLS12.
+	// +optional
+	TLSSecurityProfile *openshiftconfigv1.TLSSecurityProfile `json:"tlsSecurityProfile,omitempty"`
+
+	// EvictionStrategy defines at the cluster level if the VirtualMachineInstance should be
+	// migrated instead of shut-off in case of a node drain. If the VirtualMachineInstance specific
+	// field is set it overrides the cluster level one.
+	// Allowed values:
+	// - `None` no eviction strategy at cluster level.
+	// - `LiveMigrate` migrate the VM on eviction; a not live migratable VM with no specific strategy will block the drain of the node util manually evicted.
+	// - `LiveMigrateIfPossible` migrate the VM on eviction if live migration is possible, otherwise directly evict.
+	// - `External` block the drain, track eviction and notify an external controller.
+	// Defaults to LiveMigrate with multiple worker nodes, None on single worker clusters.
+	// +kubebuilder:validation:Enum=None;LiveMigrate;LiveMigrateIfPossible;External
+	// +optional
+	EvictionStrategy *kubevirtcorev1.EvictionStrategy `json:"evictionStrategy,omitempty"`
+
+	// VirtualMachineOptions holds the cluster level information regarding the virtual machine.
+	// +kubebuilder:default={"disableFreePageReporting": false, "disableSerialConsoleLog": true}
+	// +default={"disableFreePageReporting": false, "disableSerialConsoleLog": true}
+	// +optional
+	VirtualMachineOptions *VirtualMachineOptions `json:"virtualMachineOptions,omitempty"`
+
+	// CommonBootImageNamespace override the default namespace of the common boot images, in order to hide them.
+	//
+	// If not set, HCO won't set any namespace, letting SSP to use the default. If set, use the namespace to create the
+	// DataImportCronTemplates and the common image streams, with this namespace. This field is not set by default.
+	//
+	// +optional
+	CommonBootImageNamespace *string `json:"commonBootImageNamespace,omitempty"`
+
+	// KSMConfiguration holds the information regarding
+	// the enabling the KSM in the nodes (if available).
+	// +optional
+	KSMConfiguration *kubevirtcorev1.KSMConfiguration `json:"ksmConfiguration,omitempty"`
+
+	// ApplicationAwareConfig set the AAQ configurations
+	// +optional
+	ApplicationAwareConfig *ApplicationAwareConfigurations `json:"applicationAwareConfig,omitempty"`
+
+	// HostPathProvisioner set the HostPathProvisioner (HPP) configurations. Only relevant if the
+	// enableHostPathProvisioner feature gate is set.
+	// +optional
+	HostPathProvisioner *HostPathProvisionerConfig `json:"hostPathProvisioner,omitempty"`
+
+	// HigherWorkloadDensity holds configurataion aimed to increase virtual machine density
+	// +kubebuilder:default={"memoryOvercommitPercentage": 100}
+	// +default={"memoryOvercommitPercentage": 100}
+	// +optional
+	HigherWorkloadDensity *HigherWorkloadDensityConfiguration `json:"higherWorkloadDensity,omitempty"`
+
+	// OperandOverrides holds typed JSON patches to be applied on top of the operand CRs, as rendered by HCO.
+	// This is the supported replacement of the jsonpatch annotations. Please notice that using operand overrides
+	// raises the TaintedConfiguration condition.
+	// +optional
+	OperandOverrides *OperandOverrides `json:"operandOverrides,omitempty"`
+
+	// ReconcilePolicy allows to stop HCO from reconciling the operand CRs, globally or per operand, e.g. for
+	// debugging a live cluster. HCO keeps reporting the conditions of the operands that are not reconciled, and
+	// raises the ReconcilePaused condition.
+	// +optional
+	ReconcilePolicy *ReconcilePolicy `json:"reconcilePolicy,omitempty"`
+
+	// AlertSilences controls the Alertmanager silences that HCO manages: its built-in silences, and the
+	// user-declared ones. HCO creates the silences, refreshes them before they expire, and deletes the silences that
+	// are removed from the list. The state of the silences is reported in the status.alertSilences field. Requires
+	// the Prometheus Operator; on clusters other than OpenShift, the Alertmanager endpoint must be configured.
+	// +optional
+	AlertSilences *AlertSilencesConfig `json:"alertSilences,omitempty"`
+
+	// Storage holds the cluster level storage configurations
+	// +optional
+	Storage StorageConfig `json:"storage,omitempty"`
+
+	// Networking holds the cluster level networking configurations
+	// +optional
+	Networking NetworkingConfig `json:"networking,omitempty"`
+}
+
+// StorageConfig holds the cluster level storage configurations
+// +k8s:openapi-gen=true
+type StorageConfig struct {
+	// Override the storage class used for scratch space during transfer operations. The scratch space storage class
+	// is determined in the following order:
+	// value of scratchSpaceStorageClass, if that doesn't exist, use the default storage class, if there is no default
+	// storage class, use the storage class of the DataVolume, if no storage class specified, use no storage class for
+	// scratch space
+	// +optional
+	ScratchSpaceStorageClass *string `json:"scratchSpaceStorageClass,omitempty"`
+
+	// VMStateStorageClass is the name of the storage class to use for the PVCs created to preserve VM state, like TPM.
+	// The storage class must support RWX in filesystem mode.
+	// +optional
+	VMStateStorageClass *string `json:"vmStateStorageClass,omitempty"`
+
+	// FilesystemOverhead describes the space reserved for overhead when using Filesystem volumes.
+	// A value is between 0 and 1, if not defined it is 0.055 (5.5 percent overhead)
+	// +optional
+	FilesystemOverhead *cdiv1beta1.FilesystemOverhead `json:"filesystemOverhead,omitempty"`
+
+	// Import contains configuration for importing containerized data
+	// +optional
+	Import *StorageImportConfig `json:"import,omitempty"`
+}
+
+// NetworkingConfig holds the cluster level networking configurations
+// +k8s:openapi-gen=true
+type NetworkingConfig struct {
+	// KubeSecondaryDNSNameServerIP defines name server IP used by KubeSecondaryDNS
+	// +optional
+	KubeSecondaryDNSNameServerIP *string `json:"kubeSecondaryDNSNameServerIP,omitempty"`
+
+	// NetworkBinding defines the network binding plugins.
+	// Those bindings can be used when defining virtual machine interfaces.
+	// +optional
+	NetworkBinding map[string]kubevirtcorev1.InterfaceBindingPlugin `json:"networkBinding,omitempty"`
+}
+
+// CertRotateConfigCA contains the tunables for TLS certificates.
+// +k8s:openapi-gen=true
+type CertRotateConfigCA struct {
+	// The requested 'duration' (i.e. lifetime) of the Certificate.
+	// This should comply with golang's ParseDuration format (https://golang.org/pkg/time/#ParseDuration)
+	// +kubebuilder:default="48h0m0s"
+	// +default="48h0m0s"
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// The amount of time before the currently issued certificate's `notAfter`
+	// time that we will begin to attempt to renew the certificate.
+	// This should comply with golang's ParseDuration format (https://golang.org/pkg/time/#ParseDuration)
+	// +kubebuilder:default="24h0m0s"
+	// +default="24h0m0s"
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+}
+
+// CertRotateConfigServer contains the tunables for TLS certificates.
+// +k8s:openapi-gen=true
+type CertRotateConfigServer struct {
+	// The requested 'duration' (i.e. lifetime) of the Certificate.
+	// This should comply with golang's ParseDuration format (https://golang.org/pkg/time/#ParseDuration)
+	// +kubebuilder:default="24h0m0s"
+	// +default="24h0m0s"
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// The amount of time before the currently issued certificate's `notAfter`
+	// time that we will begin to attempt to renew the certificate.
+	// This should comply with golang's ParseDuration format (https://golang.org/pkg/time/#ParseDuration)
+	// +kubebuilder:default="12h0m0s"
+	// +default="12h0m0s"
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+}
+
+// HyperConvergedCertConfig holds the CertConfig entries for the HCO operands
+// +k8s:openapi-gen=true
+type HyperConvergedCertConfig struct {
+	// CA configuration -
+	// CA certs are kept in the CA bundle as long as they are valid
+	// +kubebuilder:default={"duration": "48h0m0s", "renewBefore": "24h0m0s"}
+	// +optional
+	CA CertRotateConfigCA `json:"ca,omitempty"`
+
+	// Server configuration -
+	// Certs are rotated and discarded
+	// +kubebuilder:default={"duration": "24h0m0s", "renewBefore": "12h0m0s"}
+	// +optional
+	Server CertRotateConfigServer `json:"server,omitempty"`
+}
+
+// HyperConvergedConfig defines a set of configurations to pass to components
+type HyperConvergedConfig struct {
+	// NodePlacement describes node scheduling configuration.
+	// +optional
+	NodePlacement *sdkapi.NodePlacement `json:"nodePlacement,omitempty"`
+}
+
+// LiveMigrationConfigurations - Live migration limits and timeouts are applied so that migration processes do not
+// overwhelm the cluster.
+// +k8s:openapi-gen=true
+type LiveMigrationConfigurations struct {
+	// Number of migrations running in parallel in the cluster.
+	// If not set, the mutating webhook sets it according to the number of workload nodes when the HyperConverged CR
+	// is created, and 5 migrations are used otherwise.
+	// +optional
+	ParallelMigrationsPerCluster *uint32 `json:"parallelMigrationsPerCluster,omitempty"`
+
+	// Maximum number of outbound migrations per node.
+	// +optional
+	// +kubebuilder:default=2
+	// +default=2
+	ParallelOutboundMigrationsPerNode *uint32 `json:"parallelOutboundMigrationsPerNode,omitempty"`
+
+	// Bandwidth limit of each migration, the value is quantity of bytes per second (e.g. 2048Mi = 2048MiB/sec)
+	// +optional
+	// +kubebuilder:validation:Pattern=^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+	BandwidthPerMigration *string `json:"bandwidthPerMigration,omitempty"`
+
+	// If a migrating VM is big and busy, while the connection to the destination node
+	// is slow, migration may never converge. The completion timeout is calculated
+	// based on completionTimeoutPerGiB times the size of the guest (both RAM and
+	// migrated disks, if any). For example, with completionTimeoutPerGiB set to 800,
+	// a virtual machine instance with 6GiB memory will timeout if it has not
+	// completed migration in 1h20m. Use a lower completionTimeoutPerGiB to induce
+	// quicker failure, so that another destination or post-copy is attempted. Use a
+	// higher completionTimeoutPerGiB to let workload with spikes in its memory dirty
+	// rate to converge.
+	// The format is a number.
+	// +kubebuilder:default=800
+	// +default=800
+	// +optional
+	CompletionTimeoutPerGiB *int64 `json:"completionTimeoutPerGiB,omitempty"`
+
+	// The migration will be canceled if memory copy fails to make progress in this time, in seconds.
+	// +kubebuilder:default=150
+	// +default=150
+	// +optional
+	ProgressTimeout *int64 `json:"progressTimeout,omitempty"`
+
+	// The migrations will be performed over a dedicated multus network to minimize disruption to tenant workloads due to network saturation when VM live migrations are triggered.
+	// +optional
+	Network *string `json:"network,omitempty"`
+
+	// AllowAutoConverge allows the platform to compromise performance/availability of VMIs to
+	// guarantee successful VMI live migrations. Defaults to false
+	// +optional
+	// +kubebuilder:default=false
+	// +default=false
+	AllowAutoConverge *bool `json:"allowAutoConverge,omitempty"`
+
+	// When enabled, KubeVirt attempts to use post-copy live-migration in case it
+	// reaches its completion timeout while attempting pre-copy live-migration.
+	// Post-copy migrations allow even the busiest VMs to successfully live-migrate.
+	// However, events like a network failure or a failure in any of the source or
+	// destination nodes can cause the migrated VM to crash or reach inconsistency.
+	// Enable this option when evicting nodes is more important than keeping VMs
+	// alive.
+	// Defaults to false.
+	// +optional
+	// +kubebuilder:default=false
+	// +default=false
+	AllowPostCopy *bool `json:"allowPostCopy,omitempty"`
+}
+
+// VirtualMachineOptions holds the cluster level information regarding the virtual machine.
+type VirtualMachineOptions struct {
+	// DisableFreePageReporting disable the free page reporting of
+	// memory balloon device https://libvirt.org/formatdomain.html#memory-balloon-device.
+	// This will have effect only if AutoattachMemBalloon is not false and the vmi is not
+	// requesting any high performance feature (dedicatedCPU/realtime/hugePages), in which free page reporting is always disabled.
+	// +optional
+	// +kubebuilder:default=false
+	// +default=false
+	DisableFreePageReporting *bool `json:"disableFreePageReporting,omitempty"`
+
+	// DisableSerialConsoleLog disables logging the auto-attached default serial console.
+	// If not set, serial console logs will be written to a file and then streamed from a container named `guest-console-log`.
+	// The value can be individually overridden for each VM, not relevant if AutoattachSerialConsole is disabled for the VM.
+	// +optional
+	// +kubebuilder:default=true
+	// +default=true
+	DisableSerialConsoleLog *bool `json:"disableSerialConsoleLog,omitempty"`
+}
+
+// HyperConvergedFeatureGates is a set of optional feature gates to enable or disable new features that are not enabled
+// by default yet.
+// +k8s:openapi-gen=true
+type HyperConvergedFeatureGates struct {
+	// Allow to expose a limited set of host metrics to guests.
+	// +optional
+	// +kubebuilder:default=false
+	// +default=false
+	DownwardMetrics *bool `json:"downwardMetrics,omitempty"`
+
+	// Allow migrating a virtual machine with CPU host-passthrough mode. This should be
+	// enabled only when the Cluster is homogeneous from CPU HW perspective doc here
+	// +optional
+	// +kubebuilder:default=false
+	// +default=false
+	WithHostPassthroughCPU *bool `json:"withHostPassthroughCPU,omitempty"`
+
+	// Opt-in to automatic delivery/updates of the common data import cron templates.
+	// There are two sources for the data import cron templates: hard coded list of common templates, and custom
+	// templates that can be added to the dataImportCronTemplates field. This feature gates only control the common
+	// templates. It is possible to use custom templates by adding them to the dataImportCronTemplates field.
+	// +optional
+	// +kubebuilder:default=true
+	// +default=true
+	EnableCommonBootImageImport *bool `json:"enableCommonBootImageImport,omitempty"`
+
+	// deploy VM console proxy resources in SSP operator
+	// +optional
+	// +kubebuilder:default=false
+	// +default=false
+	DeployVMConsoleProxy *bool `json:"deployVmConsoleProxy,omitempty"`
+
+	// Deploy KubeSecondaryDNS by CNAO
+	// +optional
+	// +kubebuilder:default=false
+	// +default=false
+	DeployKubeSecondaryDNS *bool `json:"deployKubeSecondaryDNS,omitempty"`
+
+	// Deploy KubevirtIpamController by CNAO.
+	// Allows having persistent IPs for Kubevirt user defined networks.
+	// +optional
+	// +kubebuilder:default=false
+	// +default=false
+	DeployKubevirtIpamController *bool `json:"deployKubevirtIpamController,omitempty"`
+
+	// Disable mediated devices handling on KubeVirt
+	// +optional
+	// +kubebuilder:default=false
+	// +default=false
+	DisableMDevConfiguration *bool `json:"disableMDevConfiguration,omitempty"`
+
+	// Enable persistent reservation of a LUN through the SCSI Persistent Reserve commands on Kubevirt.
+	// In order to issue privileged SCSI ioctls, the VM requires activation of the persistent reservation flag.
+	// Once this feature gate is enabled, then the additional container with the qemu-pr-helper is deployed inside the virt-handler pod.
+	// Enabling (or removing) the feature gate causes the redeployment of the virt-handler pod.
+	// +optional
+	// +kubebuilder:default=false
+	// +default=false
+	PersistentReservation *bool `json:"persistentReservation,omitempty"`
+
+	// TODO update description to also include cpu limits as well, after 4.14
+
+	// Enable KubeVirt to set automatic limits when they are needed.
+	// If ResourceQuota with set memory limits is associated with a namespace, each pod in that namespace must have memory limits set.
+	// By default, KubeVirt does not set such limits to the virt-launcher pod.
+	// When this feature gate is enabled, KubeVirt will set limits to the virt-launcher pod if they are not set manually
+	// and if a resource quota with memory limits is associated with the creation namespace.
+	// Note: this feature is in Developer Preview.
+	// +optional
+	// +kubebuilder:default=false
+	// +default=false
+	AutoResourceLimits *bool `json:"autoResourceLimits,omitempty"`
+
+	// Enable KubeVirt to request up to two additional dedicated CPUs
+	// in order to complete the total CPU count to an even parity when using emulator thread isolation.
+	// Note: this feature is in Developer Preview.
+	// +optional
+	// +kubebuilder:default=false
+	// +default=false
+	AlignCPUs *bool `json:"alignCPUs,omitempty"`
+
+	// EnableApplicationAwareQuota if true, enables the Application Aware Quota feature
+	// +optional
+	// +kubebuilder:default=false
+	// +default=false
+	EnableApplicationAwareQuota *bool `json:"enableApplicationAwareQuota,omitempty"`
+
+	// primaryUserDefinedNetworkBinding deploys the needed configurations for kubevirt users to
+	// be able to bind their VM to a UDN network on the VM's primary interface.
+	// Note: this feature is in Developer Preview.
+	// +optional
+	// +kubebuilder:default=false
+	// +default=false
+	PrimaryUserDefinedNetworkBinding *bool `json:"primaryUserDefinedNetworkBinding,omitempty"`
+
+	// EnableHostPathProvisioner if true, HCO deploys the HostPathProvisioner CR, according to the
+	// spec.hostPathProvisioner field, that must then include at least one storage pool
+	// +optional
+	// +kubebuilder:default=false
+	// +default=false
+	EnableHostPathProvisioner *bool `json:"enableHostPathProvisioner,omitempty"`
+}
+
+// PermittedHostDevices holds information about devices allowed for passthrough
+// +k8s:openapi-gen=true
+type PermittedHostDevices struct {
+	// +listType=map
+	// +listMapKey=pciDeviceSelector
+	PciHostDevices []PciHostDevice `json:"pciHostDevices,omitempty"`
+	// +listType=map
+	// +listMapKey=resourceName
+	USBHostDevices []USBHostDevice `json:"usbHostDevices,omitempty"`
+	// +listType=map
+	// +listMapKey=mdevNameSelector
+	MediatedDevices []MediatedHostDevice `json:"mediatedDevices,omitempty"`
+}
+
+// PciHostDevice represents a host PCI device allowed for passthrough
+// +k8s:openapi-gen=true
+type PciHostDevice struct {
+	// a combination of a vendor_id:product_id required to identify a PCI device on a host.
+	PCIDeviceSelector string `json:"pciDeviceSelector"`
+	// name by which a device is advertised and being requested
+	ResourceName string `json:"resourceName"`
+	// indicates that this resource is being provided by an external device plugin
+	// +optional
+	ExternalResourceProvider bool `json:"externalResourceProvider,omitempty"`
+	// HCO enforces the existence of several PciHostDevice objects. Set disabled field to true instead of remove
+	// these objects.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// USBSelector represents a selector for a USB device allowed for passthrough
+// +k8s:openapi-gen=true
+type USBSelector struct {
+	Vendor  string `json:"vendor"`
+	Product string `json:"product"`
+}
+
+// USBHostDevice represents a host USB device allowed for passthrough
+// +k8s:openapi-gen=true
+type USBHostDevice struct {
+	// Identifies the list of USB host devices.
+	// e.g: kubevirt.io/storage, kubevirt.io/bootable-usb, etc
+	ResourceName string `json:"resourceName"`
+	// +listType=atomic
+	Selectors []USBSelector `json:"selectors,omitempty"`
+	// If true, KubeVirt will leave the allocation and monitoring to an
+	// external device plugin
+	ExternalResourceProvider bool `json:"externalResourceProvider,omitempty"`
+	// HCO enforces the existence of several USBHostDevice objects. Set disabled field to true instead of remove
+	// these objects.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// MediatedHostDevice represents a host mediated device allowed for passthrough
+// +k8s:openapi-gen=true
+type MediatedHostDevice struct {
+	// name of a mediated device type required to identify a mediated device on a host
+	MDEVNameSelector string `json:"mdevNameSelector"`
+	// name by which a device is advertised and being requested
+	ResourceName string `json:"resourceName"`
+	// indicates that this resource is being provided by an external device plugin
+	// +optional
+	ExternalResourceProvider bool `json:"externalResourceProvider,omitempty"`
+	// HCO enforces the existence of several MediatedHostDevice objects. Set disabled field to true instead of remove
+	// these objects.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// MediatedDevicesConfiguration holds information about MDEV types to be defined, if available
+// +k8s:openapi-gen=true
+// +kubebuilder:validation:XValidation:rule="has(self.mediatedDeviceTypes) && size(self.mediatedDeviceTypes)>0",message="for mediatedDevicesConfiguration a non-empty mediatedDeviceTypes is required"
+type MediatedDevicesConfiguration struct {
+	// +optional
+	// +listType=atomic
+	MediatedDeviceTypes []string `json:"mediatedDeviceTypes"`
+
+	// +optional
+	// +listType=atomic
+	NodeMediatedDeviceTypes []NodeMediatedDeviceTypesConfig `json:"nodeMediatedDeviceTypes,omitempty"`
+}
+
+// NodeMediatedDeviceTypesConfig holds information about MDEV types to be defined in a specific node that matches the NodeSelector field.
+// +k8s:openapi-gen=true
+// +kubebuilder:validation:XValidation:rule="has(self.mediatedDeviceTypes) && size(self.mediatedDeviceTypes)>0",message="for nodeMediatedDeviceTypes a non-empty mediatedDeviceTypes is required"
+type NodeMediatedDeviceTypesConfig struct {
+
+	// NodeSelector is a selector which must be true for the vmi to fit on a node.
+	// Selector which must match a node's labels for the vmi to be scheduled on that node.
+	// More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
+	NodeSelector map[string]string `json:"nodeSelector"`
+
+	// +listType=atomic
+	// +optional
+	MediatedDeviceTypes []string `json:"mediatedDeviceTypes"`
+}
+
+// OperandResourceRequirements is a list of resource requirements for the operand workloads pods
+// +k8s:openapi-gen=true
+type OperandResourceRequirements struct {
+	// StorageWorkloads defines the resources requirements for storage workloads. It will propagate to the CDI custom
+	// resource
+	// +optional
+	StorageWorkloads *corev1.ResourceRequirements `json:"storageWorkloads,omitempty"`
+
+	// VmiCPUAllocationRatio defines, for each requested virtual CPU,
+	// how much physical CPU to request per VMI from the
+	// hosting node. The value is in fraction of a CPU thread (or
+	// core on non-hyperthreaded nodes).
+	// VMI POD CPU request = number of vCPUs * 1/vmiCPUAllocationRatio
+	// For example, a value of 1 means 1 physical CPU thread per VMI CPU thread.
+	// A value of 100 would be 1% of a physical thread allocated for each
+	// requested VMI thread.
+	// This option has no effect on VMIs that request dedicated CPUs.
+	// Defaults to 10, or to a lower ratio on small clusters, as set by the mutating webhook when the HyperConverged CR
+	// is created.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	VmiCPUAllocationRatio *int `json:"vmiCPUAllocationRatio,omitempty"`
+
+	// When set, AutoCPULimitNamespaceLabelSelector will set a CPU limit on virt-launcher for VMIs running inside
+	// namespaces that match the label selector.
+	// The CPU limit will equal the number of requested vCPUs.
+	// This setting does not apply to VMIs with dedicated CPUs.
+	// +optional
+	AutoCPULimitNamespaceLabelSelector *metav1.LabelSelector `json:"autoCPULimitNamespaceLabelSelector,omitempty"`
+}
+
+// HyperConvergedObsoleteCPUs allows avoiding scheduling of VMs for obsolete CPU models
+// +k8s:openapi-gen=true
+type HyperConvergedObsoleteCPUs struct {
+	// MinCPUModel is the Minimum CPU model that is used for basic CPU features; e.g. Penryn or Haswell.
+	// The default value for this field is nil, but in KubeVirt, the default value is "Penryn", if nothing else is set.
+	// Use this field to override KubeVirt default value.
+	// +optional
+	MinCPUModel string `json:"minCPUModel,omitempty"`
+	// CPUModels is a list of obsolete CPU models. When the node-labeller obtains the list of obsolete CPU models, it
+	// eliminates those CPU models and creates labels for valid CPU models.
+	// The default values for this field is nil, however, HCO uses opinionated values, and adding values to this list
+	// will add them to the opinionated values.
+	// +listType=set
+	// +optional
+	CPUModels []string `json:"cpuModels,omitempty"`
+}
+
+// StorageImportConfig contains configuration for importing containerized data
+// +k8s:openapi-gen=true
+type StorageImportConfig struct {
+	// InsecureRegistries is a list of image registries URLs that are not secured. Setting an insecure registry URL
+	// in this list allows pulling images from this registry.
+	// +listType=set
+	// +optional
+	InsecureRegistries []string `json:"insecureRegistries,omitempty"`
+}
+
+// HyperConvergedWorkloadUpdateStrategy defines options related to updating a KubeVirt install
+//
+// +k8s:openapi-gen=true
+type HyperConvergedWorkloadUpdateStrategy struct {
+	// WorkloadUpdateMethods defines the methods that can be used to disrupt workloads
+	// during automated workload updates.
+	// When multiple methods are present, the least disruptive method takes
+	// precedence over more disruptive methods. For example if both LiveMigrate and Evict
+	// methods are listed, only VMs which are not live migratable will be restarted/shutdown.
+	// An empty list defaults to no automated workload updating.
+	// If not set, LiveMigrate is used, except on single node clusters, where the mutating webhook sets an empty list
+	// when the HyperConverged CR is created.
+	//
+	// +listType=atomic
+	// +optional
+	WorkloadUpdateMethods []string `json:"workloadUpdateMethods"`
+
+	// BatchEvictionSize Represents the number of VMIs that can be forced updated per
+	// the BatchShutdownInterval interval
+	//
+	// +kubebuilder:default=10
+	// +default=10
+	// +optional
+	BatchEvictionSize *int `json:"batchEvictionSize,omitempty"`
+
+	// BatchEvictionInterval Represents the interval to wait before issuing the next
+	// batch of shutdowns
+	//
+	// +kubebuilder:default="1m0s"
+	// +default="1m0s"
+	// +optional
+	BatchEvictionInterval *metav1.Duration `json:"batchEvictionInterval,omitempty"`
+}
+
+// HyperConvergedStatus defines the observed state of HyperConverged
+// +k8s:openapi-gen=true
+type HyperConvergedStatus struct {
+	// Conditions describes the state of the HyperConverged resource.
+	// +listType=atomic
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"  patchStrategy:"merge" patchMergeKey:"type"`
+
+	// RelatedObjects is a list of objects created and maintained by this
+	// operator. Object references will be added to this list after they have
+	// been created AND found in the cluster.
+	// +listType=atomic
+	// +optional
+	RelatedObjects []corev1.ObjectReference `json:"relatedObjects,omitempty"`
+
+	// Versions is a list of HCO component versions, as name/version pairs. The version with a name of "operator"
+	// is the HCO version itself, as described here:
+	// https://github.com/openshift/cluster-version-operator/blob/master/docs/dev/clusteroperator.md#version
+	// +listType=atomic
+	// +optional
+	Versions []Version `json:"versions,omitempty"`
+
+	// ObservedGeneration reflects the HyperConverged resource generation. If the ObservedGeneration is less than the
+	// resource generation in metadata, the status is out of date
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// DataImportSchedule is the cron expression that is used in for the hard-coded data import cron templates. HCO
+	// generates the value of this field once and stored in the status field, so will survive restart.
+	// +optional
+	DataImportSchedule string `json:"dataImportSchedule,omitempty"`
+
+	// DataImportCronTemplates is a list of the actual DataImportCronTemplates as HCO update in the SSP CR. The list
+	// contains both the common and the custom templates, including any modification done by HCO.
+	DataImportCronTemplates []DataImportCronTemplateStatus `json:"dataImportCronTemplates,omitempty"`
+
+	// SystemHealthStatus reflects the health of HCO and its secondary resources, based on the aggregated conditions.
+	// +optional
+	SystemHealthStatus string `json:"systemHealthStatus,omitempty"`
+
+	// OperandOverrides reports the result of applying the spec.operandOverrides on each one of the operand CRs.
+	// +listType=map
+	// +listMapKey=operand
+	// +optional
+	OperandOverrides []OperandOverrideStatus `json:"operandOverrides,omitempty"`
+
+	// Components reports the status of each one of the operands managed by HCO.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Components []ComponentStatus `json:"components,omitempty"`
+
+	// UpgradePatchHistory is a list of the upgrade patches that HCO applied on upgrades, with the most recent last.
+	// Only the last 20 entries are kept.
+	// +listType=atomic
+	// +optional
+	UpgradePatchHistory []UpgradePatchRecord `json:"upgradePatchHistory,omitempty"`
+
+	// UpgradeProgress records the upgrade of HCO that is in progress. It's removed when the upgrade is completed.
+	// +optional
+	UpgradeProgress *UpgradeProgress `json:"upgradeProgress,omitempty"`
+
+	// AlertSilences reports the state of the Alertmanager silences that HCO manages
+	// +listType=atomic
+	// +optional
+	AlertSilences []AlertSilenceStatus `json:"alertSilences,omitempty"`
+}
+
+type Version struct {
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+// LogVerbosityConfiguration configures log verbosity for different components
+// +k8s:openapi-gen=true
+type LogVerbosityConfiguration struct {
+	// Kubevirt is a struct that allows specifying the log verbosity level that controls the amount of information
+	// logged for each Kubevirt component.
+	// +optional
+	Kubevirt *kubevirtcorev1.LogVerbosity `json:"kubevirt,omitempty"`
+
+	// CDI indicates the log verbosity level that controls the amount of information logged for CDI components.
+	// +optional
+	CDI *int32 `json:"cdi,omitempty"`
+}
+
+// DataImportCronStatus is the status field of the DIC template
+type DataImportCronStatus struct {
+	// CommonTemplate indicates whether this is a common template (true), or a custom one (false)
+	CommonTemplate bool `json:"commonTemplate,omitempty"`
+
+	// Modified indicates if a common template was customized. Always false for custom templates.
+	Modified bool `json:"modified,omitempty"`
+
+	// LastImportTimestamp is the time of the last successful import of the golden image, as reported by the
+	// DataImportCron
+	// +optional
+	LastImportTimestamp *metav1.Time `json:"lastImportTimestamp,omitempty"`
+
+	// CurrentDigest is the digest of the latest source image of the golden image, as polled by the DataImportCron
+	// +optional
+	CurrentDigest string `json:"currentDigest,omitempty"`
+
+	// FailingCondition is the condition of the DataImportCron or of its DataSource, that shows why the golden image is
+	// not up-to-date or not ready. It is not set when the golden image is up-to-date and ready.
+	// +optional
+	FailingCondition *metav1.Condition `json:"failingCondition,omitempty"`
+}
+
+// DataImportCronTemplate defines the template type for DataImportCrons.
+// It requires metadata.name to be specified while leaving namespace as optional.
+type DataImportCronTemplate struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec *cdiv1beta1.DataImportCronSpec `json:"spec,omitempty"`
+}
+
+// DataImportCronTemplateStatus is a copy of a dataImportCronTemplate as defined in the spec, or in the HCO image.
+type DataImportCronTemplateStatus struct {
+	DataImportCronTemplate `json:",inline"`
+
+	Status DataImportCronStatus `json:"status,omitempty"`
+}
+
+// ApplicationAwareConfigurations holds the AAQ configurations
+// +k8s:openapi-gen=true
+type ApplicationAwareConfigurations struct {
+	// VmiCalcConfigName determine how resource allocation will be done with ApplicationsResourceQuota.
+	// allowed values are: VmiPodUsage, VirtualResources, DedicatedVirtualResources or IgnoreVmiCalculator
+	// +kubebuilder:validation:Enum=VmiPodUsage;VirtualResources;DedicatedVirtualResources;IgnoreVmiCalculator
+	// +kubebuilder:default=DedicatedVirtualResources
+	VmiCalcConfigName *aaqv1alpha1.VmiCalcConfigName `json:"vmiCalcConfigName,omitempty"`
+
+	// NamespaceSelector determines in which namespaces scheduling gate will be added to pods..
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// AllowApplicationAwareClusterResourceQuota if set to true, allows creation and management of ClusterAppsResourceQuota
+	// +kubebuilder:default=false
+	AllowApplicationAwareClusterResourceQuota bool `json:"allowApplicationAwareClusterResourceQuota,omitempty"`
+}
+
+// HostPathProvisionerConfig holds the HostPathProvisioner (HPP) configurations
+// +k8s:openapi-gen=true
+type HostPathProvisionerConfig struct {
+	// StoragePools is the list of the storage pools that HPP provides. Each pool is exposed by a storage class that
+	// uses the pool name in its storagePool parameter.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	StoragePools []HostPathStoragePool `json:"storagePools,omitempty"`
+
+	// NodePlacement describes the nodes where the HPP workloads run. If not set, the node placement of the
+	// spec.workloads field is used.
+	// +optional
+	NodePlacement *sdkapi.NodePlacement `json:"nodePlacement,omitempty"`
+}
+
+// HostPathStoragePool is a single storage pool of HPP
+// +k8s:openapi-gen=true
+type HostPathStoragePool struct {
+	// Name is the name of the storage pool
+	Name string `json:"name"`
+
+	// Path is the path of the storage pool on the node
+	Path string `json:"path"`
+
+	// PVCTemplate is a template of the PVC that backs the storage pool on each node. If not set, the storage pool
+	// uses the node file system.
+	// +optional
+	PVCTemplate *corev1.PersistentVolumeClaimSpec `json:"pvcTemplate,omitempty"`
+}
+
+// HigherWorkloadDensity holds configurataion aimed to increase virtual machine density
+type HigherWorkloadDensityConfiguration struct {
+	// MemoryOvercommitPercentage is the percentage of memory we want to give VMIs compared to the amount
+	// given to its parent pod (virt-launcher). For example, a value of 102 means the VMI will
+	// "see" 2% more memory than its parent pod. Values under 100 are effectively "undercommits".
+	// Overcommits can lead to memory exhaustion, which in turn can lead to crashes. Use carefully.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=100
+	// +default=100
+	MemoryOvercommitPercentage int `json:"memoryOvercommitPercentage,omitempty"`
+}
+
+// OperandOverrides holds the overrides of the operand CRs managed by HCO. Each override is applied as a JSON patch
+// (RFC6902) on the corresponding CR, after HCO rendered it.
+// +k8s:openapi-gen=true
+type OperandOverrides struct {
+	// KubeVirt holds the override of the KubeVirt CR
+	// +optional
+	KubeVirt *OperandOverride `json:"kubevirt,omitempty"`
+
+	// CDI holds the override of the CDI CR
+	// +optional
+	CDI *OperandOverride `json:"cdi,omitempty"`
+
+	// NetworkAddonsConfig holds the override of the NetworkAddonsConfig CR
+	// +optional
+	NetworkAddonsConfig *OperandOverride `json:"networkAddonsConfig,omitempty"`
+
+	// SSP holds the override of the SSP CR
+	// +optional
+	SSP *OperandOverride `json:"ssp,omitempty"`
+
+	// AAQ holds the override of the AAQ CR. Only relevant if the enableApplicationAwareQuota feature gate is set.
+	// +optional
+	AAQ *OperandOverride `json:"aaq,omitempty"`
+
+	// HostPathProvisioner holds the override of the HostPathProvisioner CR. Only relevant if the
+	// enableHostPathProvisioner feature gate is set.
+	// +optional
+	HostPathProvisioner *OperandOverride `json:"hostPathProvisioner,omitempty"`
+}
+
+// OperandOverride is a list of JSON patch operations to be applied on the spec of an operand CR
+// +k8s:openapi-gen=true
+type OperandOverride struct {
+	// Patches is the list of JSON patch operations. The operations are applied by their order in the list.
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	Patches []OperandPatch `json:"patches"`
+}
+
+// OperandPatch is a single JSON patch operation, as defined in RFC6902
+// +k8s:openapi-gen=true
+type OperandPatch struct {
+	// Op is the patch operation
+	// +kubebuilder:validation:Enum=add;remove;replace;move;copy;test
+	Op string `json:"op"`
+
+	// Path is a JSON pointer to the target field. Only fields under /spec/ can be modified.
+	// +kubebuilder:validation:Pattern=`^/spec/`
+	Path string `json:"path"`
+
+	// From is a JSON pointer to the source field of the move and copy operations
+	// +kubebuilder:validation:Pattern=`^/spec/`
+	// +optional
+	From string `json:"from,omitempty"`
+
+	// Value is the value to be used by the add, replace and test operations
+	// +optional
+	Value *apiextensionsv1.JSON `json:"value,omitempty"`
+}
+
+// OperandOverrideStatus is the result of applying an override on an operand CR
+type OperandOverrideStatus struct {
+	// Operand is the name of the overridden operand, as used in the spec.operandOverrides field
+	Operand string `json:"operand"`
+
+	// Applied indicates whether the override was successfully applied on the operand CR
+	Applied bool `json:"applied"`
+
+	// Operations is the number of the patch operations in the override
+	// +optional
+	Operations int32 `json:"operations,omitempty"`
+
+	// ChangedPaths lists the paths of the operand CR that the override actually changed, compared to the CR as
+	// rendered by HCO. Operations that do not modify the rendered CR, like test operations, are not listed.
+	// +listType=atomic
+	// +optional
+	ChangedPaths []string `json:"changedPaths,omitempty"`
+
+	// Message holds the failure reason, if the override could not be applied
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ReconcilePolicy controls which of the operands are reconciled by HCO
+// +k8s:openapi-gen=true
+type ReconcilePolicy struct {
+	// Paused stops HCO from creating, updating or deleting any of its operands. HCO reconciles all the operands
+	// again, once the pause is lifted.
+	// +kubebuilder:default=false
+	// +default=false
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// Operands sets the management state of specific operand CRs. An Unmanaged operand CR is not modified by HCO,
+	// so out-of-band modifications are not reverted.
+	// +optional
+	Operands *OperandManagementStates `json:"operands,omitempty"`
+
+	// OutOfBandChanges controls the changes in the spec of the managed operand CRs (KubeVirt, CDI,
+	// NetworkAddonsConfig, SSP, AAQ and HostPathProvisioner), that are not made by HCO. HCO reverts such changes. Allow accepts them;
+	// Warn accepts them with an admission warning, and Deny rejects them. The warning and the rejection message name
+	// the HyperConverged field that controls the modified setting. The changes in Unmanaged operand CRs, or while the
+	// reconciliation is paused, are always accepted.
+	// +kubebuilder:default=Allow
+	// +default="Allow"
+	// +optional
+	OutOfBandChanges OutOfBandChangesPolicy `json:"outOfBandChanges,omitempty"`
+}
+
+// OperandManagementStates holds the management state of each one of the operand CRs
+// +k8s:openapi-gen=true
+type OperandManagementStates struct {
+	// KubeVirt is the management state of the KubeVirt CR
+	// +optional
+	KubeVirt ManagementState `json:"kubevirt,omitempty"`
+
+	// CDI is the management state of the CDI CR
+	// +optional
+	CDI ManagementState `json:"cdi,omitempty"`
+
+	// NetworkAddonsConfig is the management state of the NetworkAddonsConfig CR
+	// +optional
+	NetworkAddonsConfig ManagementState `json:"networkAddonsConfig,omitempty"`
+
+	// SSP is the management state of the SSP CR
+	// +optional
+	SSP ManagementState `json:"ssp,omitempty"`
+
+	// AAQ is the management state of the AAQ CR
+	// +optional
+	AAQ ManagementState `json:"aaq,omitempty"`
+
+	// HostPathProvisioner is the management state of the HostPathProvisioner CR
+	// +optional
+	HostPathProvisioner ManagementState `json:"hostPathProvisioner,omitempty"`
+}
+
+// ManagementState indicates whether HCO reconciles an operand CR. An empty value means Managed.
+// +kubebuilder:validation:Enum=Managed;Unmanaged
+type ManagementState string
+
+const (
+	ManagementStateManaged   ManagementState = "Managed"
+	ManagementStateUnmanaged ManagementState = "Unmanaged"
+)
+
+// OutOfBandChangesPolicy is the way the out-of-band changes in the operand CRs are handled. An empty value means Allow.
+// +kubebuilder:validation:Enum=Allow;Warn;Deny
+type OutOfBandChangesPolicy string
+
+const (
+	OutOfBandChangesAllow OutOfBandChangesPolicy = "Allow"
+	OutOfBandChangesWarn  OutOfBandChangesPolicy = "Warn"
+	OutOfBandChangesDeny  OutOfBandChangesPolicy = "Deny"
+)
+
+// ComponentStatus is the status of a single operand managed by HCO
+type ComponentStatus struct {
+	// Name is the name of the operand, as used in the spec.operandOverrides field
+	Name string `json:"name"`
+
+	// Group is the API group of the operand CR
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Version is the API version of the operand CR
+	Version string `json:"version"`
+
+	// Kind is the kind of the operand CR
+	Kind string `json:"kind"`
+
+	// ObservedVersion is the version of the operand, as reported in the status of its CR
+	// +optional
+	ObservedVersion string `json:"observedVersion,omitempty"`
+
+	// TargetVersion is the version of the operand that HCO deploys
+	// +optional
+	TargetVersion string `json:"targetVersion,omitempty"`
+
+	// Conditions are the Available, Progressing, Degraded and Upgradeable conditions of the operand CR
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// LastTransitionTime is the last time one of the operand conditions changed
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// LastReconcileError is the error HCO hit in the last reconciliation of the operand, if any
+	// +optional
+	LastReconcileError string `json:"lastReconcileError,omitempty"`
+}
+
+// UpgradePatchRecord records an upgrade patch that HCO applied
+type UpgradePatchRecord struct {
+	// Type is the type of the upgrade patch; one of HyperConvergedPatch, ObjectPatch and ObjectRemoval
+	Type string `json:"type"`
+
+	// Object is the object that was modified or removed by the upgrade patch
+	Object corev1.ObjectReference `json:"object"`
+
+	// SemverRange is the range of the versions that the upgrade patch applies to
+	SemverRange string `json:"semverRange"`
+
+	// FromVersion is the HCO version that the upgrade started from
+	// +optional
+	FromVersion string `json:"fromVersion,omitempty"`
+
+	// ToVersion is the HCO version that applied the upgrade patch
+	// +optional
+	ToVersion string `json:"toVersion,omitempty"`
+
+	// AppliedTime is the time when the upgrade patch was applied
+	AppliedTime metav1.Time `json:"appliedTime"`
+}
+
+// UpgradeProgress records an upgrade of HCO that is in progress
+type UpgradeProgress struct {
+	// TargetVersion is the HCO version that the upgrade is to
+	TargetVersion string `json:"targetVersion"`
+
+	// StartTime is the time when the upgrade started
+	StartTime metav1.Time `json:"startTime"`
+}
+
+// Upgrade patch types, as used in the status.upgradePatchHistory field
+const (
+	UpgradePatchTypeHyperConverged = "HyperConvergedPatch"
+	UpgradePatchTypeObject         = "ObjectPatch"
+	UpgradePatchTypeObjectRemoval  = "ObjectRemoval"
+)
+
+// AlertSilencesConfig controls the Alertmanager silences that HCO manages
+// +k8s:openapi-gen=true
+type AlertSilencesConfig struct {
+	// DisableBuiltInSilences stops HCO from creating its built-in silences, like the silence of the
+	// PodDisruptionBudgetAtLimit alerts of the KubeVirt VMs. HCO deletes the built-in silences it already created.
+	// +kubebuilder:default=false
+	// +default=false
+	// +optional
+	DisableBuiltInSilences bool `json:"disableBuiltInSilences,omitempty"`
+
+	// Silences is the list of the user-declared silences
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Silences []AlertSilence `json:"silences,omitempty"`
+}
+
+// AlertSilence is a user-declared Alertmanager silence
+// +k8s:openapi-gen=true
+type AlertSilence struct {
+	// Name identifies the silence in the spec and in the status
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Matchers select the alerts to silence. An alert is silenced if it matches all the matchers.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	Matchers []AlertSilenceMatcher `json:"matchers"`
+
+	// Comment is the comment of the silence in Alertmanager
+	// +optional
+	Comment string `json:"comment,omitempty"`
+
+	// Duration is the time the silence is active, from when HCO first created it. If not set, the silence is
+	// active as long as it is in the list.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// AlertSilenceMatcher matches the alerts by the value of one of their labels
+// +k8s:openapi-gen=true
+type AlertSilenceMatcher struct {
+	// Name is the name of the alert label
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Value is the value of the label; a regular expression if IsRegex is true
+	Value string `json:"value"`
+
+	// IsRegex indicates whether the value is a regular expression
+	// +optional
+	IsRegex bool `json:"isRegex,omitempty"`
+
+	// IsEqual indicates whether the matcher selects the alerts with a matching label value (true), or the alerts with
+	// a non-matching label value (false)
+	// +kubebuilder:default=true
+	// +default=true
+	// +optional
+	IsEqual *bool `json:"isEqual,omitempty"`
+}
+
+// AlertSilenceStatus is the state of an Alertmanager silence that HCO manages
+type AlertSilenceStatus struct {
+	// Name is the name of the silence; the name of a built-in silence, or the name of a user-declared silence, as
+	// used in the spec.alertSilences.silences field
+	Name string `json:"name"`
+
+	// BuiltIn indicates whether this is a built-in silence of HCO (true), or a user-declared one (false)
+	// +optional
+	BuiltIn bool `json:"builtIn,omitempty"`
+
+	// ID is the ID of the silence in Alertmanager
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// State is the state of the silence; one of Active, Expired and Failed
+	State string `json:"state"`
+
+	// StartsAt is the time when the silence started
+	// +optional
+	StartsAt *metav1.Time `json:"startsAt,omitempty"`
+
+	// EndsAt is the time when the silence ends, unless HCO refreshes it
+	// +optional
+	EndsAt *metav1.Time `json:"endsAt,omitempty"`
+
+	// Message is the error HCO hit in the last reconciliation of the silence, if any
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// Alert silence states, as used in the status.alertSilences field
+const (
+	AlertSilenceStateActive  = "Active"
+	AlertSilenceStateExpired = "Expired"
+	AlertSilenceStateFailed  = "Failed"
+)
+
+// Operand names, as used in the spec.operandOverrides field
+const (
+	OperandKubeVirt            = "kubevirt"
+	OperandCDI                 = "cdi"
+	OperandNetworkAddonsConfig = "networkAddonsConfig"
+	OperandSSP                 = "ssp"
+	OperandAAQ                 = "aaq"
+	OperandHostPathProvisioner = "hostPathProvisioner"
+)
+
+const (
+	ConditionAvailable = "Available"
+
+	// ConditionProgressing indicates that the operator is actively making changes to the resources maintained by the
+	// operator
+	ConditionProgressing = "Progressing"
+
+	// ConditionDegraded indicates that the resources maintained by the operator are not functioning completely.
+	// An example of a degraded state would be if not all pods in a deployment were running.
+	// It may still be available, but it is degraded
+	ConditionDegraded = "Degraded"
+
+	// ConditionUpgradeable indicates whether the resources maintained by the operator are in a state that is safe to upgrade.
+	// When `False`, the resources maintained by the operator should not be upgraded and the
+	// message field should contain a human-readable description of what the administrator should do to
+	// allow the operator to successfully update the resources maintained by the operator.
+	ConditionUpgradeable = "Upgradeable"
+
+	// ConditionReconcileComplete communicates the status of the HyperConverged resource's
+	// reconcile functionality. Basically, is the Reconcile function running to completion.
+	ConditionReconcileComplete = "ReconcileComplete"
+
+	// ConditionTaintedConfiguration indicates that a hidden/debug configuration
+	// has been applied to the HyperConverged resource via a specialized annotation, or via the operandOverrides field.
+	// This condition is exposed only when its value is True, and is otherwise hidden.
+	ConditionTaintedConfiguration = "TaintedConfiguration"
+
+	// ConditionReconcilePaused indicates that HCO does not reconcile some or all of its operands, because of the
+	// spec.reconcilePolicy field.
+	ConditionReconcilePaused = "ReconcilePaused"
+
+	// ConditionAlertmanagerUnavailable indicates that HCO can't manage the alert silences, because the Alertmanager
+	// endpoint is not configured, or is not reachable.
+	// This condition is exposed only when its value is True, and is otherwise hidden.
+	ConditionAlertmanagerUnavailable = "AlertmanagerUnavailable"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HyperConverged is the Schema for the hyperconvergeds API
+// +k8s:openapi-gen=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:resource:scope=Namespaced,categories={all},shortName={hco,hcos}
+// +kubebuilder:subresource:status
+type HyperConverged struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:default={"certConfig": {"ca": {"duration": "48h0m0s", "renewBefore": "24h0m0s"}, "server": {"duration": "24h0m0s", "renewBefore": "12h0m0s"}},"featureGates": {"downwardMetrics": false, "withHostPassthroughCPU": false, "enableCommonBootImageImport": true, "deployVmConsoleProxy": false, "deployKubeSecondaryDNS": false, "deployKubevirtIpamController": false, "disableMDevConfiguration": false, "persistentReservation": false, "autoResourceLimits": false, "enableApplicationAwareQuota": false, "primaryUserDefinedNetworkBinding": false, "enableHostPathProvisioner": false}, "liveMigrationConfig": {"completionTimeoutPerGiB": 800, "parallelOutboundMigrationsPerNode": 2, "progressTimeout": 150, "allowAutoConverge": false, "allowPostCopy": false}, "uninstallStrategy": "BlockUninstallIfWorkloadsExist", "virtualMachineOptions": {"disableFreePageReporting": false, "disableSerialConsoleLog": true}}
+	// +optional
+	Spec   HyperConvergedSpec   `json:"spec,omitempty"`
+	Status HyperConvergedStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HyperConvergedList contains a list of HyperConverged
+type HyperConvergedList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HyperConverged `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&HyperConverged{}, &HyperConvergedList{})
+}
@@ -0,0 +1,25 @@
+// NOTE: Boilerplate only.  Ignore this file.
+
+// package v1 contains API Schema definitions for the hco v1 API group
+// +k8s:deepcopy-gen=package,register
+// +k8s:defaulter-gen=TypeMeta
+// +groupName=hco.kubevirt.io
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	hcoutils "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: hcoutils.APIVersionGroup, Version: hcoutils.APIVersionV1}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+
+	// AddToScheme tbd
+	AddToScheme = SchemeBuilder.AddToScheme
+)
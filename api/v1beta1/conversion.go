@@ -0,0 +1,5 @@
+package v1beta1
+
+// Hub marks v1beta1 as the conversion hub of the HyperConverged API. All the other versions are converted to, and
+// from, this version.
+func (*HyperConverged) Hub() {}
@@ -3,6 +3,7 @@ package v1beta1
 import (
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -66,13 +67,13 @@ type HyperConvergedSpec struct {
 
 	// featureGates is a map of feature gate flags. Setting a flag to `true` will enable
 	// the feature. Setting `false` or removing the feature gate, disables the feature.
-	// +kubebuilder:default={"downwardMetrics": false, "withHostPassthroughCPU": false, "enableCommonBootImageImport": true, "deployTektonTaskResources": false, "deployVmConsoleProxy": false, "deployKubeSecondaryDNS": false, "deployKubevirtIpamController": false, "nonRoot": true, "disableMDevConfiguration": false, "persistentReservation": false, "enableManagedTenantQuota": false,"autoResourceLimits": false, "enableApplicationAwareQuota": false, "primaryUserDefinedNetworkBinding": false}
+	// +kubebuilder:default={"downwardMetrics": false, "withHostPassthroughCPU": false, "enableCommonBootImageImport": true, "deployTektonTaskResources": false, "deployVmConsoleProxy": false, "deployKubeSecondaryDNS": false, "deployKubevirtIpamController": false, "nonRoot": true, "disableMDevConfiguration": false, "persistentReservation": false, "enableManagedTenantQuota": false,"autoResourceLimits": false, "enableApplicationAwareQuota": false, "primaryUserDefinedNetworkBinding": false, "enableHostPathProvisioner": false}
 	// +optional
 	FeatureGates HyperConvergedFeatureGates `json:"featureGates,omitempty"`
 
 	// Live migration limits and timeouts are applied so that migration processes do not
 	// overwhelm the cluster.
-	// +kubebuilder:default={"completionTimeoutPerGiB": 800, "parallelMigrationsPerCluster": 5, "parallelOutboundMigrationsPerNode": 2, "progressTimeout": 150, "allowAutoConverge": false, "allowPostCopy": false}
+	// +kubebuilder:default={"completionTimeoutPerGiB": 800, "parallelOutboundMigrationsPerNode": 2, "progressTimeout": 150, "allowAutoConverge": false, "allowPostCopy": false}
 	// +optional
 	LiveMigrationConfig LiveMigrationConfigurations `json:"liveMigrationConfig,omitempty"`
 
@@ -90,7 +91,6 @@ type HyperConvergedSpec struct {
 	CertConfig HyperConvergedCertConfig `json:"certConfig,omitempty"`
 
 	// ResourceRequirements describes the resource requirements for the operand workloads.
-	// +kubebuilder:default={"vmiCPUAllocationRatio": 10}
 	// +kubebuilder:validation:XValidation:rule="!has(self.vmiCPUAllocationRatio) || self.vmiCPUAllocationRatio != 1",message="Automatic CPU limits are incompatible with a VMI CPU allocation ratio of 1"
 	// +optional
 	ResourceRequirements *OperandResourceRequirements `json:"resourceRequirements,omitempty"`
@@ -136,7 +136,7 @@ type HyperConvergedSpec struct {
 	StorageImport *StorageImportConfig `json:"storageImport,omitempty"`
 
 	// WorkloadUpdateStrategy defines at the cluster level how to handle automated workload updates
-	// +kubebuilder:default={"workloadUpdateMethods": {"LiveMigrate"}, "batchEvictionSize": 10, "batchEvictionInterval": "1m0s"}
+	// +kubebuilder:default={"batchEvictionSize": 10, "batchEvictionInterval": "1m0s"}
 	WorkloadUpdateStrategy HyperConvergedWorkloadUpdateStrategy `json:"workloadUpdateStrategy,omitempty"`
 
 	// DataImportCronTemplates holds list of data import cron templates (golden images)
@@ -238,11 +238,35 @@ type HyperConvergedSpec struct {
 	// +optional
 	ApplicationAwareConfig *ApplicationAwareConfigurations `json:"applicationAwareConfig,omitempty"`
 
+	// HostPathProvisioner set the HostPathProvisioner (HPP) configurations. Only relevant if the
+	// enableHostPathProvisioner feature gate is set.
+	// +optional
+	HostPathProvisioner *HostPathProvisionerConfig `json:"hostPathProvisioner,omitempty"`
+
 	// HigherWorkloadDensity holds configurataion aimed to increase virtual machine density
 	// +kubebuilder:default={"memoryOvercommitPercentage": 100}
 	// +default={"memoryOvercommitPercentage": 100}
 	// +optional
 	HigherWorkloadDensity *HigherWorkloadDensityConfiguration `json:"higherWorkloadDensity,omitempty"`
+
+	// OperandOverrides holds typed JSON patches to be applied on top of the operand CRs, as rendered by HCO.
+	// This is the supported replacement of the jsonpatch annotations. Please notice that using operand overrides
+	// raises the TaintedConfiguration condition.
+	// +optional
+	OperandOverrides *OperandOverrides `json:"operandOverrides,omitempty"`
+
+	// ReconcilePolicy allows to stop HCO from reconciling the operand CRs, globally or per operand, e.g. for
+	// debugging a live cluster. HCO keeps reporting the conditions of the operands that are not reconciled, and
+	// raises the ReconcilePaused condition.
+	// +optional
+	ReconcilePolicy *ReconcilePolicy `json:"reconcilePolicy,omitempty"`
+
+	// AlertSilences controls the Alertmanager silences that HCO manages: its built-in silences, and the
+	// user-declared ones. HCO creates the silences, refreshes them before they expire, and deletes the silences that
+	// are removed from the list. The state of the silences is reported in the status.alertSilences field. Requires
+	// the Prometheus Operator; on clusters other than OpenShift, the Alertmanager endpoint must be configured.
+	// +optional
+	AlertSilences *AlertSilencesConfig `json:"alertSilences,omitempty"`
 }
 
 // CertRotateConfigCA contains the tunables for TLS certificates.
@@ -311,9 +335,9 @@ type HyperConvergedConfig struct {
 // +k8s:openapi-gen=true
 type LiveMigrationConfigurations struct {
 	// Number of migrations running in parallel in the cluster.
+	// If not set, the mutating webhook sets it according to the number of workload nodes when the HyperConverged CR
+	// is created, and 5 migrations are used otherwise.
 	// +optional
-	// +kubebuilder:default=5
-	// +default=5
 	ParallelMigrationsPerCluster *uint32 `json:"parallelMigrationsPerCluster,omitempty"`
 
 	// Maximum number of outbound migrations per node.
@@ -512,6 +536,13 @@ type HyperConvergedFeatureGates struct {
 	// +kubebuilder:default=false
 	// +default=false
 	PrimaryUserDefinedNetworkBinding *bool `json:"primaryUserDefinedNetworkBinding,omitempty"`
+
+	// EnableHostPathProvisioner if true, HCO deploys the HostPathProvisioner CR, according to the
+	// spec.hostPathProvisioner field, that must then include at least one storage pool
+	// +optional
+	// +kubebuilder:default=false
+	// +default=false
+	EnableHostPathProvisioner *bool `json:"enableHostPathProvisioner,omitempty"`
 }
 
 // PermittedHostDevices holds information about devices allowed for passthrough
@@ -639,10 +670,9 @@ type OperandResourceRequirements struct {
 	// A value of 100 would be 1% of a physical thread allocated for each
 	// requested VMI thread.
 	// This option has no effect on VMIs that request dedicated CPUs.
-	// Defaults to 10
-	// +kubebuilder:default=10
+	// Defaults to 10, or to a lower ratio on small clusters, as set by the mutating webhook when the HyperConverged CR
+	// is created.
 	// +kubebuilder:validation:Minimum=1
-	// +default=10
 	// +optional
 	VmiCPUAllocationRatio *int `json:"vmiCPUAllocationRatio,omitempty"`
 
@@ -691,10 +721,11 @@ type HyperConvergedWorkloadUpdateStrategy struct {
 	// precedence over more disruptive methods. For example if both LiveMigrate and Evict
 	// methods are listed, only VMs which are not live migratable will be restarted/shutdown.
 	// An empty list defaults to no automated workload updating.
+	// If not set, LiveMigrate is used, except on single node clusters, where the mutating webhook sets an empty list
+	// when the HyperConverged CR is created.
 	//
 	// +listType=atomic
-	// +kubebuilder:default={"LiveMigrate"}
-	// +default=["LiveMigrate"]
+	// +optional
 	WorkloadUpdateMethods []string `json:"workloadUpdateMethods"`
 
 	// BatchEvictionSize Represents the number of VMIs that can be forced updated per
@@ -755,6 +786,33 @@ type HyperConvergedStatus struct {
 	// SystemHealthStatus reflects the health of HCO and its secondary resources, based on the aggregated conditions.
 	// +optional
 	SystemHealthStatus string `json:"systemHealthStatus,omitempty"`
+
+	// OperandOverrides reports the result of applying the spec.operandOverrides on each one of the operand CRs.
+	// +listType=map
+	// +listMapKey=operand
+	// +optional
+	OperandOverrides []OperandOverrideStatus `json:"operandOverrides,omitempty"`
+
+	// Components reports the status of each one of the operands managed by HCO.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Components []ComponentStatus `json:"components,omitempty"`
+
+	// UpgradePatchHistory is a list of the upgrade patches that HCO applied on upgrades, with the most recent last.
+	// Only the last 20 entries are kept.
+	// +listType=atomic
+	// +optional
+	UpgradePatchHistory []UpgradePatchRecord `json:"upgradePatchHistory,omitempty"`
+
+	// UpgradeProgress records the upgrade of HCO that is in progress. It's removed when the upgrade is completed.
+	// +optional
+	UpgradeProgress *UpgradeProgress `json:"upgradeProgress,omitempty"`
+
+	// AlertSilences reports the state of the Alertmanager silences that HCO manages
+	// +listType=atomic
+	// +optional
+	AlertSilences []AlertSilenceStatus `json:"alertSilences,omitempty"`
 }
 
 type Version struct {
@@ -782,6 +840,20 @@ type DataImportCronStatus struct {
 
 	// Modified indicates if a common template was customized. Always false for custom templates.
 	Modified bool `json:"modified,omitempty"`
+
+	// LastImportTimestamp is the time of the last successful import of the golden image, as reported by the
+	// DataImportCron
+	// +optional
+	LastImportTimestamp *metav1.Time `json:"lastImportTimestamp,omitempty"`
+
+	// CurrentDigest is the digest of the latest source image of the golden image, as polled by the DataImportCron
+	// +optional
+	CurrentDigest string `json:"currentDigest,omitempty"`
+
+	// FailingCondition is the condition of the DataImportCron or of its DataSource, that shows why the golden image is
+	// not up-to-date or not ready. It is not set when the golden image is up-to-date and ready.
+	// +optional
+	FailingCondition *metav1.Condition `json:"failingCondition,omitempty"`
 }
 
 // DataImportCronTemplate defines the template type for DataImportCrons.
@@ -816,6 +888,37 @@ type ApplicationAwareConfigurations struct {
 	AllowApplicationAwareClusterResourceQuota bool `json:"allowApplicationAwareClusterResourceQuota,omitempty"`
 }
 
+// HostPathProvisionerConfig holds the HostPathProvisioner (HPP) configurations
+// +k8s:openapi-gen=true
+type HostPathProvisionerConfig struct {
+	// StoragePools is the list of the storage pools that HPP provides. Each pool is exposed by a storage class that
+	// uses the pool name in its storagePool parameter.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	StoragePools []HostPathStoragePool `json:"storagePools,omitempty"`
+
+	// NodePlacement describes the nodes where the HPP workloads run. If not set, the node placement of the
+	// spec.workloads field is used.
+	// +optional
+	NodePlacement *sdkapi.NodePlacement `json:"nodePlacement,omitempty"`
+}
+
+// HostPathStoragePool is a single storage pool of HPP
+// +k8s:openapi-gen=true
+type HostPathStoragePool struct {
+	// Name is the name of the storage pool
+	Name string `json:"name"`
+
+	// Path is the path of the storage pool on the node
+	Path string `json:"path"`
+
+	// PVCTemplate is a template of the PVC that backs the storage pool on each node. If not set, the storage pool
+	// uses the node file system.
+	// +optional
+	PVCTemplate *corev1.PersistentVolumeClaimSpec `json:"pvcTemplate,omitempty"`
+}
+
 // HigherWorkloadDensity holds configurataion aimed to increase virtual machine density
 type HigherWorkloadDensityConfiguration struct {
 	// MemoryOvercommitPercentage is the percentage of memory we want to give VMIs compared to the amount
@@ -828,6 +931,349 @@ type HigherWorkloadDensityConfiguration struct {
 	MemoryOvercommitPercentage int `json:"memoryOvercommitPercentage,omitempty"`
 }
 
+// OperandOverrides holds the overrides of the operand CRs managed by HCO. Each override is applied as a JSON patch
+// (RFC6902) on the corresponding CR, after HCO rendered it.
+// +k8s:openapi-gen=true
+type OperandOverrides struct {
+	// KubeVirt holds the override of the KubeVirt CR
+	// +optional
+	KubeVirt *OperandOverride `json:"kubevirt,omitempty"`
+
+	// CDI holds the override of the CDI CR
+	// +optional
+	CDI *OperandOverride `json:"cdi,omitempty"`
+
+	// NetworkAddonsConfig holds the override of the NetworkAddonsConfig CR
+	// +optional
+	NetworkAddonsConfig *OperandOverride `json:"networkAddonsConfig,omitempty"`
+
+	// SSP holds the override of the SSP CR
+	// +optional
+	SSP *OperandOverride `json:"ssp,omitempty"`
+
+	// AAQ holds the override of the AAQ CR. Only relevant if the enableApplicationAwareQuota feature gate is set.
+	// +optional
+	AAQ *OperandOverride `json:"aaq,omitempty"`
+
+	// HostPathProvisioner holds the override of the HostPathProvisioner CR. Only relevant if the
+	// enableHostPathProvisioner feature gate is set.
+	// +optional
+	HostPathProvisioner *OperandOverride `json:"hostPathProvisioner,omitempty"`
+}
+
+// OperandOverride is a list of JSON patch operations to be applied on the spec of an operand CR
+// +k8s:openapi-gen=true
+type OperandOverride struct {
+	// Patches is the list of JSON patch operations. The operations are applied by their order in the list.
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	Patches []OperandPatch `json:"patches"`
+}
+
+// OperandPatch is a single JSON patch operation, as defined in RFC6902
+// +k8s:openapi-gen=true
+type OperandPatch struct {
+	// Op is the patch operation
+	// +kubebuilder:validation:Enum=add;remove;replace;move;copy;test
+	Op string `json:"op"`
+
+	// Path is a JSON pointer to the target field. Only fields under /spec/ can be modified.
+	// +kubebuilder:validation:Pattern=`^/spec/`
+	Path string `json:"path"`
+
+	// From is a JSON pointer to the source field of the move and copy operations
+	// +kubebuilder:validation:Pattern=`^/spec/`
+	// +optional
+	From string `json:"from,omitempty"`
+
+	// Value is the value to be used by the add, replace and test operations
+	// +optional
+	Value *apiextensionsv1.JSON `json:"value,omitempty"`
+}
+
+// OperandOverrideStatus is the result of applying an override on an operand CR
+type OperandOverrideStatus struct {
+	// Operand is the name of the overridden operand, as used in the spec.operandOverrides field
+	Operand string `json:"operand"`
+
+	// Applied indicates whether the override was successfully applied on the operand CR
+	Applied bool `json:"applied"`
+
+	// Operations is the number of the patch operations in the override
+	// +optional
+	Operations int32 `json:"operations,omitempty"`
+
+	// ChangedPaths lists the paths of the operand CR that the override actually changed, compared to the CR as
+	// rendered by HCO. Operations that do not modify the rendered CR, like test operations, are not listed.
+	// +listType=atomic
+	// +optional
+	ChangedPaths []string `json:"changedPaths,omitempty"`
+
+	// Message holds the failure reason, if the override could not be applied
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ReconcilePolicy controls which of the operands are reconciled by HCO
+// +k8s:openapi-gen=true
+type ReconcilePolicy struct {
+	// Paused stops HCO from creating, updating or deleting any of its operands. HCO reconciles all the operands
+	// again, once the pause is lifted.
+	// +kubebuilder:default=false
+	// +default=false
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// Operands sets the management state of specific operand CRs. An Unmanaged operand CR is not modified by HCO,
+	// so out-of-band modifications are not reverted.
+	// +optional
+	Operands *OperandManagementStates `json:"operands,omitempty"`
+
+	// OutOfBandChanges controls the changes in the spec of the managed operand CRs (KubeVirt, CDI,
+	// NetworkAddonsConfig, SSP, AAQ and HostPathProvisioner), that are not made by HCO. HCO reverts such changes. Allow accepts them;
+	// Warn accepts them with an admission warning, and Deny rejects them. The warning and the rejection message name
+	// the HyperConverged field that controls the modified setting. The changes in Unmanaged operand CRs, or while the
+	// reconciliation is paused, are always accepted.
+	// +kubebuilder:default=Allow
+	// +default="Allow"
+	// +optional
+	OutOfBandChanges OutOfBandChangesPolicy `json:"outOfBandChanges,omitempty"`
+}
+
+// OperandManagementStates holds the management state of each one of the operand CRs
+// +k8s:openapi-gen=true
+type OperandManagementStates struct {
+	// KubeVirt is the management state of the KubeVirt CR
+	// +optional
+	KubeVirt ManagementState `json:"kubevirt,omitempty"`
+
+	// CDI is the management state of the CDI CR
+	// +optional
+	CDI ManagementState `json:"cdi,omitempty"`
+
+	// NetworkAddonsConfig is the management state of the NetworkAddonsConfig CR
+	// +optional
+	NetworkAddonsConfig ManagementState `json:"networkAddonsConfig,omitempty"`
+
+	// SSP is the management state of the SSP CR
+	// +optional
+	SSP ManagementState `json:"ssp,omitempty"`
+
+	// AAQ is the management state of the AAQ CR
+	// +optional
+	AAQ ManagementState `json:"aaq,omitempty"`
+
+	// HostPathProvisioner is the management state of the HostPathProvisioner CR
+	// +optional
+	HostPathProvisioner ManagementState `json:"hostPathProvisioner,omitempty"`
+}
+
+// ManagementState indicates whether HCO reconciles an operand CR. An empty value means Managed.
+// +kubebuilder:validation:Enum=Managed;Unmanaged
+type ManagementState string
+
+const (
+	ManagementStateManaged   ManagementState = "Managed"
+	ManagementStateUnmanaged ManagementState = "Unmanaged"
+)
+
+// OutOfBandChangesPolicy is the way the out-of-band changes in the operand CRs are handled. An empty value means Allow.
+// +kubebuilder:validation:Enum=Allow;Warn;Deny
+type OutOfBandChangesPolicy string
+
+const (
+	OutOfBandChangesAllow OutOfBandChangesPolicy = "Allow"
+	OutOfBandChangesWarn  OutOfBandChangesPolicy = "Warn"
+	OutOfBandChangesDeny  OutOfBandChangesPolicy = "Deny"
+)
+
+// ComponentStatus is the status of a single operand managed by HCO
+type ComponentStatus struct {
+	// Name is the name of the operand, as used in the spec.operandOverrides field
+	Name string `json:"name"`
+
+	// Group is the API group of the operand CR
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Version is the API version of the operand CR
+	Version string `json:"version"`
+
+	// Kind is the kind of the operand CR
+	Kind string `json:"kind"`
+
+	// ObservedVersion is the version of the operand, as reported in the status of its CR
+	// +optional
+	ObservedVersion string `json:"observedVersion,omitempty"`
+
+	// TargetVersion is the version of the operand that HCO deploys
+	// +optional
+	TargetVersion string `json:"targetVersion,omitempty"`
+
+	// Conditions are the Available, Progressing, Degraded and Upgradeable conditions of the operand CR
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// LastTransitionTime is the last time one of the operand conditions changed
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// LastReconcileError is the error HCO hit in the last reconciliation of the operand, if any
+	// +optional
+	LastReconcileError string `json:"lastReconcileError,omitempty"`
+}
+
+// UpgradePatchRecord records an upgrade patch that HCO applied
+type UpgradePatchRecord struct {
+	// Type is the type of the upgrade patch; one of HyperConvergedPatch, ObjectPatch and ObjectRemoval
+	Type string `json:"type"`
+
+	// Object is the object that was modified or removed by the upgrade patch
+	Object corev1.ObjectReference `json:"object"`
+
+	// SemverRange is the range of the versions that the upgrade patch applies to
+	SemverRange string `json:"semverRange"`
+
+	// FromVersion is the HCO version that the upgrade started from
+	// +optional
+	FromVersion string `json:"fromVersion,omitempty"`
+
+	// ToVersion is the HCO version that applied the upgrade patch
+	// +optional
+	ToVersion string `json:"toVersion,omitempty"`
+
+	// AppliedTime is the time when the upgrade patch was applied
+	AppliedTime metav1.Time `json:"appliedTime"`
+}
+
+// UpgradeProgress records an upgrade of HCO that is in progress
+type UpgradeProgress struct {
+	// TargetVersion is the HCO version that the upgrade is to
+	TargetVersion string `json:"targetVersion"`
+
+	// StartTime is the time when the upgrade started
+	StartTime metav1.Time `json:"startTime"`
+}
+
+// Upgrade patch types, as used in the status.upgradePatchHistory field
+const (
+	UpgradePatchTypeHyperConverged = "HyperConvergedPatch"
+	UpgradePatchTypeObject         = "ObjectPatch"
+	UpgradePatchTypeObjectRemoval  = "ObjectRemoval"
+)
+
+// AlertSilencesConfig controls the Alertmanager silences that HCO manages
+// +k8s:openapi-gen=true
+type AlertSilencesConfig struct {
+	// DisableBuiltInSilences stops HCO from creating its built-in silences, like the silence of the
+	// PodDisruptionBudgetAtLimit alerts of the KubeVirt VMs. HCO deletes the built-in silences it already created.
+	// +kubebuilder:default=false
+	// +default=false
+	// +optional
+	DisableBuiltInSilences bool `json:"disableBuiltInSilences,omitempty"`
+
+	// Silences is the list of the user-declared silences
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Silences []AlertSilence `json:"silences,omitempty"`
+}
+
+// AlertSilence is a user-declared Alertmanager silence
+// +k8s:openapi-gen=true
+type AlertSilence struct {
+	// Name identifies the silence in the spec and in the status
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Matchers select the alerts to silence. An alert is silenced if it matches all the matchers.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	Matchers []AlertSilenceMatcher `json:"matchers"`
+
+	// Comment is the comment of the silence in Alertmanager
+	// +optional
+	Comment string `json:"comment,omitempty"`
+
+	// Duration is the time the silence is active, from when HCO first created it. If not set, the silence is
+	// active as long as it is in the list.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// AlertSilenceMatcher matches the alerts by the value of one of their labels
+// +k8s:openapi-gen=true
+type AlertSilenceMatcher struct {
+	// Name is the name of the alert label
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Value is the value of the label; a regular expression if IsRegex is true
+	Value string `json:"value"`
+
+	// IsRegex indicates whether the value is a regular expression
+	// +optional
+	IsRegex bool `json:"isRegex,omitempty"`
+
+	// IsEqual indicates whether the matcher selects the alerts with a matching label value (true), or the alerts with
+	// a non-matching label value (false)
+	// +kubebuilder:default=true
+	// +default=true
+	// +optional
+	IsEqual *bool `json:"isEqual,omitempty"`
+}
+
+// AlertSilenceStatus is the state of an Alertmanager silence that HCO manages
+type AlertSilenceStatus struct {
+	// Name is the name of the silence; the name of a built-in silence, or the name of a user-declared silence, as
+	// used in the spec.alertSilences.silences field
+	Name string `json:"name"`
+
+	// BuiltIn indicates whether this is a built-in silence of HCO (true), or a user-declared one (false)
+	// +optional
+	BuiltIn bool `json:"builtIn,omitempty"`
+
+	// ID is the ID of the silence in Alertmanager
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// State is the state of the silence; one of Active, Expired and Failed
+	State string `json:"state"`
+
+	// StartsAt is the time when the silence started
+	// +optional
+	StartsAt *metav1.Time `json:"startsAt,omitempty"`
+
+	// EndsAt is the time when the silence ends, unless HCO refreshes it
+	// +optional
+	EndsAt *metav1.Time `json:"endsAt,omitempty"`
+
+	// Message is the error HCO hit in the last reconciliation of the silence, if any
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// Alert silence states, as used in the status.alertSilences field
+const (
+	AlertSilenceStateActive  = "Active"
+	AlertSilenceStateExpired = "Expired"
+	AlertSilenceStateFailed  = "Failed"
+)
+
+// Operand names, as used in the spec.operandOverrides field
+const (
+	OperandKubeVirt            = "kubevirt"
+	OperandCDI                 = "cdi"
+	OperandNetworkAddonsConfig = "networkAddonsConfig"
+	OperandSSP                 = "ssp"
+	OperandAAQ                 = "aaq"
+	OperandHostPathProvisioner = "hostPathProvisioner"
+)
+
 const (
 	ConditionAvailable = "Available"
 
@@ -851,16 +1297,24 @@ const (
 	ConditionReconcileComplete = "ReconcileComplete"
 
 	// ConditionTaintedConfiguration indicates that a hidden/debug configuration
-	// has been applied to the HyperConverged resource via a specialized annotation.
+	// has been applied to the HyperConverged resource via a specialized annotation, or via the operandOverrides field.
 	// This condition is exposed only when its value is True, and is otherwise hidden.
 	ConditionTaintedConfiguration = "TaintedConfiguration"
+
+	// ConditionReconcilePaused indicates that HCO does not reconcile some or all of its operands, because of the
+	// spec.reconcilePolicy field.
+	ConditionReconcilePaused = "ReconcilePaused"
+
+	// ConditionAlertmanagerUnavailable indicates that HCO can't manage the alert silences, because the Alertmanager
+	// endpoint is not configured, or is not reachable.
+	// This condition is exposed only when its value is True, and is otherwise hidden.
+	ConditionAlertmanagerUnavailable = "AlertmanagerUnavailable"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // HyperConverged is the Schema for the hyperconvergeds API
 // +k8s:openapi-gen=true
-// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 // +kubebuilder:resource:scope=Namespaced,categories={all},shortName={hco,hcos}
 // +kubebuilder:subresource:status
@@ -868,7 +1322,7 @@ type HyperConverged struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	// +kubebuilder:default={"certConfig": {"ca": {"duration": "48h0m0s", "renewBefore": "24h0m0s"}, "server": {"duration": "24h0m0s", "renewBefore": "12h0m0s"}},"featureGates": {"downwardMetrics": false, "withHostPassthroughCPU": false, "enableCommonBootImageImport": true, "deployTektonTaskResources": false, "deployVmConsoleProxy": false, "deployKubeSecondaryDNS": false, "deployKubevirtIpamController": false, "nonRoot": true, "disableMDevConfiguration": false, "persistentReservation": false, "enableManagedTenantQuota": false, "autoResourceLimits": false, "enableApplicationAwareQuota": false, "primaryUserDefinedNetworkBinding": false}, "liveMigrationConfig": {"completionTimeoutPerGiB": 800, "parallelMigrationsPerCluster": 5, "parallelOutboundMigrationsPerNode": 2, "progressTimeout": 150, "allowAutoConverge": false, "allowPostCopy": false}, "resourceRequirements": {"vmiCPUAllocationRatio": 10}, "uninstallStrategy": "BlockUninstallIfWorkloadsExist", "virtualMachineOptions": {"disableFreePageReporting": false, "disableSerialConsoleLog": true}}
+	// +kubebuilder:default={"certConfig": {"ca": {"duration": "48h0m0s", "renewBefore": "24h0m0s"}, "server": {"duration": "24h0m0s", "renewBefore": "12h0m0s"}},"featureGates": {"downwardMetrics": false, "withHostPassthroughCPU": false, "enableCommonBootImageImport": true, "deployTektonTaskResources": false, "deployVmConsoleProxy": false, "deployKubeSecondaryDNS": false, "deployKubevirtIpamController": false, "nonRoot": true, "disableMDevConfiguration": false, "persistentReservation": false, "enableManagedTenantQuota": false, "autoResourceLimits": false, "enableApplicationAwareQuota": false, "primaryUserDefinedNetworkBinding": false, "enableHostPathProvisioner": false}, "liveMigrationConfig": {"completionTimeoutPerGiB": 800, "parallelOutboundMigrationsPerNode": 2, "progressTimeout": 150, "allowAutoConverge": false, "allowPostCopy": false}, "uninstallStrategy": "BlockUninstallIfWorkloadsExist", "virtualMachineOptions": {"disableFreePageReporting": false, "disableSerialConsoleLog": true}}
 	// +optional
 	Spec   HyperConvergedSpec   `json:"spec,omitempty"`
 	Status HyperConvergedStatus `json:"status,omitempty"`
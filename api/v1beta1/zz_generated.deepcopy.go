@@ -26,14 +26,111 @@ package v1beta1
 
 import (
 	configv1 "github.com/openshift/api/config/v1"
-	apicorev1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
-	corev1 "kubevirt.io/api/core/v1"
+	apicorev1 "kubevirt.io/api/core/v1"
 	v1alpha1 "kubevirt.io/application-aware-quota/staging/src/kubevirt.io/application-aware-quota-api/pkg/apis/core/v1alpha1"
 	corev1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertSilence) DeepCopyInto(out *AlertSilence) {
+	*out = *in
+	if in.Matchers != nil {
+		in, out := &in.Matchers, &out.Matchers
+		*out = make([]AlertSilenceMatcher, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSilence.
+func (in *AlertSilence) DeepCopy() *AlertSilence {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertSilence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertSilenceMatcher) DeepCopyInto(out *AlertSilenceMatcher) {
+	*out = *in
+	if in.IsEqual != nil {
+		in, out := &in.IsEqual, &out.IsEqual
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSilenceMatcher.
+func (in *AlertSilenceMatcher) DeepCopy() *AlertSilenceMatcher {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertSilenceMatcher)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertSilenceStatus) DeepCopyInto(out *AlertSilenceStatus) {
+	*out = *in
+	if in.StartsAt != nil {
+		in, out := &in.StartsAt, &out.StartsAt
+		*out = (*in).DeepCopy()
+	}
+	if in.EndsAt != nil {
+		in, out := &in.EndsAt, &out.EndsAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSilenceStatus.
+func (in *AlertSilenceStatus) DeepCopy() *AlertSilenceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertSilenceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertSilencesConfig) DeepCopyInto(out *AlertSilencesConfig) {
+	*out = *in
+	if in.Silences != nil {
+		in, out := &in.Silences, &out.Silences
+		*out = make([]AlertSilence, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSilencesConfig.
+func (in *AlertSilencesConfig) DeepCopy() *AlertSilencesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertSilencesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationAwareConfigurations) DeepCopyInto(out *ApplicationAwareConfigurations) {
 	*out = *in
@@ -112,9 +209,45 @@ func (in *CertRotateConfigServer) DeepCopy() *CertRotateConfigServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatus.
+func (in *ComponentStatus) DeepCopy() *ComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataImportCronStatus) DeepCopyInto(out *DataImportCronStatus) {
 	*out = *in
+	if in.LastImportTimestamp != nil {
+		in, out := &in.LastImportTimestamp, &out.LastImportTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.FailingCondition != nil {
+		in, out := &in.FailingCondition, &out.FailingCondition
+		*out = new(v1.Condition)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -154,7 +287,7 @@ func (in *DataImportCronTemplate) DeepCopy() *DataImportCronTemplate {
 func (in *DataImportCronTemplateStatus) DeepCopyInto(out *DataImportCronTemplateStatus) {
 	*out = *in
 	in.DataImportCronTemplate.DeepCopyInto(&out.DataImportCronTemplate)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -184,6 +317,54 @@ func (in *HigherWorkloadDensityConfiguration) DeepCopy() *HigherWorkloadDensityC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostPathProvisionerConfig) DeepCopyInto(out *HostPathProvisionerConfig) {
+	*out = *in
+	if in.StoragePools != nil {
+		in, out := &in.StoragePools, &out.StoragePools
+		*out = make([]HostPathStoragePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodePlacement != nil {
+		in, out := &in.NodePlacement, &out.NodePlacement
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPathProvisionerConfig.
+func (in *HostPathProvisionerConfig) DeepCopy() *HostPathProvisionerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HostPathProvisionerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostPathStoragePool) DeepCopyInto(out *HostPathStoragePool) {
+	*out = *in
+	if in.PVCTemplate != nil {
+		in, out := &in.PVCTemplate, &out.PVCTemplate
+		*out = new(corev1.PersistentVolumeClaimSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPathStoragePool.
+func (in *HostPathStoragePool) DeepCopy() *HostPathStoragePool {
+	if in == nil {
+		return nil
+	}
+	out := new(HostPathStoragePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HyperConverged) DeepCopyInto(out *HyperConverged) {
 	*out = *in
@@ -328,6 +509,11 @@ func (in *HyperConvergedFeatureGates) DeepCopyInto(out *HyperConvergedFeatureGat
 		*out = new(bool)
 		**out = **in
 	}
+	if in.EnableHostPathProvisioner != nil {
+		in, out := &in.EnableHostPathProvisioner, &out.EnableHostPathProvisioner
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -493,7 +679,7 @@ func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 	}
 	if in.EvictionStrategy != nil {
 		in, out := &in.EvictionStrategy, &out.EvictionStrategy
-		*out = new(corev1.EvictionStrategy)
+		*out = new(apicorev1.EvictionStrategy)
 		**out = **in
 	}
 	if in.VMStateStorageClass != nil {
@@ -513,12 +699,12 @@ func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 	}
 	if in.KSMConfiguration != nil {
 		in, out := &in.KSMConfiguration, &out.KSMConfiguration
-		*out = new(corev1.KSMConfiguration)
+		*out = new(apicorev1.KSMConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.NetworkBinding != nil {
 		in, out := &in.NetworkBinding, &out.NetworkBinding
-		*out = make(map[string]corev1.InterfaceBindingPlugin, len(*in))
+		*out = make(map[string]apicorev1.InterfaceBindingPlugin, len(*in))
 		for key, val := range *in {
 			(*out)[key] = *val.DeepCopy()
 		}
@@ -528,11 +714,31 @@ func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 		*out = new(ApplicationAwareConfigurations)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HostPathProvisioner != nil {
+		in, out := &in.HostPathProvisioner, &out.HostPathProvisioner
+		*out = new(HostPathProvisionerConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.HigherWorkloadDensity != nil {
 		in, out := &in.HigherWorkloadDensity, &out.HigherWorkloadDensity
 		*out = new(HigherWorkloadDensityConfiguration)
 		**out = **in
 	}
+	if in.OperandOverrides != nil {
+		in, out := &in.OperandOverrides, &out.OperandOverrides
+		*out = new(OperandOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReconcilePolicy != nil {
+		in, out := &in.ReconcilePolicy, &out.ReconcilePolicy
+		*out = new(ReconcilePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlertSilences != nil {
+		in, out := &in.AlertSilences, &out.AlertSilences
+		*out = new(AlertSilencesConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -558,7 +764,7 @@ func (in *HyperConvergedStatus) DeepCopyInto(out *HyperConvergedStatus) {
 	}
 	if in.RelatedObjects != nil {
 		in, out := &in.RelatedObjects, &out.RelatedObjects
-		*out = make([]apicorev1.ObjectReference, len(*in))
+		*out = make([]corev1.ObjectReference, len(*in))
 		copy(*out, *in)
 	}
 	if in.Versions != nil {
@@ -573,6 +779,39 @@ func (in *HyperConvergedStatus) DeepCopyInto(out *HyperConvergedStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.OperandOverrides != nil {
+		in, out := &in.OperandOverrides, &out.OperandOverrides
+		*out = make([]OperandOverrideStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ComponentStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UpgradePatchHistory != nil {
+		in, out := &in.UpgradePatchHistory, &out.UpgradePatchHistory
+		*out = make([]UpgradePatchRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UpgradeProgress != nil {
+		in, out := &in.UpgradeProgress, &out.UpgradeProgress
+		*out = new(UpgradeProgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlertSilences != nil {
+		in, out := &in.AlertSilences, &out.AlertSilences
+		*out = make([]AlertSilenceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -678,7 +917,7 @@ func (in *LogVerbosityConfiguration) DeepCopyInto(out *LogVerbosityConfiguration
 	*out = *in
 	if in.Kubevirt != nil {
 		in, out := &in.Kubevirt, &out.Kubevirt
-		*out = new(corev1.LogVerbosity)
+		*out = new(apicorev1.LogVerbosity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.CDI != nil {
@@ -781,12 +1020,139 @@ func (in *NodeMediatedDeviceTypesConfig) DeepCopy() *NodeMediatedDeviceTypesConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperandManagementStates) DeepCopyInto(out *OperandManagementStates) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperandManagementStates.
+func (in *OperandManagementStates) DeepCopy() *OperandManagementStates {
+	if in == nil {
+		return nil
+	}
+	out := new(OperandManagementStates)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperandOverride) DeepCopyInto(out *OperandOverride) {
+	*out = *in
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]OperandPatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperandOverride.
+func (in *OperandOverride) DeepCopy() *OperandOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(OperandOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperandOverrideStatus) DeepCopyInto(out *OperandOverrideStatus) {
+	*out = *in
+	if in.ChangedPaths != nil {
+		in, out := &in.ChangedPaths, &out.ChangedPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperandOverrideStatus.
+func (in *OperandOverrideStatus) DeepCopy() *OperandOverrideStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OperandOverrideStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperandOverrides) DeepCopyInto(out *OperandOverrides) {
+	*out = *in
+	if in.KubeVirt != nil {
+		in, out := &in.KubeVirt, &out.KubeVirt
+		*out = new(OperandOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CDI != nil {
+		in, out := &in.CDI, &out.CDI
+		*out = new(OperandOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkAddonsConfig != nil {
+		in, out := &in.NetworkAddonsConfig, &out.NetworkAddonsConfig
+		*out = new(OperandOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SSP != nil {
+		in, out := &in.SSP, &out.SSP
+		*out = new(OperandOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AAQ != nil {
+		in, out := &in.AAQ, &out.AAQ
+		*out = new(OperandOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostPathProvisioner != nil {
+		in, out := &in.HostPathProvisioner, &out.HostPathProvisioner
+		*out = new(OperandOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperandOverrides.
+func (in *OperandOverrides) DeepCopy() *OperandOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(OperandOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperandPatch) DeepCopyInto(out *OperandPatch) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperandPatch.
+func (in *OperandPatch) DeepCopy() *OperandPatch {
+	if in == nil {
+		return nil
+	}
+	out := new(OperandPatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperandResourceRequirements) DeepCopyInto(out *OperandResourceRequirements) {
 	*out = *in
 	if in.StorageWorkloads != nil {
 		in, out := &in.StorageWorkloads, &out.StorageWorkloads
-		*out = new(apicorev1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.VmiCPUAllocationRatio != nil {
@@ -861,6 +1227,27 @@ func (in *PermittedHostDevices) DeepCopy() *PermittedHostDevices {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcilePolicy) DeepCopyInto(out *ReconcilePolicy) {
+	*out = *in
+	if in.Operands != nil {
+		in, out := &in.Operands, &out.Operands
+		*out = new(OperandManagementStates)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcilePolicy.
+func (in *ReconcilePolicy) DeepCopy() *ReconcilePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconcilePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageImportConfig) DeepCopyInto(out *StorageImportConfig) {
 	*out = *in
@@ -919,6 +1306,41 @@ func (in *USBSelector) DeepCopy() *USBSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradePatchRecord) DeepCopyInto(out *UpgradePatchRecord) {
+	*out = *in
+	out.Object = in.Object
+	in.AppliedTime.DeepCopyInto(&out.AppliedTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradePatchRecord.
+func (in *UpgradePatchRecord) DeepCopy() *UpgradePatchRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradePatchRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeProgress) DeepCopyInto(out *UpgradeProgress) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeProgress.
+func (in *UpgradeProgress) DeepCopy() *UpgradeProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Version) DeepCopyInto(out *Version) {
 	*out = *in
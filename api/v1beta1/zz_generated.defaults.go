@@ -100,9 +100,9 @@ func SetObjectDefaults_HyperConverged(in *HyperConverged) {
 		var ptrVar1 bool = false
 		in.Spec.FeatureGates.PrimaryUserDefinedNetworkBinding = &ptrVar1
 	}
-	if in.Spec.LiveMigrationConfig.ParallelMigrationsPerCluster == nil {
-		var ptrVar1 uint32 = 5
-		in.Spec.LiveMigrationConfig.ParallelMigrationsPerCluster = &ptrVar1
+	if in.Spec.FeatureGates.EnableHostPathProvisioner == nil {
+		var ptrVar1 bool = false
+		in.Spec.FeatureGates.EnableHostPathProvisioner = &ptrVar1
 	}
 	if in.Spec.LiveMigrationConfig.ParallelOutboundMigrationsPerNode == nil {
 		var ptrVar1 uint32 = 2
@@ -144,17 +144,6 @@ func SetObjectDefaults_HyperConverged(in *HyperConverged) {
 			panic(err)
 		}
 	}
-	if in.Spec.ResourceRequirements != nil {
-		if in.Spec.ResourceRequirements.VmiCPUAllocationRatio == nil {
-			var ptrVar1 int = 10
-			in.Spec.ResourceRequirements.VmiCPUAllocationRatio = &ptrVar1
-		}
-	}
-	if in.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods == nil {
-		if err := json.Unmarshal([]byte(`["LiveMigrate"]`), &in.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods); err != nil {
-			panic(err)
-		}
-	}
 	if in.Spec.WorkloadUpdateStrategy.BatchEvictionSize == nil {
 		var ptrVar1 int = 10
 		in.Spec.WorkloadUpdateStrategy.BatchEvictionSize = &ptrVar1
@@ -31,9 +31,14 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertSilence":                         schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_AlertSilence(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertSilenceMatcher":                  schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_AlertSilenceMatcher(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertSilencesConfig":                  schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_AlertSilencesConfig(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ApplicationAwareConfigurations":       schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ApplicationAwareConfigurations(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigCA":                   schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_CertRotateConfigCA(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigServer":               schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_CertRotateConfigServer(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HostPathProvisionerConfig":            schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HostPathProvisionerConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HostPathStoragePool":                  schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HostPathStoragePool(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConverged":                       schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConverged(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedCertConfig":             schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedCertConfig(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedFeatureGates":           schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedFeatureGates(ref),
@@ -46,15 +51,166 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MediatedDevicesConfiguration":         schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MediatedDevicesConfiguration(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MediatedHostDevice":                   schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MediatedHostDevice(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NodeMediatedDeviceTypesConfig":        schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_NodeMediatedDeviceTypesConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandManagementStates":              schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_OperandManagementStates(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandOverride":                      schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_OperandOverride(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandOverrides":                     schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_OperandOverrides(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandPatch":                         schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_OperandPatch(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandResourceRequirements":          schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_OperandResourceRequirements(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.PciHostDevice":                        schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_PciHostDevice(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.PermittedHostDevices":                 schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_PermittedHostDevices(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ReconcilePolicy":                      schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ReconcilePolicy(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.StorageImportConfig":                  schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_StorageImportConfig(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.USBHostDevice":                        schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_USBHostDevice(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.USBSelector":                          schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_USBSelector(ref),
 	}
 }
 
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_AlertSilence(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertSilence is a user-declared Alertmanager silence",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name identifies the silence in the spec and in the status",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"matchers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Matchers select the alerts to silence. An alert is silenced if it matches all the matchers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertSilenceMatcher"),
+									},
+								},
+							},
+						},
+					},
+					"comment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Comment is the comment of the silence in Alertmanager",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the time the silence is active, from when HCO first created it. If not set, the silence is active as long as it is in the list.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"name", "matchers"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertSilenceMatcher", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_AlertSilenceMatcher(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertSilenceMatcher matches the alerts by the value of one of their labels",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the alert label",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the value of the label; a regular expression if IsRegex is true",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"isRegex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IsRegex indicates whether the value is a regular expression",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"isEqual": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IsEqual indicates whether the matcher selects the alerts with a matching label value (true), or the alerts with a non-matching label value (false)",
+							Default:     true,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "value"},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_AlertSilencesConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertSilencesConfig controls the Alertmanager silences that HCO manages",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"disableBuiltInSilences": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DisableBuiltInSilences stops HCO from creating its built-in silences, like the silence of the PodDisruptionBudgetAtLimit alerts of the KubeVirt VMs. HCO deletes the built-in silences it already created.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"silences": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Silences is the list of the user-declared silences",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertSilence"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertSilence"},
+	}
+}
+
 func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ApplicationAwareConfigurations(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -148,6 +304,87 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_CertRotateConfi
 	}
 }
 
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HostPathProvisionerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HostPathProvisionerConfig holds the HostPathProvisioner (HPP) configurations",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"storagePools": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "StoragePools is the list of the storage pools that HPP provides. Each pool is exposed by a storage class that uses the pool name in its storagePool parameter.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HostPathStoragePool"),
+									},
+								},
+							},
+						},
+					},
+					"nodePlacement": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodePlacement describes the nodes where the HPP workloads run. If not set, the node placement of the spec.workloads field is used.",
+							Ref:         ref("kubevirt.io/controller-lifecycle-operator-sdk/api.NodePlacement"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HostPathStoragePool", "kubevirt.io/controller-lifecycle-operator-sdk/api.NodePlacement"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HostPathStoragePool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HostPathStoragePool is a single storage pool of HPP",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the storage pool",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the path of the storage pool on the node",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pvcTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PVCTemplate is a template of the PVC that backs the storage pool on each node. If not set, the storage pool uses the node file system.",
+							Ref:         ref("k8s.io/api/core/v1.PersistentVolumeClaimSpec"),
+						},
+					},
+				},
+				Required: []string{"name", "path"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.PersistentVolumeClaimSpec"},
+	}
+}
+
 func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConverged(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -351,6 +588,14 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedF
 							Format:      "",
 						},
 					},
+					"enableHostPathProvisioner": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnableHostPathProvisioner if true, HCO deploys the HostPathProvisioner CR, according to the spec.hostPathProvisioner field, that must then include at least one storage pool",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -646,6 +891,12 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ApplicationAwareConfigurations"),
 						},
 					},
+					"hostPathProvisioner": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostPathProvisioner set the HostPathProvisioner (HPP) configurations. Only relevant if the enableHostPathProvisioner feature gate is set.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HostPathProvisionerConfig"),
+						},
+					},
 					"higherWorkloadDensity": {
 						SchemaProps: spec.SchemaProps{
 							Description: "HigherWorkloadDensity holds configurataion aimed to increase virtual machine density",
@@ -653,11 +904,29 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HigherWorkloadDensityConfiguration"),
 						},
 					},
+					"operandOverrides": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OperandOverrides holds typed JSON patches to be applied on top of the operand CRs, as rendered by HCO. This is the supported replacement of the jsonpatch annotations. Please notice that using operand overrides raises the TaintedConfiguration condition.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandOverrides"),
+						},
+					},
+					"reconcilePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReconcilePolicy allows to stop HCO from reconciling the operand CRs, globally or per operand, e.g. for debugging a live cluster. HCO keeps reporting the conditions of the operands that are not reconciled, and raises the ReconcilePaused condition.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ReconcilePolicy"),
+						},
+					},
+					"alertSilences": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AlertSilences controls the Alertmanager silences that HCO manages: its built-in silences, and the user-declared ones. HCO creates the silences, refreshes them before they expire, and deletes the silences that are removed from the list. The state of the silences is reported in the status.alertSilences field. Requires the Prometheus Operator; on clusters other than OpenShift, the Alertmanager endpoint must be configured.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertSilencesConfig"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ApplicationAwareConfigurations", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportCronTemplate", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HigherWorkloadDensityConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedCertConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedFeatureGates", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedObsoleteCPUs", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedWorkloadUpdateStrategy", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LiveMigrationConfigurations", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LogVerbosityConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MediatedDevicesConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandResourceRequirements", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.PermittedHostDevices", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.StorageImportConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VirtualMachineOptions", "github.com/openshift/api/config/v1.TLSSecurityProfile", "kubevirt.io/api/core/v1.InterfaceBindingPlugin", "kubevirt.io/api/core/v1.KSMConfiguration", "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.FilesystemOverhead"},
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertSilencesConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ApplicationAwareConfigurations", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportCronTemplate", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HigherWorkloadDensityConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HostPathProvisionerConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedCertConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedFeatureGates", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedObsoleteCPUs", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedWorkloadUpdateStrategy", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LiveMigrationConfigurations", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LogVerbosityConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MediatedDevicesConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandOverrides", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandResourceRequirements", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.PermittedHostDevices", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ReconcilePolicy", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.StorageImportConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VirtualMachineOptions", "github.com/openshift/api/config/v1.TLSSecurityProfile", "kubevirt.io/api/core/v1.InterfaceBindingPlugin", "kubevirt.io/api/core/v1.KSMConfiguration", "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.FilesystemOverhead"},
 	}
 }
 
@@ -762,11 +1031,99 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 							Format:      "",
 						},
 					},
+					"operandOverrides": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"operand",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "OperandOverrides reports the result of applying the spec.operandOverrides on each one of the operand CRs.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandOverrideStatus"),
+									},
+								},
+							},
+						},
+					},
+					"components": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Components reports the status of each one of the operands managed by HCO.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ComponentStatus"),
+									},
+								},
+							},
+						},
+					},
+					"upgradePatchHistory": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "UpgradePatchHistory is a list of the upgrade patches that HCO applied on upgrades, with the most recent last. Only the last 20 entries are kept.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.UpgradePatchRecord"),
+									},
+								},
+							},
+						},
+					},
+					"upgradeProgress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpgradeProgress records the upgrade of HCO that is in progress. It's removed when the upgrade is completed.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.UpgradeProgress"),
+						},
+					},
+					"alertSilences": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AlertSilences reports the state of the Alertmanager silences that HCO manages",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertSilenceStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportCronTemplateStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.Version", "k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertSilenceStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ComponentStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportCronTemplateStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandOverrideStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.UpgradePatchRecord", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.UpgradeProgress", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.Version", "k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
 	}
 }
 
@@ -784,8 +1141,7 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedW
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "WorkloadUpdateMethods defines the methods that can be used to disrupt workloads during automated workload updates. When multiple methods are present, the least disruptive method takes precedence over more disruptive methods. For example if both LiveMigrate and Evict methods are listed, only VMs which are not live migratable will be restarted/shutdown. An empty list defaults to no automated workload updating.",
-							Default:     []interface{}{"LiveMigrate"},
+							Description: "WorkloadUpdateMethods defines the methods that can be used to disrupt workloads during automated workload updates. When multiple methods are present, the least disruptive method takes precedence over more disruptive methods. For example if both LiveMigrate and Evict methods are listed, only VMs which are not live migratable will be restarted/shutdown. An empty list defaults to no automated workload updating. If not set, LiveMigrate is used, except on single node clusters, where the mutating webhook sets an empty list when the HyperConverged CR is created.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -814,7 +1170,6 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedW
 						},
 					},
 				},
-				Required: []string{"workloadUpdateMethods"},
 			},
 		},
 		Dependencies: []string{
@@ -831,8 +1186,7 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_LiveMigrationCo
 				Properties: map[string]spec.Schema{
 					"parallelMigrationsPerCluster": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Number of migrations running in parallel in the cluster.",
-							Default:     5,
+							Description: "Number of migrations running in parallel in the cluster. If not set, the mutating webhook sets it according to the number of workload nodes when the HyperConverged CR is created, and 5 migrations are used otherwise.",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
@@ -1110,6 +1464,192 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_NodeMediatedDev
 	}
 }
 
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_OperandManagementStates(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperandManagementStates holds the management state of each one of the operand CRs",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubevirt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeVirt is the management state of the KubeVirt CR",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cdi": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CDI is the management state of the CDI CR",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"networkAddonsConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkAddonsConfig is the management state of the NetworkAddonsConfig CR",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ssp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSP is the management state of the SSP CR",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"aaq": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AAQ is the management state of the AAQ CR",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hostPathProvisioner": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostPathProvisioner is the management state of the HostPathProvisioner CR",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_OperandOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperandOverride is a list of JSON patch operations to be applied on the spec of an operand CR",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"patches": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Patches is the list of JSON patch operations. The operations are applied by their order in the list.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandPatch"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"patches"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandPatch"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_OperandOverrides(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperandOverrides holds the overrides of the operand CRs managed by HCO. Each override is applied as a JSON patch (RFC6902) on the corresponding CR, after HCO rendered it.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubevirt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeVirt holds the override of the KubeVirt CR",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandOverride"),
+						},
+					},
+					"cdi": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CDI holds the override of the CDI CR",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandOverride"),
+						},
+					},
+					"networkAddonsConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkAddonsConfig holds the override of the NetworkAddonsConfig CR",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandOverride"),
+						},
+					},
+					"ssp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSP holds the override of the SSP CR",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandOverride"),
+						},
+					},
+					"aaq": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AAQ holds the override of the AAQ CR. Only relevant if the enableApplicationAwareQuota feature gate is set.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandOverride"),
+						},
+					},
+					"hostPathProvisioner": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostPathProvisioner holds the override of the HostPathProvisioner CR. Only relevant if the enableHostPathProvisioner feature gate is set.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandOverride"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandOverride"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_OperandPatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperandPatch is a single JSON patch operation, as defined in RFC6902",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"op": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Op is the patch operation",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is a JSON pointer to the target field. Only fields under /spec/ can be modified.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Description: "From is a JSON pointer to the source field of the move and copy operations",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the value to be used by the add, replace and test operations",
+							Ref:         ref("k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSON"),
+						},
+					},
+				},
+				Required: []string{"op", "path"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSON"},
+	}
+}
+
 func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_OperandResourceRequirements(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1125,8 +1665,7 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_OperandResource
 					},
 					"vmiCPUAllocationRatio": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VmiCPUAllocationRatio defines, for each requested virtual CPU, how much physical CPU to request per VMI from the hosting node. The value is in fraction of a CPU thread (or core on non-hyperthreaded nodes). VMI POD CPU request = number of vCPUs * 1/vmiCPUAllocationRatio For example, a value of 1 means 1 physical CPU thread per VMI CPU thread. A value of 100 would be 1% of a physical thread allocated for each requested VMI thread. This option has no effect on VMIs that request dedicated CPUs. Defaults to 10",
-							Default:     10,
+							Description: "VmiCPUAllocationRatio defines, for each requested virtual CPU, how much physical CPU to request per VMI from the hosting node. The value is in fraction of a CPU thread (or core on non-hyperthreaded nodes). VMI POD CPU request = number of vCPUs * 1/vmiCPUAllocationRatio For example, a value of 1 means 1 physical CPU thread per VMI CPU thread. A value of 100 would be 1% of a physical thread allocated for each requested VMI thread. This option has no effect on VMIs that request dedicated CPUs. Defaults to 10, or to a lower ratio on small clusters, as set by the mutating webhook when the HyperConverged CR is created.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
@@ -1267,6 +1806,43 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_PermittedHostDe
 	}
 }
 
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ReconcilePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReconcilePolicy controls which of the operands are reconciled by HCO",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"paused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Paused stops HCO from creating, updating or deleting any of its operands. HCO reconciles all the operands again, once the pause is lifted.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"operands": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operands sets the management state of specific operand CRs. An Unmanaged operand CR is not modified by HCO, so out-of-band modifications are not reverted.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandManagementStates"),
+						},
+					},
+					"outOfBandChanges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OutOfBandChanges controls the changes in the spec of the managed operand CRs (KubeVirt, CDI, NetworkAddonsConfig, SSP, AAQ and HostPathProvisioner), that are not made by HCO. HCO reverts such changes. Allow accepts them; Warn accepts them with an admission warning, and Deny rejects them. The warning and the rejection message name the HyperConverged field that controls the modified setting. The changes in Unmanaged operand CRs, or while the reconciliation is paused, are always accepted.",
+							Default:     "Allow",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandManagementStates"},
+	}
+}
+
 func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_StorageImportConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -172,8 +172,8 @@ func main() {
 		os.Exit(1)
 	}
 
-	if ci.IsOpenshift() {
-		if err = observability.SetupWithManager(mgr); err != nil {
+	if ci.IsMonitoringAvailable() {
+		if err = observability.SetupWithManager(mgr, operatorNamespace, ci); err != nil {
 			logger.Error(err, "unable to create controller", "controller", "Observability")
 			os.Exit(1)
 		}
@@ -207,6 +207,9 @@ func getCacheOption(operatorNamespace string, isMonitoringAvailable, isOpenshift
 	namespaceSelector := fields.Set{"metadata.namespace": operatorNamespace}.AsSelector()
 	labelSelector := labels.Set{hcoutil.AppLabel: hcoutil.HyperConvergedName}.AsSelector()
 	labelSelectorForNamespace := labels.Set{hcoutil.KubernetesMetadataName: operatorNamespace}.AsSelector()
+	labelSelectorForGoldenImageCrons := labels.Set{hcoutil.AppLabelManagedBy: hcoutil.SSPOperatorName}.AsSelector()
+	labelSelectorForGoldenImageDataSources, err := labels.Parse(hcoutil.DataImportCronLabel)
+	cmdHelper.ExitOnError(err, "can't build the DataSource label selector")
 
 	cacheOptions := cache.Options{
 		ByObject: map[client.Object]cache.ByObject{
@@ -277,6 +280,12 @@ func getCacheOption(operatorNamespace string, isMonitoringAvailable, isOpenshift
 		&consolev1.ConsolePlugin{}: {
 			Label: labelSelector,
 		},
+		&cdiv1beta1.DataImportCron{}: {
+			Label: labelSelectorForGoldenImageCrons,
+		},
+		&cdiv1beta1.DataSource{}: {
+			Label: labelSelectorForGoldenImageDataSources,
+		},
 	}
 
 	if isMonitoringAvailable {
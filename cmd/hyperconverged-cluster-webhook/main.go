@@ -4,8 +4,8 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"maps"
 	"os"
-	"path/filepath"
 
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
@@ -22,10 +22,19 @@ import (
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
+	consolev1 "github.com/openshift/api/console/v1"
+	imagev1 "github.com/openshift/api/image/v1"
+	openshiftroutev1 "github.com/openshift/api/route/v1"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
@@ -38,9 +47,12 @@ import (
 	"github.com/kubevirt/hyperconverged-cluster-operator/cmd/cmdcommon"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/webhooks"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/webhooks/certificates"
 	kubevirtcorev1 "kubevirt.io/api/core/v1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	sspv1beta2 "kubevirt.io/ssp-operator/api/v1beta2"
+
+	aaqv1alpha1 "kubevirt.io/application-aware-quota/staging/src/kubevirt.io/application-aware-quota-api/pkg/apis/core/v1alpha1"
 )
 
 // Change below variables to serve metrics on different host or port.
@@ -59,6 +71,13 @@ var (
 		kubevirtcorev1.AddToScheme,
 		openshiftconfigv1.Install,
 		csvv1alpha1.AddToScheme,
+		schedulingv1.AddToScheme,
+		rbacv1.AddToScheme,
+		consolev1.Install,
+		openshiftroutev1.Install,
+		imagev1.Install,
+		aaqv1alpha1.AddToScheme,
+		extv1.AddToScheme,
 	}
 )
 
@@ -76,20 +95,36 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Make sure the certificates are mounted, this should be handled by the OLM
-	webhookCertDir := webhooks.GetWebhookCertDir()
-	certs := []string{filepath.Join(webhookCertDir, hcoutil.WebhookCertName), filepath.Join(webhookCertDir, hcoutil.WebhookKeyName)}
-	for _, fname := range certs {
-		if _, err := os.Stat(fname); err != nil {
-			logger.Error(err, "CSV certificates were not found, skipping webhook initialization")
-			cmdHelper.ExitOnError(err, "CSV certificates were not found, skipping webhook initialization")
-		}
-	}
-
 	// Setup Scheme for all resources
 	scheme := apiruntime.NewScheme()
 	cmdHelper.AddToScheme(scheme, resourcesSchemeFuncs)
 
+	// apiclient.New() returns a client without cache.
+	// cache is not initialized before mgr.Start()
+	// we need this because we need to interact with OperatorCondition
+	apiClient, err := client.New(cfg, client.Options{
+		Scheme: scheme,
+	})
+	cmdHelper.ExitOnError(err, "Cannot create a new API client")
+
+	// Detect OpenShift version
+	ci := hcoutil.GetClusterInfo()
+	ctx := context.TODO()
+	err = ci.Init(ctx, apiClient, logger)
+	cmdHelper.ExitOnError(err, "Cannot detect cluster type")
+
+	// The certificates are injected by the OLM, or by cert-manager. If they are not mounted, the webhook generates and
+	// rotates its own certificates, until cert-manager issues them
+	webhookCertDir := webhooks.GetWebhookCertDir()
+	var certManager *certificates.Manager
+	if !certificates.CertificatesExist(webhookCertDir) {
+		logger.Info("The webhook certificates were not found; using self-managed certificates")
+		certManager = certificates.NewManager(logger.WithName("certificates"), apiClient, operatorNamespace, webhookCertDir, certificates.SelfManagedCertDir)
+		webhookCertDir = certificates.SelfManagedCertDir
+		err = certManager.Init(ctx)
+		cmdHelper.ExitOnError(err, "Cannot generate the webhook certificates")
+	}
+
 	// Create a new Cmd to provide shared dependencies and start components
 	mgr, err := manager.New(cfg, manager.Options{
 		Metrics: server.Options{
@@ -100,8 +135,9 @@ func main() {
 		LivenessEndpointName:   hcoutil.LivenessEndpointName,
 		LeaderElection:         false,
 		Scheme:                 scheme,
+		Cache:                  getCacheOption(operatorNamespace, ci.IsOpenshift()),
 		WebhookServer: webhook.NewServer(webhook.Options{
-			CertDir:  webhooks.GetWebhookCertDir(),
+			CertDir:  webhookCertDir,
 			CertName: hcoutil.WebhookCertName,
 			KeyName:  hcoutil.WebhookKeyName,
 			Port:     hcoutil.WebhookPort,
@@ -110,28 +146,19 @@ func main() {
 	})
 	cmdHelper.ExitOnError(err, "failed to create manager")
 
-	// apiclient.New() returns a client without cache.
-	// cache is not initialized before mgr.Start()
-	// we need this because we need to interact with OperatorCondition
-	apiClient, err := client.New(mgr.GetConfig(), client.Options{
-		Scheme: mgr.GetScheme(),
-	})
-	cmdHelper.ExitOnError(err, "Cannot create a new API client")
-
 	// register pprof instrumentation if HCO_PPROF_ADDR is set
 	cmdHelper.ExitOnError(cmdHelper.RegisterPPROFServer(mgr), "can't register pprof server")
 
 	logger.Info("Registering Components.")
 
-	// Detect OpenShift version
-	ci := hcoutil.GetClusterInfo()
-	ctx := context.TODO()
-	err = ci.Init(ctx, apiClient, logger)
-	cmdHelper.ExitOnError(err, "Cannot detect cluster type")
-
 	eventEmitter := hcoutil.GetEventEmitter()
 	eventEmitter.Init(ci.GetPod(), ci.GetCSV(), mgr.GetEventRecorderFor(hcoutil.HyperConvergedName))
 
+	if certManager != nil {
+		err = mgr.Add(certManager)
+		cmdHelper.ExitOnError(err, "unable to add the webhook certificate manager")
+	}
+
 	err = mgr.AddHealthzCheck("ping", healthz.Ping)
 	cmdHelper.ExitOnError(err, "unable to add health check")
 
@@ -181,6 +208,60 @@ func main() {
 	}
 }
 
+// Restricts the cache's ListWatch of the objects that the validating webhook dry-runs, to the objects of HCO, as the
+// operator does, to control the memory impact
+func getCacheOption(operatorNamespace string, isOpenshift bool) cache.Options {
+	namespaceSelector := fields.Set{"metadata.namespace": operatorNamespace}.AsSelector()
+	labelSelector := labels.Set{hcoutil.AppLabel: hcoutil.HyperConvergedName}.AsSelector()
+
+	cacheOptions := cache.Options{
+		ByObject: map[client.Object]cache.ByObject{
+			&schedulingv1.PriorityClass{}: {
+				Label: labelSelector,
+			},
+			&corev1.ConfigMap{}: {
+				Label: labelSelector,
+			},
+			&corev1.Service{}: {
+				Field: namespaceSelector,
+			},
+			&rbacv1.Role{}: {
+				Label: labelSelector,
+				Field: namespaceSelector,
+			},
+			&rbacv1.RoleBinding{}: {
+				Label: labelSelector,
+				Field: namespaceSelector,
+			},
+			&appsv1.Deployment{}: {
+				Label: labelSelector,
+				Field: namespaceSelector,
+			},
+		},
+	}
+
+	if isOpenshift {
+		maps.Copy(cacheOptions.ByObject, map[client.Object]cache.ByObject{
+			&openshiftroutev1.Route{}: {
+				Namespaces: map[string]cache.Config{
+					operatorNamespace: {},
+				},
+			},
+			&imagev1.ImageStream{}: {
+				Label: labelSelector,
+			},
+			&consolev1.ConsoleCLIDownload{}: {
+				Label: labelSelector,
+			},
+			&consolev1.ConsolePlugin{}: {
+				Label: labelSelector,
+			},
+		})
+	}
+
+	return cacheOptions
+}
+
 func MutateTLSConfig(cfg *tls.Config) {
 	// This callback executes on each client call returning a new config to be used
 	// please be aware that the APIServer is using http keepalive so this is going to
@@ -11,4 +11,6 @@ const (
 	JSONPatchSSPAnnotationName  = "ssp.kubevirt.io/jsonpatch"
 	// Tuning Policy annotation name
 	TuningPolicyAnnotationName = "hco.kubevirt.io/tuningPolicy"
+	// Restore pre-upgrade snapshot annotation name
+	RestoreUpgradeSnapshotAnnotationName = "hco.kubevirt.io/restoreUpgradeSnapshot"
 )
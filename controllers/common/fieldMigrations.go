@@ -0,0 +1,189 @@
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const listItemsSuffix = "[*]"
+
+// FieldMigration describes a deprecated field of the HyperConverged CR, and the field that replaces it. The mutating
+// webhook applies the migrations at admission, and the reconciler applies them to the stored HyperConverged CR: if the
+// deprecated field is set and the new one is not, the value of the deprecated field is copied to the new field.
+type FieldMigration struct {
+	// OldPath is the path of the deprecated field, under spec, e.g. "spec.a.b". The "[*]" suffix of a path element
+	// selects all the items of a list, e.g. "spec.a[*].b".
+	OldPath string
+	// NewPath is the path of the field that replaces the deprecated one. It must select the same list items as OldPath.
+	NewPath string
+	// Transform converts the value of the deprecated field to the value of the new field. If nil, the value is copied
+	// as is.
+	Transform func(value any) (any, error)
+	// RemovedIn is the HCO release in which the deprecated field is removed, e.g. "1.15.0"
+	RemovedIn string
+}
+
+// FieldMigrations is the registry of the deprecated fields of the HyperConverged CR. Deprecating a field that has a
+// replacement only requires adding an entry here.
+var FieldMigrations = []FieldMigration{
+	{
+		OldPath:   "spec.mediatedDevicesConfiguration.mediatedDevicesTypes",
+		NewPath:   "spec.mediatedDevicesConfiguration.mediatedDeviceTypes",
+		RemovedIn: "1.15.0",
+	},
+	{
+		OldPath:   "spec.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[*].mediatedDevicesTypes",
+		NewPath:   "spec.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[*].mediatedDeviceTypes",
+		RemovedIn: "1.15.0",
+	},
+}
+
+// DeprecatedField is an occurrence of a deprecated field in a specific object
+type DeprecatedField struct {
+	// OldPath is the path of the deprecated field in the object, e.g. "spec.a[1].b"
+	OldPath string
+	// NewPath is the path of the field that replaces it in the object
+	NewPath string
+	// NewPathElements are the elements of NewPath, with the list indexes as separate elements, e.g. ["spec", "a", "1", "b"]
+	NewPathElements []string
+	// Migration is the migration the field belongs to
+	Migration *FieldMigration
+}
+
+// MigratedWarning is the warning about a deprecated field that was migrated
+func (df DeprecatedField) MigratedWarning() string {
+	return fmt.Sprintf("%s: the deprecated field was copied to %s; the field is removed in version %s", df.OldPath, df.NewPath, df.Migration.RemovedIn)
+}
+
+// DeprecatedWarning is the warning about a deprecated field that is set
+func (df DeprecatedField) DeprecatedWarning() string {
+	return fmt.Sprintf("%s: the field is deprecated, and is removed in version %s; use %s instead", df.OldPath, df.Migration.RemovedIn, df.NewPath)
+}
+
+// GetDeprecatedFields returns the deprecated fields that are set in obj, an unstructured HyperConverged CR
+func GetDeprecatedFields(obj map[string]any) ([]DeprecatedField, error) {
+	var fields []DeprecatedField
+	for i := range FieldMigrations {
+		err := FieldMigrations[i].walk(obj, func(container map[string]any, df DeprecatedField, oldPath, _ []string) error {
+			if value, found, _ := unstructured.NestedFieldNoCopy(container, oldPath...); found && !isEmpty(value) {
+				fields = append(fields, df)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return fields, nil
+}
+
+// ApplyFieldMigrations copies the deprecated fields of obj, an unstructured HyperConverged CR, to the fields that
+// replace them, if they are not set, and returns the migrated fields.
+func ApplyFieldMigrations(obj map[string]any) ([]DeprecatedField, error) {
+	var migrated []DeprecatedField
+	for i := range FieldMigrations {
+		fm := &FieldMigrations[i]
+		err := fm.walk(obj, func(container map[string]any, df DeprecatedField, oldPath, newPath []string) error {
+			value, found, _ := unstructured.NestedFieldNoCopy(container, oldPath...)
+			if !found || isEmpty(value) {
+				return nil
+			}
+
+			if newValue, found, _ := unstructured.NestedFieldNoCopy(container, newPath...); found && !isEmpty(newValue) {
+				return nil
+			}
+
+			value = runtime.DeepCopyJSONValue(value)
+			if fm.Transform != nil {
+				var err error
+				if value, err = fm.Transform(value); err != nil {
+					return fmt.Errorf("failed to migrate %s; %w", df.OldPath, err)
+				}
+			}
+
+			if err := unstructured.SetNestedField(container, value, newPath...); err != nil {
+				return err
+			}
+
+			migrated = append(migrated, df)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return migrated, nil
+}
+
+// walk calls fn for each object that the paths of the migration select. The paths passed to fn are relative to this
+// object.
+func (fm *FieldMigration) walk(obj map[string]any, fn func(container map[string]any, df DeprecatedField, oldPath, newPath []string) error) error {
+	oldPath := strings.Split(fm.OldPath, ".")
+	newPath := strings.Split(fm.NewPath, ".")
+
+	return fm.walkPath(obj, oldPath, newPath, nil, nil, fn)
+}
+
+func (fm *FieldMigration) walkPath(obj map[string]any, oldPath, newPath []string, prefix, prefixElements []string, fn func(container map[string]any, df DeprecatedField, oldPath, newPath []string) error) error {
+	for i, element := range oldPath {
+		if !strings.HasSuffix(element, listItemsSuffix) {
+			continue
+		}
+
+		if i >= len(newPath) || strings.Join(oldPath[:i+1], ".") != strings.Join(newPath[:i+1], ".") {
+			return fmt.Errorf("the paths of the %s field migration must select the same list items", fm.OldPath)
+		}
+
+		listPath := append(append([]string{}, oldPath[:i]...), strings.TrimSuffix(element, listItemsSuffix))
+		value, found, _ := unstructured.NestedFieldNoCopy(obj, listPath...)
+		list, ok := value.([]any)
+		if !found || !ok {
+			return nil
+		}
+
+		for index, item := range list {
+			itemObj, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			itemPrefix := append(append([]string{}, prefix...), fmt.Sprintf("%s[%d]", strings.Join(listPath, "."), index))
+			itemPrefixElements := append(append(append([]string{}, prefixElements...), listPath...), strconv.Itoa(index))
+			if err := fm.walkPath(itemObj, oldPath[i+1:], newPath[i+1:], itemPrefix, itemPrefixElements, fn); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	df := DeprecatedField{
+		OldPath:         strings.Join(append(append([]string{}, prefix...), oldPath...), "."),
+		NewPath:         strings.Join(append(append([]string{}, prefix...), newPath...), "."),
+		NewPathElements: append(append([]string{}, prefixElements...), newPath...),
+		Migration:       fm,
+	}
+
+	return fn(obj, df, oldPath, newPath)
+}
+
+func isEmpty(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,135 @@
+package common
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var errTestTransform = errors.New("test transform error")
+
+var _ = Describe("field migrations", func() {
+	var origMigrations []FieldMigration
+
+	BeforeEach(func() {
+		origMigrations = FieldMigrations
+		FieldMigrations = []FieldMigration{
+			{
+				OldPath:   "spec.oldField",
+				NewPath:   "spec.newParent.newField",
+				RemovedIn: "2.0.0",
+			},
+			{
+				OldPath: "spec.items[*].old",
+				NewPath: "spec.items[*].new",
+				Transform: func(value any) (any, error) {
+					return strings.ToUpper(value.(string)), nil
+				},
+				RemovedIn: "3.0.0",
+			},
+		}
+	})
+
+	AfterEach(func() {
+		FieldMigrations = origMigrations
+	})
+
+	newObj := func() map[string]any {
+		return map[string]any{
+			"spec": map[string]any{
+				"oldField": "value",
+				"items": []any{
+					map[string]any{"old": "a"},
+					map[string]any{"old": "b", "new": "C"},
+					map[string]any{"new": "D"},
+				},
+			},
+		}
+	}
+
+	It("should copy the deprecated fields to the empty new fields", func() {
+		obj := newObj()
+
+		migrated, err := ApplyFieldMigrations(obj)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(obj).To(Equal(map[string]any{
+			"spec": map[string]any{
+				"oldField":  "value",
+				"newParent": map[string]any{"newField": "value"},
+				"items": []any{
+					map[string]any{"old": "a", "new": "A"},
+					map[string]any{"old": "b", "new": "C"},
+					map[string]any{"new": "D"},
+				},
+			},
+		}))
+
+		Expect(migrated).To(HaveLen(2))
+		Expect(migrated[0].OldPath).To(Equal("spec.oldField"))
+		Expect(migrated[0].NewPathElements).To(Equal([]string{"spec", "newParent", "newField"}))
+		Expect(migrated[0].MigratedWarning()).To(Equal("spec.oldField: the deprecated field was copied to spec.newParent.newField; the field is removed in version 2.0.0"))
+		Expect(migrated[1].OldPath).To(Equal("spec.items[0].old"))
+		Expect(migrated[1].NewPathElements).To(Equal([]string{"spec", "items", "0", "new"}))
+		Expect(migrated[1].MigratedWarning()).To(Equal("spec.items[0].old: the deprecated field was copied to spec.items[0].new; the field is removed in version 3.0.0"))
+	})
+
+	It("should return the deprecated fields that are set", func() {
+		deprecated, err := GetDeprecatedFields(newObj())
+		Expect(err).ToNot(HaveOccurred())
+
+		warnings := make([]string, 0, len(deprecated))
+		for _, df := range deprecated {
+			warnings = append(warnings, df.DeprecatedWarning())
+		}
+
+		Expect(warnings).To(Equal([]string{
+			"spec.oldField: the field is deprecated, and is removed in version 2.0.0; use spec.newParent.newField instead",
+			"spec.items[0].old: the field is deprecated, and is removed in version 3.0.0; use spec.items[0].new instead",
+			"spec.items[1].old: the field is deprecated, and is removed in version 3.0.0; use spec.items[1].new instead",
+		}))
+	})
+
+	It("should do nothing if the deprecated fields are not set", func() {
+		obj := map[string]any{"spec": map[string]any{"items": []any{map[string]any{"old": ""}}}}
+
+		migrated, err := ApplyFieldMigrations(obj)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(migrated).To(BeEmpty())
+		Expect(obj).To(Equal(map[string]any{"spec": map[string]any{"items": []any{map[string]any{"old": ""}}}}))
+	})
+
+	It("should fail if the paths of a migration select different lists", func() {
+		FieldMigrations = []FieldMigration{{OldPath: "spec.items[*].old", NewPath: "spec.other[*].new"}}
+
+		_, err := ApplyFieldMigrations(newObj())
+		Expect(err).To(MatchError(ContainSubstring("must select the same list items")))
+	})
+
+	It("should return the errors of the transform function", func() {
+		obj := newObj()
+		obj["spec"].(map[string]any)["items"] = []any{map[string]any{"old": "a"}}
+		FieldMigrations[1].Transform = func(value any) (any, error) {
+			return nil, errTestTransform
+		}
+
+		_, err := ApplyFieldMigrations(obj)
+		Expect(err).To(MatchError(errTestTransform))
+	})
+
+	It("should have valid paths in the registry", func() {
+		FieldMigrations = origMigrations
+		for _, fm := range FieldMigrations {
+			Expect(fm.OldPath).To(HavePrefix("spec."))
+			Expect(fm.NewPath).To(HavePrefix("spec."))
+			_, err := semver.Parse(fm.RemovedIn)
+			Expect(err).ToNot(HaveOccurred(), "RemovedIn must be an HCO release version")
+		}
+
+		_, err := GetDeprecatedFields(map[string]any{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
@@ -0,0 +1,398 @@
+package commontestutils
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// The fake client does not support server-side apply. HcoTestClient emulates it, with a simplified field ownership
+// tracking: lists are atomic, and only the spec, the labels, the annotations and the owner references are tracked.
+//
+// The emulation also tracks the fields modified by Update calls, to simulate out-of-band modifications by other
+// managers.
+
+// TestFieldManager is the field manager of Update calls that do not specify a field owner
+const TestFieldManager = "hco-test-client"
+
+func objectLeaves(obj map[string]any) [][]string {
+	var leaves [][]string
+
+	var walk func(parent []string, m map[string]any)
+	walk = func(parent []string, m map[string]any) {
+		for key, value := range m {
+			p := append(slices.Clone(parent), key)
+			if child, ok := value.(map[string]any); ok && len(child) > 0 {
+				walk(p, child)
+				continue
+			}
+			leaves = append(leaves, p)
+		}
+	}
+
+	if spec, ok := obj["spec"]; ok {
+		walk(nil, map[string]any{"spec": spec})
+	}
+
+	if metadata, ok := obj["metadata"].(map[string]any); ok {
+		for _, field := range []string{"labels", "annotations", "ownerReferences"} {
+			if value, ok := metadata[field]; ok {
+				walk([]string{"metadata"}, map[string]any{field: value})
+			}
+		}
+	}
+
+	return leaves
+}
+
+func toFieldSet(leaves [][]string) *fieldpath.Set {
+	set := &fieldpath.Set{}
+	for _, leaf := range leaves {
+		p := make([]any, len(leaf))
+		for i, name := range leaf {
+			p[i] = name
+		}
+		set.Insert(fieldpath.MakePathOrDie(p...))
+	}
+	return set
+}
+
+func toNames(p fieldpath.Path) ([]string, bool) {
+	names := make([]string, len(p))
+	for i, pe := range p {
+		if pe.FieldName == nil {
+			return nil, false
+		}
+		names[i] = *pe.FieldName
+	}
+	return names, true
+}
+
+func hasPrefix(names, prefix []string) bool {
+	return len(prefix) <= len(names) && slices.Equal(prefix, names[:len(prefix)])
+}
+
+// isPrefix returns true if prefix is the path p, or one of its parents
+func isPrefix(prefix []string, p fieldpath.Path) bool {
+	names, ok := toNames(p)
+	return ok && hasPrefix(names, prefix)
+}
+
+func newManagedFieldsEntry(manager string, operation metav1.ManagedFieldsOperationType, apiVersion string, set *fieldpath.Set) metav1.ManagedFieldsEntry {
+	raw, err := set.ToJSON()
+	if err != nil {
+		panic(err)
+	}
+	return metav1.ManagedFieldsEntry{
+		Manager:    manager,
+		Operation:  operation,
+		APIVersion: apiVersion,
+		Time:       ptr.To(metav1.Now()),
+		FieldsType: "FieldsV1",
+		FieldsV1:   &metav1.FieldsV1{Raw: raw},
+	}
+}
+
+func readFieldSet(entry metav1.ManagedFieldsEntry) *fieldpath.Set {
+	set := &fieldpath.Set{}
+	if entry.FieldsV1 != nil {
+		if err := set.FromJSON(bytes.NewReader(entry.FieldsV1.Raw)); err != nil {
+			panic(err)
+		}
+	}
+	return set
+}
+
+// removeFields removes the members of the entries that are under one of the paths. Entries with no members are
+// removed.
+func removeFields(entries []metav1.ManagedFieldsEntry, paths [][]string, keep func(metav1.ManagedFieldsEntry) bool) []metav1.ManagedFieldsEntry {
+	var newEntries []metav1.ManagedFieldsEntry
+	for _, entry := range entries {
+		if keep(entry) {
+			newEntries = append(newEntries, entry)
+			continue
+		}
+
+		set := readFieldSet(entry)
+		newSet := &fieldpath.Set{}
+		set.Iterate(func(mp fieldpath.Path) {
+			if !slices.ContainsFunc(paths, func(p []string) bool { return isPrefix(p, mp) }) {
+				newSet.Insert(mp)
+			}
+		})
+
+		if !newSet.Empty() {
+			raw, err := newSet.ToJSON()
+			if err != nil {
+				panic(err)
+			}
+			entry.FieldsV1 = &metav1.FieldsV1{Raw: raw}
+			newEntries = append(newEntries, entry)
+		}
+	}
+	return newEntries
+}
+
+// removeField removes the field, and its parents if they become empty
+func removeField(obj map[string]any, names []string) {
+	unstructured.RemoveNestedField(obj, names...)
+	for i := len(names) - 1; i > 0; i-- {
+		parent, found, _ := unstructured.NestedMap(obj, names[:i]...)
+		if !found || len(parent) > 0 {
+			return
+		}
+		unstructured.RemoveNestedField(obj, names[:i]...)
+	}
+}
+
+func mergeInto(dst, src map[string]any) {
+	for key, value := range src {
+		srcMap, srcIsMap := value.(map[string]any)
+		dstMap, dstIsMap := dst[key].(map[string]any)
+		if srcIsMap && dstIsMap && len(srcMap) > 0 {
+			mergeInto(dstMap, srcMap)
+			continue
+		}
+		dst[key] = runtime.DeepCopyJSONValue(value)
+	}
+}
+
+func copyToObject(u *unstructured.Unstructured, obj client.Object) error {
+	if target, ok := obj.(*unstructured.Unstructured); ok {
+		target.Object = u.DeepCopy().Object
+		return nil
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj)
+}
+
+func (c *HcoTestClient) apply(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
+	patchOpts := &client.PatchOptions{}
+	patchOpts.ApplyOptions(opts)
+	manager := patchOpts.FieldManager
+
+	gvk, err := apiutil.GVKForObject(obj, c.client.Scheme())
+	if err != nil {
+		return err
+	}
+
+	applied, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return err
+	}
+	appliedLeaves := objectLeaves(applied)
+	appliedEntry := newManagedFieldsEntry(manager, metav1.ManagedFieldsOperationApply, gvk.GroupVersion().String(), toFieldSet(appliedLeaves))
+
+	if err = checkDeadline(ctx); err != nil {
+		return err
+	}
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(gvk)
+	err = c.client.Get(ctx, client.ObjectKeyFromObject(obj), existing)
+	if apierrors.IsNotFound(err) {
+		if c.createError != nil {
+			if err = c.createError(obj); err != nil {
+				return err
+			}
+		}
+
+		created := &unstructured.Unstructured{Object: applied}
+		created.SetManagedFields([]metav1.ManagedFieldsEntry{appliedEntry})
+		if err = c.client.Create(ctx, created, &client.CreateOptions{DryRun: patchOpts.DryRun}); err != nil {
+			return err
+		}
+		return copyToObject(created, obj)
+	} else if err != nil {
+		return err
+	}
+
+	if c.updateError != nil {
+		if err = c.updateError(obj); err != nil {
+			return err
+		}
+	}
+
+	if err = checkDeadline(ctx); err != nil {
+		return err
+	}
+
+	isOwnEntry := func(entry metav1.ManagedFieldsEntry) bool {
+		return entry.Manager == manager && entry.Operation == metav1.ManagedFieldsOperationApply
+	}
+
+	// remove the fields that were applied before, but not anymore
+	for _, entry := range existing.GetManagedFields() {
+		if !isOwnEntry(entry) {
+			continue
+		}
+		readFieldSet(entry).Iterate(func(mp fieldpath.Path) {
+			names, ok := toNames(mp)
+			if ok && !slices.ContainsFunc(appliedLeaves, func(p []string) bool {
+				return hasPrefix(names, p) || hasPrefix(p, names)
+			}) {
+				removeField(existing.Object, names)
+			}
+		})
+	}
+
+	mergeInto(existing.Object, applied)
+
+	// the apply forces the ownership of all the applied fields
+	entries := removeFields(existing.GetManagedFields(), appliedLeaves, func(metav1.ManagedFieldsEntry) bool { return false })
+	entries = slices.DeleteFunc(entries, isOwnEntry)
+	existing.SetManagedFields(append(entries, appliedEntry))
+
+	if err = c.client.Update(ctx, existing, &client.UpdateOptions{DryRun: patchOpts.DryRun}); err != nil {
+		return err
+	}
+	return copyToObject(existing, obj)
+}
+
+// trackCreatedFields sets the managed fields of an object created with a field owner
+func (c *HcoTestClient) trackCreatedFields(obj client.Object, opts ...client.CreateOption) {
+	createOpts := &client.CreateOptions{}
+	createOpts.ApplyOptions(opts)
+	if createOpts.FieldManager == "" || len(obj.GetManagedFields()) > 0 {
+		return
+	}
+
+	gvk, err := apiutil.GVKForObject(obj, c.client.Scheme())
+	if err != nil {
+		return
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return
+	}
+
+	if leaves := objectLeaves(content); len(leaves) > 0 {
+		obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+			newManagedFieldsEntry(createOpts.FieldManager, metav1.ManagedFieldsOperationUpdate, gvk.GroupVersion().String(), toFieldSet(leaves)),
+		})
+	}
+}
+
+// trackUpdatedFields moves the ownership of the fields modified by an Update call, to the field manager of the call
+func (c *HcoTestClient) trackUpdatedFields(ctx context.Context, obj client.Object, opts ...client.UpdateOption) {
+	updateOpts := &client.UpdateOptions{}
+	updateOpts.ApplyOptions(opts)
+	manager := updateOpts.FieldManager
+	if manager == "" {
+		manager = TestFieldManager
+	}
+
+	gvk, err := apiutil.GVKForObject(obj, c.client.Scheme())
+	if err != nil {
+		return
+	}
+
+	stored := &unstructured.Unstructured{}
+	stored.SetGroupVersionKind(gvk)
+	if err = c.client.Get(ctx, client.ObjectKeyFromObject(obj), stored); err != nil {
+		return
+	}
+
+	// if the managed fields are not tracked, or if they are explicitly modified, don't touch them
+	if len(stored.GetManagedFields()) == 0 || !sameManagedFields(stored.GetManagedFields(), obj.GetManagedFields()) {
+		return
+	}
+
+	updated, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return
+	}
+
+	var changed, modified [][]string
+	for _, leaf := range objectLeaves(updated) {
+		newValue, _, _ := unstructured.NestedFieldNoCopy(updated, leaf...)
+		oldValue, found, _ := unstructured.NestedFieldNoCopy(stored.Object, leaf...)
+		if !found || !reflect.DeepEqual(newValue, oldValue) {
+			changed = append(changed, leaf)
+			modified = append(modified, leaf)
+		}
+	}
+	for _, leaf := range objectLeaves(stored.Object) {
+		if _, found, _ := unstructured.NestedFieldNoCopy(updated, leaf...); !found {
+			changed = append(changed, leaf)
+		}
+	}
+
+	if len(changed) == 0 {
+		return
+	}
+
+	isOwnEntry := func(entry metav1.ManagedFieldsEntry) bool {
+		return entry.Manager == manager && entry.Operation == metav1.ManagedFieldsOperationUpdate
+	}
+
+	entries := removeFields(stored.GetManagedFields(), changed, isOwnEntry)
+	ownSet := toFieldSet(modified)
+	if idx := slices.IndexFunc(entries, isOwnEntry); idx >= 0 {
+		ownSet = ownSet.Union(readFieldSet(entries[idx]))
+		entries = slices.Delete(entries, idx, idx+1)
+	}
+	if !ownSet.Empty() {
+		entries = append(entries, newManagedFieldsEntry(manager, metav1.ManagedFieldsOperationUpdate, gvk.GroupVersion().String(), ownSet))
+	}
+
+	obj.SetManagedFields(entries)
+}
+
+func sameManagedFields(a, b []metav1.ManagedFieldsEntry) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Manager != b[i].Manager || a[i].Operation != b[i].Operation ||
+			(a[i].FieldsV1 == nil) != (b[i].FieldsV1 == nil) ||
+			(a[i].FieldsV1 != nil && !bytes.Equal(a[i].FieldsV1.Raw, b[i].FieldsV1.Raw)) {
+			return false
+		}
+	}
+	return true
+}
+
+// withLegacyManagedFields adds managed fields to objects that have none, as if their spec and annotations were set by
+// HCO, before it used server-side apply
+func withLegacyManagedFields(objs []client.Object) []client.Object {
+	for _, obj := range objs {
+		if len(obj.GetManagedFields()) > 0 {
+			continue
+		}
+
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			continue
+		}
+
+		leaves := slices.DeleteFunc(objectLeaves(content), func(leaf []string) bool {
+			return hasPrefix(leaf, []string{"metadata", "labels"}) || hasPrefix(leaf, []string{"metadata", "ownerReferences"})
+		})
+		if len(leaves) == 0 {
+			continue
+		}
+
+		gvk, err := apiutil.GVKForObject(obj, GetScheme())
+		if err != nil {
+			continue
+		}
+
+		obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+			newManagedFieldsEntry(hcoutil.LegacyFieldManager, metav1.ManagedFieldsOperationUpdate, gvk.GroupVersion().String(), toFieldSet(leaves)),
+		})
+	}
+	return objs
+}
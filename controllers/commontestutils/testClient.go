@@ -7,6 +7,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -56,6 +57,8 @@ func (c *HcoTestClient) Create(ctx context.Context, obj client.Object, opts ...c
 		return err
 	}
 
+	c.trackCreatedFields(obj, opts...)
+
 	return c.client.Create(ctx, obj, opts...)
 }
 
@@ -84,6 +87,8 @@ func (c *HcoTestClient) Update(ctx context.Context, obj client.Object, opts ...c
 		return err
 	}
 
+	c.trackUpdatedFields(ctx, obj, opts...)
+
 	return c.client.Update(ctx, obj, opts...)
 }
 
@@ -96,6 +101,9 @@ func checkDeadline(ctx context.Context) error {
 }
 
 func (c *HcoTestClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if patch.Type() == types.ApplyPatchType {
+		return c.apply(ctx, obj, opts...)
+	}
 	return c.client.Patch(ctx, obj, patch, opts...)
 }
 
@@ -181,7 +189,7 @@ func (errs *TestErrors) GetNextError() (bool, error) {
 func InitClient(clientObjects []client.Object) *HcoTestClient {
 	// Create a fake client to mock API calls
 	cl := fake.NewClientBuilder().
-		WithObjects(clientObjects...).
+		WithObjects(withLegacyManagedFields(clientObjects)...).
 		WithScheme(GetScheme()).
 		WithStatusSubresource(clientObjects...).
 		Build()
@@ -0,0 +1,38 @@
+package hyperconverged
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+// applyFieldMigrations applies the field migrations of the common.FieldMigrations registry to the stored HyperConverged
+// CR, as the mutating webhook does at admission, so the CRs that were stored before a migration was added, are
+// migrated too.
+func (r *ReconcileHyperConverged) applyFieldMigrations(req *common.HcoRequest) error {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(req.Instance)
+	if err != nil {
+		return err
+	}
+
+	migrated, err := common.ApplyFieldMigrations(obj)
+	if err != nil || len(migrated) == 0 {
+		return err
+	}
+
+	instance := &hcov1beta1.HyperConverged{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj, instance); err != nil {
+		return err
+	}
+	req.Instance.Spec = instance.Spec
+	req.Dirty = true
+
+	for _, df := range migrated {
+		req.Logger.Info("migrated a deprecated field", "field", df.OldPath, "newField", df.NewPath)
+		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, "DeprecatedFieldMigrated", df.MigratedWarning())
+	}
+
+	return nil
+}
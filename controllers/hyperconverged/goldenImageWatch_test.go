@@ -0,0 +1,46 @@
+package hyperconverged
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+)
+
+var _ = Describe("test the golden image watch predicate", func() {
+	It("should pass the status changes of a DataImportCron", func() {
+		oldCron := &cdiv1beta1.DataImportCron{}
+		newCron := oldCron.DeepCopy()
+		newCron.Status.LastImportTimestamp = &metav1.Time{}
+
+		Expect(goldenImageStatusChangedPredicate{}.Update(event.UpdateEvent{ObjectOld: oldCron, ObjectNew: newCron})).To(BeTrue())
+	})
+
+	It("should filter out the DataImportCron updates that don't change the status", func() {
+		oldCron := &cdiv1beta1.DataImportCron{}
+		newCron := oldCron.DeepCopy()
+		newCron.ResourceVersion = "2"
+		newCron.Annotations = map[string]string{"key": "value"}
+
+		Expect(goldenImageStatusChangedPredicate{}.Update(event.UpdateEvent{ObjectOld: oldCron, ObjectNew: newCron})).To(BeFalse())
+	})
+
+	It("should pass the status changes of a DataSource", func() {
+		oldDS := &cdiv1beta1.DataSource{}
+		newDS := oldDS.DeepCopy()
+		newDS.Status.Conditions = []cdiv1beta1.DataSourceCondition{{Type: cdiv1beta1.DataSourceReady}}
+
+		Expect(goldenImageStatusChangedPredicate{}.Update(event.UpdateEvent{ObjectOld: oldDS, ObjectNew: newDS})).To(BeTrue())
+	})
+
+	It("should filter out the DataSource updates that don't change the status", func() {
+		oldDS := &cdiv1beta1.DataSource{}
+		newDS := oldDS.DeepCopy()
+		newDS.ResourceVersion = "2"
+
+		Expect(goldenImageStatusChangedPredicate{}.Update(event.UpdateEvent{ObjectOld: oldDS, ObjectNew: newDS})).To(BeFalse())
+	})
+})
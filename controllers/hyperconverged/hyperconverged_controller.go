@@ -8,6 +8,8 @@ import (
 	"os"
 	"reflect"
 	"slices"
+	"strings"
+	"time"
 
 	"github.com/blang/semver/v4"
 	jsonpatch "github.com/evanphx/json-patch/v5"
@@ -34,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -64,7 +67,7 @@ var (
 const (
 	// We cannot set owner reference of cluster-wide resources to namespaced HyperConverged object. Therefore,
 	// use finalizers to manage the cleanup.
-	FinalizerName = "kubevirt.io/hyperconverged"
+	FinalizerName = hcoutil.HyperConvergedFinalizerName
 
 	// OpenshiftNamespace is for resources that belong in the openshift namespace
 
@@ -76,12 +79,27 @@ const (
 	invalidRequestMessageFormat = "Request does not match expected name (%v) and namespace (%v)"
 	commonDegradedReason        = "HCODegraded"
 	commonProgressingReason     = "HCOProgressing"
+	upgradingReason             = "HCOUpgrading"
+	upgradingMessagePrefix      = "HCO is now upgrading to version "
 	taintedConfigurationReason  = "UnsupportedFeatureAnnotation"
 	taintedConfigurationMessage = "Unsupported feature was activated via an HCO annotation"
+	taintedOverrideReason       = "UnsupportedOperandOverride"
+	taintedOverrideMessage      = "Unsupported feature was activated via the spec.operandOverrides field"
 	systemHealthStatusHealthy   = "healthy"
 	systemHealthStatusWarning   = "warning"
 	systemHealthStatusError     = "error"
 
+	reconcilePausedReason              = "ReconcilePaused"
+	reconcilePausedMessage             = "The reconciliation of all the operands is paused by the spec.reconcilePolicy field"
+	reconcilePausedUnmanagedReason     = "UnmanagedOperands"
+	reconcilePausedUnmanagedMessageFmt = "The following operands are Unmanaged by the spec.reconcilePolicy field: %s"
+
+	upgradeBlockedReason           = "UpgradeBlocked"
+	upgradeBlockedMessagePrefix    = "The upgrade is blocked: "
+	upgradeAssertionsRetryInterval = time.Minute
+	upgradePatchAppliedReason      = "UpgradePatchApplied"
+	maxUpgradePatchHistory         = 20
+
 	hcoVersionName    = "operator"
 	secondaryCRPrefix = "hco-controlled-cr-"
 	apiServerCRPrefix = "api-server-cr-"
@@ -90,6 +108,12 @@ const (
 	v2vGroup = "v2v.kubevirt.io"
 
 	requestedStatusKey = "requested status"
+
+	// the sources that trigger a reconciliation, for the reconcile metrics
+	reconcileTriggerHyperConverged = "hyperconverged"
+	reconcileTriggerAPIServer      = "apiserver"
+	reconcileTriggerSecondary      = "secondary"
+	reconcileTriggerUnknown        = "unknown"
 )
 
 // JSONPatchAnnotationNames - annotations used to patch operand CRs with unsupported/unofficial/hidden features.
@@ -101,6 +125,10 @@ var JSONPatchAnnotationNames = []string{
 	common.JSONPatchSSPAnnotationName,
 }
 
+// operandOverridesMetricLabelPrefix is the prefix of the kubevirt_hco_unsafe_modifications label, for the
+// modifications done using the spec.operandOverrides field. The operand name is appended to it.
+const operandOverridesMetricLabelPrefix = "spec.operandOverrides."
+
 // RegisterReconciler creates a new HyperConverged Reconciler and registers it into manager.
 func RegisterReconciler(mgr manager.Manager, ci hcoutil.ClusterInfo, upgradeableCond hcoutil.Condition) error {
 	return add(mgr, newReconciler(mgr, ci, upgradeableCond), ci)
@@ -182,6 +210,7 @@ func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo) er
 		&cdiv1beta1.CDI{},
 		&networkaddonsv1.NetworkAddonsConfig{},
 		&aaqv1alpha1.AAQ{},
+		operands.NewEmptyHostPathProvisioner(),
 		&schedulingv1.PriorityClass{},
 		&corev1.ConfigMap{},
 		&corev1.Service{},
@@ -228,6 +257,14 @@ func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo) er
 		}
 	}
 
+	if ci.IsOpenshift() {
+		// Watch the golden images separately: the cache only holds the objects with the SSP and CDI labels, and only
+		// the changes of their spec, status or labels are interesting for the golden image status
+		if err = watchGoldenImages(c, mgr, secCRPlaceholder); err != nil {
+			return err
+		}
+	}
+
 	apiServerCRPlaceholder, err := getAPIServerCRPlaceholder()
 	if err != nil {
 		return err
@@ -258,6 +295,60 @@ func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo) er
 	return nil
 }
 
+func watchGoldenImages(c controller.Controller, mgr manager.Manager, secCRPlaceholder types.NamespacedName) error {
+	goldenImageResources := map[client.Object]predicate.Predicate{
+		&cdiv1beta1.DataImportCron{}: predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			return obj.GetLabels()[hcoutil.AppLabelManagedBy] == hcoutil.SSPOperatorName
+		}),
+		&cdiv1beta1.DataSource{}: predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			_, ok := obj.GetLabels()[hcoutil.DataImportCronLabel]
+			return ok
+		}),
+	}
+
+	for resource, labelPredicate := range goldenImageResources {
+		msg := fmt.Sprintf("Reconciling for %T", resource)
+		err := c.Watch(
+			source.Kind(mgr.GetCache(), resource,
+				handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+					log.Info(msg)
+					return []reconcile.Request{
+						{NamespacedName: secCRPlaceholder},
+					}
+				}),
+				labelPredicate,
+				predicate.Or[client.Object](
+					predicate.GenerationChangedPredicate{},
+					predicate.LabelChangedPredicate{},
+					goldenImageStatusChangedPredicate{},
+				),
+			))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// goldenImageStatusChangedPredicate passes the updates of the status of the DataImportCrons and the DataSources, that
+// don't change their generation
+type goldenImageStatusChangedPredicate struct {
+	predicate.Funcs
+}
+
+func (goldenImageStatusChangedPredicate) Update(e event.UpdateEvent) bool {
+	switch oldObj := e.ObjectOld.(type) {
+	case *cdiv1beta1.DataImportCron:
+		newObj, ok := e.ObjectNew.(*cdiv1beta1.DataImportCron)
+		return !ok || !reflect.DeepEqual(oldObj.Status, newObj.Status)
+	case *cdiv1beta1.DataSource:
+		newObj, ok := e.ObjectNew.(*cdiv1beta1.DataSource)
+		return !ok || !reflect.DeepEqual(oldObj.Status, newObj.Status)
+	}
+	return true
+}
+
 var _ reconcile.Reconciler = &ReconcileHyperConverged{}
 
 // ReconcileHyperConverged reconciles a HyperConverged object
@@ -280,13 +371,22 @@ type ReconcileHyperConverged struct {
 // Note:
 // The Controller will requeue the Request to be processed again if the returned error is non-nil or
 // Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
-func (r *ReconcileHyperConverged) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+func (r *ReconcileHyperConverged) Reconcile(ctx context.Context, request reconcile.Request) (result reconcile.Result, err error) {
 	logger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
 
-	resolvedRequest, hcoTriggered, err := r.resolveReconcileRequest(ctx, logger, request)
+	start := time.Now()
+	trigger := reconcileTriggerUnknown
+	defer func() {
+		requeued := err != nil || result.Requeue || result.RequeueAfter > 0
+		metrics.ObserveReconcile(trigger, time.Since(start), requeued)
+	}()
+
+	resolvedRequest, trigger, err := r.resolveReconcileRequest(ctx, logger, request)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
+	// consider a change in APIServerCr like a change in HCO
+	hcoTriggered := trigger != reconcileTriggerSecondary
 	hcoRequest := common.NewHcoRequest(ctx, resolvedRequest, log, r.upgradeMode, hcoTriggered)
 
 	if hcoTriggered {
@@ -308,6 +408,9 @@ func (r *ReconcileHyperConverged) Reconcile(ctx context.Context, request reconci
 
 	if instance == nil {
 		// if the HyperConverged CR was deleted during an upgrade process, then this is not an upgrade anymore
+		if r.upgradeMode {
+			metrics.SetUpgradeCompleted()
+		}
 		r.upgradeMode = false
 		if err == nil {
 			err = r.setOperatorUpgradeableStatus(hcoRequest)
@@ -330,7 +433,7 @@ func (r *ReconcileHyperConverged) Reconcile(ctx context.Context, request reconci
 		return reconcile.Result{}, err
 	}
 
-	result, err := r.doReconcile(hcoRequest)
+	result, err = r.doReconcile(hcoRequest)
 	if err != nil {
 		r.eventEmitter.EmitEvent(hcoRequest.Instance, corev1.EventTypeWarning, "ReconcileError", err.Error())
 		return result, err
@@ -349,21 +452,21 @@ func (r *ReconcileHyperConverged) Reconcile(ctx context.Context, request reconci
 }
 
 // resolveReconcileRequest returns a reconcile.Request to be used throughout the reconciliation cycle,
-// regardless of which resource has triggered it.
-func (r *ReconcileHyperConverged) resolveReconcileRequest(ctx context.Context, logger logr.Logger, originalRequest reconcile.Request) (reconcile.Request, bool, error) {
+// regardless of which resource has triggered it, and the source that triggered it.
+func (r *ReconcileHyperConverged) resolveReconcileRequest(ctx context.Context, logger logr.Logger, originalRequest reconcile.Request) (reconcile.Request, string, error) {
 
 	hcoTriggered, err := isTriggeredByHyperConverged(originalRequest)
 	if err != nil {
-		return reconcile.Request{}, hcoTriggered, err
+		return reconcile.Request{}, reconcileTriggerUnknown, err
 	}
 	if hcoTriggered {
 		logger.Info("Reconciling HyperConverged operator")
-		return originalRequest, hcoTriggered, nil
+		return originalRequest, reconcileTriggerHyperConverged, nil
 	}
 
 	hc, err := getHyperConvergedNamespacedName()
 	if err != nil {
-		return reconcile.Request{}, hcoTriggered, err
+		return reconcile.Request{}, reconcileTriggerUnknown, err
 	}
 	resolvedRequest := reconcile.Request{
 		NamespacedName: hc,
@@ -371,21 +474,19 @@ func (r *ReconcileHyperConverged) resolveReconcileRequest(ctx context.Context, l
 
 	apiServerCRTriggered, err := isTriggeredByAPIServerCR(originalRequest)
 	if err != nil {
-		return reconcile.Request{}, hcoTriggered, err
+		return reconcile.Request{}, reconcileTriggerUnknown, err
 	}
 	if apiServerCRTriggered {
 		logger.Info("Triggered by ApiServer CR, refreshing it")
 		err = hcoutil.GetClusterInfo().RefreshAPIServerCR(ctx, r.client)
 		if err != nil {
-			return reconcile.Request{}, hcoTriggered, err
+			return reconcile.Request{}, reconcileTriggerAPIServer, err
 		}
-		// consider a change in APIServerCr like a change in HCO
-		hcoTriggered = true
-		return resolvedRequest, hcoTriggered, nil
+		return resolvedRequest, reconcileTriggerAPIServer, nil
 	}
 
 	logger.Info("The reconciliation got triggered by a secondary CR object")
-	return resolvedRequest, hcoTriggered, nil
+	return resolvedRequest, reconcileTriggerSecondary, nil
 }
 
 func isTriggeredByHyperConverged(request reconcile.Request) (bool, error) {
@@ -453,23 +554,67 @@ func (r *ReconcileHyperConverged) doReconcile(req *common.HcoRequest) (reconcile
 		// get into upgrade mode
 
 		r.upgradeMode = true
+		metrics.SetUpgradeStarted(knownHcoVersion, r.ownVersion, r.getUpgradeStartTime(req), r.operandHandler.GetUpgradeComponents(req.Instance))
 		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "UpgradeHCO", "Upgrading the HyperConverged to version "+r.ownVersion)
 		req.Logger.Info(fmt.Sprintf("Start upgrading from version %s to version %s", knownHcoVersion, r.ownVersion))
 	}
 
 	req.SetUpgradeMode(r.upgradeMode)
 
+	if err := r.restoreUpgradeSnapshot(req); err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
 	if r.upgradeMode {
 		if result, err := r.handleUpgrade(req, init); result != nil {
 			return *result, err
 		}
 	}
 
+	// migrate the deprecated fields only after the pre-upgrade snapshot was taken and the upgrade patches were applied,
+	// so the snapshot keeps the spec as the user set it, and the patches see the fields they were written for
+	if err := r.applyFieldMigrations(req); err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
 	return r.EnsureOperandAndComplete(req, init)
 }
 
+// getUpgradeStartTime returns the time the upgrade to the current version started. The start time is kept in the
+// status, so if HCO was restarted in the middle of the upgrade, the upgrade keeps its original start time; otherwise,
+// the upgrade starts now.
+func (r *ReconcileHyperConverged) getUpgradeStartTime(req *common.HcoRequest) time.Time {
+	progress := req.Instance.Status.UpgradeProgress
+	if progress != nil && progress.TargetVersion == r.ownVersion && !progress.StartTime.IsZero() {
+		return progress.StartTime.Time
+	}
+
+	now := metav1.Now()
+	req.Instance.Status.UpgradeProgress = &hcov1beta1.UpgradeProgress{
+		TargetVersion: r.ownVersion,
+		StartTime:     now,
+	}
+	req.StatusDirty = true
+
+	return now.Time
+}
+
 func (r *ReconcileHyperConverged) handleUpgrade(req *common.HcoRequest, init bool) (*reconcile.Result, error) {
 
+	blocked, err := r.checkUpgradeAssertions(req)
+	if err != nil {
+		return &reconcile.Result{Requeue: true}, err
+	}
+
+	if blocked {
+		return &reconcile.Result{RequeueAfter: upgradeAssertionsRetryInterval}, nil
+	}
+
+	// keep the pre-upgrade configuration, before any upgrade patch or removal runs
+	if err = r.ensureUpgradeSnapshot(req); err != nil {
+		return &reconcile.Result{Requeue: true}, err
+	}
+
 	crdStatusUpdated, err := r.updateCrdStoredVersions(req)
 	if err != nil {
 		return &reconcile.Result{Requeue: true}, err
@@ -940,10 +1085,12 @@ func (r *ReconcileHyperConverged) completeReconciliation(req *common.HcoRequest)
 		if r.upgradeMode && req.ComponentUpgradeInProgress && !req.Dirty {
 			// update the new version only when upgrade is completed
 			UpdateVersion(&req.Instance.Status, hcoVersionName, r.ownVersion)
+			req.Instance.Status.UpgradeProgress = nil
 			req.StatusDirty = true
 
 			r.upgradeMode = false
 			req.ComponentUpgradeInProgress = false
+			metrics.SetUpgradeCompleted()
 			req.Logger.Info(fmt.Sprintf("Successfully upgraded to version %s", r.ownVersion))
 			r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "UpgradeHCO", fmt.Sprintf("Successfully upgraded to version %s", r.ownVersion))
 		}
@@ -958,8 +1105,8 @@ func (r *ReconcileHyperConverged) completeReconciliation(req *common.HcoRequest)
 		req.Conditions.SetStatusCondition(metav1.Condition{
 			Type:               hcov1beta1.ConditionProgressing,
 			Status:             metav1.ConditionTrue,
-			Reason:             "HCOUpgrading",
-			Message:            "HCO is now upgrading to version " + r.ownVersion,
+			Reason:             upgradingReason,
+			Message:            upgradingMessagePrefix + r.ownVersion,
 			ObservedGeneration: req.Instance.Generation,
 		})
 	}
@@ -1003,6 +1150,9 @@ func (r *ReconcileHyperConverged) updateConditions(req *common.HcoRequest) {
 	// Detect a "TaintedConfiguration" state, and raise a corresponding event
 	r.detectTaintedConfiguration(req, &conditions)
 
+	// Detect operands that are not reconciled, according to the spec.reconcilePolicy field
+	r.detectReconcilePaused(req, &conditions)
+
 	if !reflect.DeepEqual(conditions, req.Instance.Status.Conditions) {
 		req.Instance.Status.Conditions = conditions
 		req.StatusDirty = true
@@ -1032,7 +1182,7 @@ func (r *ReconcileHyperConverged) detectTaintedConfiguration(req *common.HcoRequ
 	conditionExists := apimetav1.IsStatusConditionTrue(req.Instance.Status.Conditions, hcov1beta1.ConditionTaintedConfiguration)
 
 	// A tainted configuration state is indicated by the
-	// presence of at least one of the JSON Patch annotations
+	// presence of at least one of the JSON Patch annotations, or of the spec.operandOverrides field
 	tainted := false
 	for _, jpa := range JSONPatchAnnotationNames {
 		NumOfChanges := 0
@@ -1045,12 +1195,29 @@ func (r *ReconcileHyperConverged) detectTaintedConfiguration(req *common.HcoRequ
 		metrics.SetUnsafeModificationCount(NumOfChanges, jpa)
 	}
 
-	if tainted {
+	// operand overrides are tainting the configuration as well
+	overridden := false
+	for _, operand := range operands.OperandOverrideNames {
+		NumOfChanges := 0
+		if override := operands.GetOperandOverride(req.Instance, operand); override != nil {
+			if NumOfChanges = len(override.Patches); NumOfChanges > 0 {
+				overridden = true
+			}
+		}
+		metrics.SetUnsafeModificationCount(NumOfChanges, operandOverridesMetricLabelPrefix+operand)
+	}
+
+	if tainted || overridden {
+		reason, message := taintedConfigurationReason, taintedConfigurationMessage
+		if !tainted {
+			reason, message = taintedOverrideReason, taintedOverrideMessage
+		}
+
 		apimetav1.SetStatusCondition(conditions, metav1.Condition{
 			Type:               hcov1beta1.ConditionTaintedConfiguration,
 			Status:             metav1.ConditionTrue,
-			Reason:             taintedConfigurationReason,
-			Message:            taintedConfigurationMessage,
+			Reason:             reason,
+			Message:            message,
 			ObservedGeneration: req.Instance.ObjectMeta.Generation,
 		})
 
@@ -1070,6 +1237,41 @@ func (r *ReconcileHyperConverged) detectTaintedConfiguration(req *common.HcoRequ
 	}
 }
 
+func (r *ReconcileHyperConverged) detectReconcilePaused(req *common.HcoRequest, conditions *[]metav1.Condition) {
+	conditionExists := apimetav1.IsStatusConditionTrue(req.Instance.Status.Conditions, hcov1beta1.ConditionReconcilePaused)
+
+	unmanaged := operands.GetUnmanagedOperands(req.Instance)
+	for _, operand := range operands.OperandOverrideNames {
+		metrics.SetReconcilePaused(operand, slices.Contains(unmanaged, operand))
+	}
+
+	if len(unmanaged) == 0 {
+		if conditionExists {
+			apimetav1.RemoveStatusCondition(conditions, hcov1beta1.ConditionReconcilePaused)
+			req.Logger.Info("All the operands are reconciled again")
+		}
+		return
+	}
+
+	reason := reconcilePausedUnmanagedReason
+	message := fmt.Sprintf(reconcilePausedUnmanagedMessageFmt, strings.Join(unmanaged, ", "))
+	if operands.IsReconcilePaused(req.Instance) {
+		reason, message = reconcilePausedReason, reconcilePausedMessage
+	}
+
+	apimetav1.SetStatusCondition(conditions, metav1.Condition{
+		Type:               hcov1beta1.ConditionReconcilePaused,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: req.Instance.ObjectMeta.Generation,
+	})
+
+	if !conditionExists {
+		req.Logger.Info("Detected operands that are not reconciled", "operands", unmanaged)
+	}
+}
+
 func (r *ReconcileHyperConverged) getSystemHealthStatus(conditions common.HcoConditions) string {
 	if isSystemHealthStatusError(conditions) {
 		return systemHealthStatusError
@@ -1155,7 +1357,7 @@ func (r *ReconcileHyperConverged) setOperatorUpgradeableStatus(request *common.H
 const crdName = "hyperconvergeds.hco.kubevirt.io"
 
 func (r *ReconcileHyperConverged) updateCrdStoredVersions(req *common.HcoRequest) (bool, error) {
-	versionsToBeRemoved := []string{"v1alpha1"}
+	versionsToBeRemoved := []string{hcoutil.APIVersionAlpha}
 
 	found := &apiextensionsv1.CustomResourceDefinition{}
 	key := client.ObjectKey{Namespace: hcoutil.UndefinedNamespace, Name: crdName}
@@ -1165,18 +1367,25 @@ func (r *ReconcileHyperConverged) updateCrdStoredVersions(req *common.HcoRequest
 		return false, err
 	}
 
-	needsUpdate := false
-	var newStoredVersions []string
-	for _, vToBeRemoved := range versionsToBeRemoved {
-		for _, sVersion := range found.Status.StoredVersions {
-			if vToBeRemoved != sVersion {
-				newStoredVersions = append(newStoredVersions, sVersion)
-			} else {
-				needsUpdate = true
-			}
+	storageVersion := getCrdStorageVersion(found)
+	if storageVersion == hcoutil.APIVersionV1 && slices.Contains(found.Status.StoredVersions, hcoutil.APIVersionBeta) {
+		// v1beta1 can only be removed from the stored versions, after all the HyperConverged objects were written
+		// again, in the new storage version.
+		if err = r.migrateHyperConvergedStorage(req); err != nil {
+			return false, err
 		}
+		versionsToBeRemoved = append(versionsToBeRemoved, hcoutil.APIVersionBeta)
 	}
-	if needsUpdate {
+
+	newStoredVersions := slices.DeleteFunc(slices.Clone(found.Status.StoredVersions), func(sVersion string) bool {
+		return slices.Contains(versionsToBeRemoved, sVersion)
+	})
+
+	if len(newStoredVersions) != len(found.Status.StoredVersions) {
+		if storageVersion != "" && !slices.Contains(newStoredVersions, storageVersion) {
+			newStoredVersions = append(newStoredVersions, storageVersion)
+		}
+
 		found.Status.StoredVersions = newStoredVersions
 		err = r.client.Status().Update(req.Ctx, found)
 		if err != nil {
@@ -1190,6 +1399,42 @@ func (r *ReconcileHyperConverged) updateCrdStoredVersions(req *common.HcoRequest
 	return false, nil
 }
 
+func getCrdStorageVersion(crd *apiextensionsv1.CustomResourceDefinition) string {
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			return version.Name
+		}
+	}
+
+	return ""
+}
+
+// migrateHyperConvergedStorage writes all the HyperConverged objects back, without any change. The API server then
+// stores them in the current storage version of the CRD.
+func (r *ReconcileHyperConverged) migrateHyperConvergedStorage(req *common.HcoRequest) error {
+	hcList := &hcov1beta1.HyperConvergedList{}
+	if err := r.client.List(req.Ctx, hcList); err != nil {
+		req.Logger.Error(err, "failed to list the HyperConverged objects")
+		return err
+	}
+
+	for i := range hcList.Items {
+		hc := &hcList.Items[i]
+		if err := r.client.Update(req.Ctx, hc); err != nil {
+			req.Logger.Error(err, "failed to migrate the HyperConverged storage version", "name", hc.Name, "namespace", hc.Namespace)
+			return err
+		}
+
+		// keep the resource version of the reconciled object, to avoid a conflict when updating its status
+		if hc.Name == req.Instance.Name && hc.Namespace == req.Instance.Namespace {
+			req.Instance.ResourceVersion = hc.ResourceVersion
+		}
+	}
+
+	req.Logger.Info("successfully migrated the HyperConverged objects to the new storage version", "count", len(hcList.Items))
+	return nil
+}
+
 func (r *ReconcileHyperConverged) migrateBeforeUpgrade(req *common.HcoRequest) (bool, error) {
 	upgradePatched, err := r.applyUpgradePatches(req)
 	if err != nil {
@@ -1204,13 +1449,8 @@ func (r *ReconcileHyperConverged) migrateBeforeUpgrade(req *common.HcoRequest) (
 func (r *ReconcileHyperConverged) applyUpgradePatches(req *common.HcoRequest) (bool, error) {
 	modified := false
 
-	knownHcoVersion, _ := GetVersion(&req.Instance.Status, hcoVersionName)
-	if knownHcoVersion == "" {
-		knownHcoVersion = "0.0.0"
-	}
-	knownHcoSV, err := semver.ParseTolerant(knownHcoVersion)
+	knownHcoSV, err := getKnownHcoSemVer(req)
 	if err != nil {
-		req.Logger.Error(err, "Error!")
 		return false, err
 	}
 
@@ -1226,6 +1466,12 @@ func (r *ReconcileHyperConverged) applyUpgradePatches(req *common.HcoRequest) (b
 		}
 	}
 
+	for _, p := range hcoUpgradeChanges.ObjectPatches {
+		if err = r.applyObjectPatch(req, knownHcoSV, p); err != nil {
+			return false, err
+		}
+	}
+
 	for _, p := range hcoUpgradeChanges.ObjectsToBeRemoved {
 		removed, err := r.removeLeftover(req, knownHcoSV, p)
 		if err != nil {
@@ -1248,6 +1494,20 @@ func (r *ReconcileHyperConverged) applyUpgradePatches(req *common.HcoRequest) (b
 	return modified, nil
 }
 
+func getKnownHcoSemVer(req *common.HcoRequest) (semver.Version, error) {
+	knownHcoVersion, _ := GetVersion(&req.Instance.Status, hcoVersionName)
+	if knownHcoVersion == "" {
+		knownHcoVersion = "0.0.0"
+	}
+	knownHcoSV, err := semver.ParseTolerant(knownHcoVersion)
+	if err != nil {
+		req.Logger.Error(err, "Error!")
+		return semver.Version{}, err
+	}
+
+	return knownHcoSV, nil
+}
+
 func (r *ReconcileHyperConverged) applyUpgradePatch(req *common.HcoRequest, hcoJSON []byte, knownHcoSV semver.Version, p hcoCRPatch) ([]byte, error) {
 	affectedRange, err := semver.ParseRange(p.SemverRange)
 	if err != nil {
@@ -1269,11 +1529,84 @@ func (r *ReconcileHyperConverged) applyUpgradePatch(req *common.HcoRequest, hcoJ
 
 			return hcoJSON, err
 		}
+
+		if !jsonpatch.Equal(hcoJSON, patchedBytes) {
+			r.recordUpgradePatch(req, hcov1beta1.UpgradePatchTypeHyperConverged, getHyperConvergedReference(req.Instance), p.SemverRange)
+		}
 		return patchedBytes, nil
 	}
 	return hcoJSON, nil
 }
 
+// applyObjectPatch applies an upgrade patch on an object that was deployed by HCO. Missing objects, or objects that
+// were not deployed by HCO, are ignored.
+func (r *ReconcileHyperConverged) applyObjectPatch(req *common.HcoRequest, knownHcoSV semver.Version, p objectPatch) error {
+	affectedRange, err := semver.ParseRange(p.SemverRange)
+	if err != nil {
+		return err
+	}
+	if !affectedRange(knownHcoSV) {
+		return nil
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(p.GroupVersionKind)
+	if err = r.client.Get(req.Ctx, p.ObjectKey, obj); err != nil {
+		if apierrors.IsNotFound(err) || apimetav1.IsNoMatchError(err) {
+			return nil
+		}
+
+		req.Logger.Error(err, "failed to read the object to be patched", "objectPatch", p)
+		return err
+	}
+
+	if obj.GetLabels()[hcoutil.AppLabel] != req.Instance.Name {
+		req.Logger.Info("the object wasn't deployed by HCO; ignoring the upgrade patch", "groupVersionKind", p.GroupVersionKind, "objectKey", p.ObjectKey)
+		return nil
+	}
+
+	objJSON, err := obj.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	req.Logger.Info("applying upgrade patch", "knownHcoSV", knownHcoSV, "affectedRange", p.SemverRange, "groupVersionKind", p.GroupVersionKind, "objectKey", p.ObjectKey)
+	var patchedBytes []byte
+	switch {
+	case len(p.MergePatch) > 0:
+		patchedBytes, err = jsonpatch.MergePatch(objJSON, p.MergePatch)
+	case p.JSONPatchApplyOptions != nil:
+		patchedBytes, err = p.JSONPatch.ApplyWithOptions(objJSON, p.JSONPatchApplyOptions)
+	default:
+		patchedBytes, err = p.JSONPatch.Apply(objJSON)
+	}
+	if err != nil {
+		// tolerate jsonpatch test failures
+		if errors.Is(err, jsonpatch.ErrTestFailed) {
+			return nil
+		}
+
+		return err
+	}
+
+	if jsonpatch.Equal(objJSON, patchedBytes) {
+		return nil
+	}
+
+	patched := &unstructured.Unstructured{}
+	if err = patched.UnmarshalJSON(patchedBytes); err != nil {
+		return err
+	}
+
+	if err = r.client.Update(req.Ctx, patched); err != nil {
+		req.Logger.Error(err, "failed to apply the upgrade patch", "objectPatch", p)
+		return err
+	}
+
+	r.recordUpgradePatch(req, hcov1beta1.UpgradePatchTypeObject, getObjectReference(patched), p.SemverRange)
+	return nil
+}
+
 func (r *ReconcileHyperConverged) removeLeftover(req *common.HcoRequest, knownHcoSV semver.Version, p objectToBeRemoved) (bool, error) {
 
 	affectedRange, err := semver.ParseRange(p.SemverRange)
@@ -1281,6 +1614,10 @@ func (r *ReconcileHyperConverged) removeLeftover(req *common.HcoRequest, knownHc
 		return false, err
 	}
 	if affectedRange(knownHcoSV) {
+		if p.LabelSelector != nil {
+			return r.removeLeftoversBySelector(req, p)
+		}
+
 		removeRelatedObject(req, r.client, p.GroupVersionKind, p.ObjectKey)
 		u := &unstructured.Unstructured{}
 		u.SetGroupVersionKind(p.GroupVersionKind)
@@ -1293,12 +1630,210 @@ func (r *ReconcileHyperConverged) removeLeftover(req *common.HcoRequest, knownHc
 			req.Logger.Error(gerr, "failed looking for leftovers", "objectToBeRemoved", p)
 			return false, gerr
 		}
-		return r.deleteObj(req, u, false)
+		return r.removeLeftoverObject(req, u, false, p.SemverRange)
 
 	}
 	return false, nil
 }
 
+// removeLeftoversBySelector removes all the objects that match the label selector. Unlike the objects that are
+// removed by name, only objects that were deployed by HCO are removed.
+func (r *ReconcileHyperConverged) removeLeftoversBySelector(req *common.HcoRequest, p objectToBeRemoved) (bool, error) {
+	selector, err := metav1.LabelSelectorAsSelector(p.LabelSelector)
+	if err != nil {
+		return false, err
+	}
+
+	opts := []client.ListOption{client.MatchingLabelsSelector{Selector: selector}}
+	if p.ObjectKey.Namespace != "" {
+		opts = append(opts, client.InNamespace(p.ObjectKey.Namespace))
+	}
+
+	leftovers := &unstructured.UnstructuredList{}
+	leftovers.SetGroupVersionKind(p.GroupVersionKind.GroupVersion().WithKind(p.GroupVersionKind.Kind + "List"))
+	if err = r.client.List(req.Ctx, leftovers, opts...); err != nil {
+		if apimetav1.IsNoMatchError(err) {
+			return false, nil
+		}
+
+		req.Logger.Error(err, "failed looking for leftovers", "objectToBeRemoved", p)
+		return false, err
+	}
+
+	removedAny := false
+	for i := range leftovers.Items {
+		leftover := &leftovers.Items[i]
+		leftover.SetGroupVersionKind(p.GroupVersionKind)
+		if leftover.GetLabels()[hcoutil.AppLabel] == req.Instance.Name {
+			removeRelatedObject(req, r.client, p.GroupVersionKind, client.ObjectKeyFromObject(leftover))
+		}
+
+		removed, err := r.removeLeftoverObject(req, leftover, true, p.SemverRange)
+		if err != nil {
+			return removedAny, err
+		}
+		removedAny = removedAny || removed
+	}
+
+	return removedAny, nil
+}
+
+func (r *ReconcileHyperConverged) removeLeftoverObject(req *common.HcoRequest, obj *unstructured.Unstructured, protectNonHCOObjects bool, semverRange string) (bool, error) {
+	ref := getObjectReference(obj)
+	removed, err := r.deleteObj(req, obj, protectNonHCOObjects)
+	if removed {
+		r.recordUpgradePatch(req, hcov1beta1.UpgradePatchTypeObjectRemoval, ref, semverRange)
+	}
+	return removed, err
+}
+
+// recordUpgradePatch adds an applied upgrade patch to the status history, and emits an event for it. Object removals
+// already emit an event when the object is deleted.
+func (r *ReconcileHyperConverged) recordUpgradePatch(req *common.HcoRequest, patchType string, obj corev1.ObjectReference, semverRange string) {
+	knownHcoVersion, _ := GetVersion(&req.Instance.Status, hcoVersionName)
+
+	history := append(req.Instance.Status.UpgradePatchHistory, hcov1beta1.UpgradePatchRecord{
+		Type:        patchType,
+		Object:      obj,
+		SemverRange: semverRange,
+		FromVersion: knownHcoVersion,
+		ToVersion:   r.ownVersion,
+		AppliedTime: metav1.Now(),
+	})
+	if len(history) > maxUpgradePatchHistory {
+		history = history[len(history)-maxUpgradePatchHistory:]
+	}
+	req.Instance.Status.UpgradePatchHistory = history
+	req.StatusDirty = true
+
+	if patchType != hcov1beta1.UpgradePatchTypeObjectRemoval {
+		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, upgradePatchAppliedReason,
+			fmt.Sprintf("Applied upgrade patch on %s %s", obj.Kind, getObjectReferenceName(obj)))
+	}
+}
+
+// checkUpgradeAssertions runs the upgrade assertions that are relevant for the version HCO is upgraded from. If any of
+// them fails, the upgrade is blocked, and the conditions are updated with the failures.
+func (r *ReconcileHyperConverged) checkUpgradeAssertions(req *common.HcoRequest) (bool, error) {
+	knownHcoSV, err := getKnownHcoSemVer(req)
+	if err != nil {
+		return false, err
+	}
+
+	var failures []string
+	for _, a := range hcoUpgradeChanges.UpgradeAssertions {
+		affectedRange, err := semver.ParseRange(a.SemverRange)
+		if err != nil {
+			return false, err
+		}
+		if !affectedRange(knownHcoSV) {
+			continue
+		}
+
+		failure, err := r.checkUpgradeAssertion(req, a)
+		if err != nil {
+			return false, err
+		}
+		if failure != "" {
+			failures = append(failures, failure)
+		}
+	}
+
+	if len(failures) == 0 {
+		return false, nil
+	}
+
+	message := upgradeBlockedMessagePrefix + strings.Join(failures, "; ")
+	req.Logger.Info("upgrade assertions failed; blocking the upgrade", "failures", failures)
+	r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, upgradeBlockedReason, message)
+
+	for _, cond := range []metav1.Condition{
+		{Type: hcov1beta1.ConditionProgressing, Status: metav1.ConditionTrue},
+		{Type: hcov1beta1.ConditionDegraded, Status: metav1.ConditionTrue},
+		{Type: hcov1beta1.ConditionUpgradeable, Status: metav1.ConditionFalse},
+		{Type: hcov1beta1.ConditionReconcileComplete, Status: metav1.ConditionFalse},
+	} {
+		cond.Reason = upgradeBlockedReason
+		cond.Message = message
+		cond.ObservedGeneration = req.Instance.Generation
+		req.Conditions.SetStatusCondition(cond)
+	}
+	// the operands are not touched while the upgrade is blocked, so their availability is not changed
+	if available := apimetav1.FindStatusCondition(req.Instance.Status.Conditions, hcov1beta1.ConditionAvailable); available != nil {
+		req.Conditions.SetStatusCondition(*available)
+	}
+
+	r.updateConditions(req)
+	return true, nil
+}
+
+// checkUpgradeAssertion returns a description of the failure, or an empty string if the assertion passed
+func (r *ReconcileHyperConverged) checkUpgradeAssertion(req *common.HcoRequest, a upgradeAssertion) (string, error) {
+	var (
+		ref     corev1.ObjectReference
+		objJSON []byte
+		err     error
+	)
+
+	if a.GroupVersionKind.Kind == "" {
+		ref = getHyperConvergedReference(req.Instance)
+		objJSON, err = json.Marshal(req.Instance)
+	} else {
+		ref = corev1.ObjectReference{Kind: a.GroupVersionKind.Kind, Namespace: a.ObjectKey.Namespace, Name: a.ObjectKey.Name}
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(a.GroupVersionKind)
+		if err = r.client.Get(req.Ctx, a.ObjectKey, obj); err != nil {
+			if apierrors.IsNotFound(err) || apimetav1.IsNoMatchError(err) {
+				return upgradeAssertionFailure(a, ref, "not found"), nil
+			}
+			return "", err
+		}
+		objJSON, err = obj.MarshalJSON()
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if _, err = a.JSONPatch.Apply(objJSON); err != nil {
+		return upgradeAssertionFailure(a, ref, err.Error()), nil
+	}
+
+	return "", nil
+}
+
+func upgradeAssertionFailure(a upgradeAssertion, ref corev1.ObjectReference, reason string) string {
+	failure := fmt.Sprintf("%s %s: %s", ref.Kind, getObjectReferenceName(ref), reason)
+	if a.Message != "" {
+		return fmt.Sprintf("%s (%s)", a.Message, failure)
+	}
+	return failure
+}
+
+func getHyperConvergedReference(hc *hcov1beta1.HyperConverged) corev1.ObjectReference {
+	return corev1.ObjectReference{
+		APIVersion: hcov1beta1.SchemeGroupVersion.String(),
+		Kind:       "HyperConverged",
+		Namespace:  hc.Namespace,
+		Name:       hc.Name,
+	}
+}
+
+func getObjectReference(obj *unstructured.Unstructured) corev1.ObjectReference {
+	return corev1.ObjectReference{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+func getObjectReferenceName(ref corev1.ObjectReference) string {
+	if ref.Namespace == "" {
+		return ref.Name
+	}
+	return ref.Namespace + "/" + ref.Name
+}
+
 func (r *ReconcileHyperConverged) deleteObj(req *common.HcoRequest, obj client.Object, protectNonHCOObjects bool) (bool, error) {
 	removed, err := hcoutil.EnsureDeleted(req.Ctx, r.client, obj, req.Instance.Name, req.Logger, false, false, protectNonHCOObjects)
 
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/blang/semver/v4"
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/ghodss/yaml"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
@@ -96,6 +98,36 @@ var _ = Describe("HyperconvergedController", func() {
 				verifyHyperConvergedCRExistsMetricFalse()
 			})
 
+			It("should report the reconcile metrics by the source that triggered the reconciliation", func() {
+				cl := commontestutils.InitClient([]client.Object{})
+				r := initReconciler(cl, nil)
+
+				hcoCountBefore, err := metrics.GetReconcileCount(reconcileTriggerHyperConverged)
+				Expect(err).ToNot(HaveOccurred())
+				secondaryCountBefore, err := metrics.GetReconcileCount(reconcileTriggerSecondary)
+				Expect(err).ToNot(HaveOccurred())
+				requeuesBefore, err := metrics.GetReconcileRequeuesCount(reconcileTriggerSecondary)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(metrics.GetReconcileCount(reconcileTriggerHyperConverged)).To(Equal(hcoCountBefore + 1))
+				Expect(metrics.GetReconcileCount(reconcileTriggerSecondary)).To(Equal(secondaryCountBefore))
+
+				ph, err := getSecondaryCRPlaceholder()
+				Expect(err).ToNot(HaveOccurred())
+				cl.InitiateGetErrors(func(key client.ObjectKey) error {
+					return errors.New("fake get error")
+				})
+
+				_, err = r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: ph})
+				Expect(err).To(HaveOccurred())
+
+				Expect(metrics.GetReconcileCount(reconcileTriggerSecondary)).To(Equal(secondaryCountBefore + 1))
+				Expect(metrics.GetReconcileRequeuesCount(reconcileTriggerSecondary)).To(Equal(requeuesBefore + 1))
+			})
+
 			It("should ignore invalid requests", func() {
 				hco := commontestutils.NewHco()
 				hco.ObjectMeta = metav1.ObjectMeta{
@@ -1359,6 +1391,8 @@ var _ = Describe("HyperconvergedController", func() {
 				_ = os.Setenv(hcoutil.HcoKvIoVersionName, newHCOVersion)
 
 				expected = getBasicDeployment()
+				// the HyperConverged CRs of the old versions got the workload update methods from the static default
+				expected.hco.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []string{hcoutil.DefaultWorkloadUpdateMethod}
 				origConditions = expected.hco.Status.Conditions
 				okConds = expected.hco.Status.Conditions
 
@@ -1476,6 +1510,97 @@ var _ = Describe("HyperconvergedController", func() {
 				validateOperatorCondition(reconciler, metav1.ConditionTrue, hcoutil.UpgradeableAllowReason, hcoutil.UpgradeableAllowMessage)
 			})
 
+			It("should report the progress of the upgrade in the metrics", func() {
+				// old HCO Version is set
+				UpdateVersion(&expected.hco.Status, hcoVersionName, oldVersion)
+
+				// CDI did not reach the target version yet
+				expected.cdi.Status.ObservedVersion = oldComponentVersion
+
+				beforeUpgrade := time.Now().Unix()
+
+				cl := expected.initClient()
+				foundResource, reconciler, _ := doReconcile(cl, expected.hco, nil)
+				Expect(reconciler.upgradeMode).To(BeTrue())
+
+				Expect(metrics.GetUpgradeInfo(oldVersion, newHCOVersion)).To(Equal(1.0))
+				Expect(metrics.GetUpgradeStartTimestamp()).To(BeNumerically(">=", beforeUpgrade))
+				Expect(foundResource.Status.UpgradeProgress).ToNot(BeNil())
+				Expect(foundResource.Status.UpgradeProgress.TargetVersion).To(Equal(newHCOVersion))
+				Expect(metrics.GetUpgradeComponentCompleted("KubeVirt")).To(Equal(1.0))
+				Expect(metrics.GetUpgradeComponentCompleted("CDI")).To(Equal(0.0))
+
+				// now, complete the upgrade
+				expected.cdi.Status.ObservedVersion = newComponentVersion
+				cl = expected.initClient()
+				_, reconciler, _ = doReconcile(cl, expected.hco, reconciler)
+				Expect(metrics.GetUpgradeComponentCompleted("CDI")).To(Equal(1.0))
+
+				foundResource, reconciler, _ = doReconcile(cl, expected.hco, reconciler)
+				Expect(reconciler.upgradeMode).To(BeFalse())
+				Expect(foundResource.Status.UpgradeProgress).To(BeNil())
+
+				Expect(metrics.GetUpgradeStartTimestamp()).To(BeZero())
+				Expect(metrics.GetUpgradeInfo(oldVersion, newHCOVersion)).To(BeZero())
+			})
+
+			It("should keep the start time of the upgrade, if HCO was restarted in the middle of the upgrade", func() {
+				// old HCO Version is set
+				UpdateVersion(&expected.hco.Status, hcoVersionName, oldVersion)
+
+				// the previous HCO pod already started the upgrade an hour ago
+				startTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+				expected.hco.Status.UpgradeProgress = &hcov1beta1.UpgradeProgress{
+					TargetVersion: newHCOVersion,
+					StartTime:     startTime,
+				}
+
+				// the Progressing condition was already True for another reason, before the upgrade started
+				apimetav1.SetStatusCondition(&expected.hco.Status.Conditions, metav1.Condition{
+					Type:               hcov1beta1.ConditionProgressing,
+					Status:             metav1.ConditionTrue,
+					Reason:             upgradingReason,
+					Message:            upgradingMessagePrefix + newHCOVersion,
+					LastTransitionTime: metav1.NewTime(startTime.Add(-time.Hour)),
+				})
+
+				// CDI did not reach the target version yet
+				expected.cdi.Status.ObservedVersion = oldComponentVersion
+
+				cl := expected.initClient()
+				foundResource, reconciler, _ := doReconcile(cl, expected.hco, nil)
+				Expect(reconciler.upgradeMode).To(BeTrue())
+
+				Expect(metrics.GetUpgradeStartTimestamp()).To(Equal(float64(startTime.Unix())))
+				Expect(foundResource.Status.UpgradeProgress).ToNot(BeNil())
+				Expect(foundResource.Status.UpgradeProgress.StartTime.Unix()).To(Equal(startTime.Unix()))
+			})
+
+			It("should not use the start time of an upgrade to another version", func() {
+				// old HCO Version is set
+				UpdateVersion(&expected.hco.Status, hcoVersionName, oldVersion)
+
+				// an upgrade to another version started a day ago
+				expected.hco.Status.UpgradeProgress = &hcov1beta1.UpgradeProgress{
+					TargetVersion: "0.0.1",
+					StartTime:     metav1.NewTime(time.Now().Add(-24 * time.Hour)),
+				}
+
+				// CDI did not reach the target version yet
+				expected.cdi.Status.ObservedVersion = oldComponentVersion
+
+				beforeUpgrade := time.Now().Unix()
+
+				cl := expected.initClient()
+				foundResource, reconciler, _ := doReconcile(cl, expected.hco, nil)
+				Expect(reconciler.upgradeMode).To(BeTrue())
+
+				Expect(metrics.GetUpgradeStartTimestamp()).To(BeNumerically(">=", beforeUpgrade))
+				Expect(foundResource.Status.UpgradeProgress).ToNot(BeNil())
+				Expect(foundResource.Status.UpgradeProgress.TargetVersion).To(Equal(newHCOVersion))
+				Expect(foundResource.Status.UpgradeProgress.StartTime.Unix()).To(BeNumerically(">=", beforeUpgrade))
+			})
+
 			It("don't increase the overwrittenModifications metric during upgrade", func() {
 				// old HCO Version is set
 				UpdateVersion(&expected.hco.Status, hcoVersionName, oldVersion)
@@ -1864,6 +1989,34 @@ var _ = Describe("HyperconvergedController", func() {
 					Expect(ver).To(Equal(newHCOVersion))
 				})
 
+				It("should migrate the HyperConverged objects and remove v1beta1 if v1 is the storage version", func() {
+					// Simulate ongoing upgrade
+					UpdateVersion(&expected.hco.Status, hcoVersionName, oldVersion)
+
+					expected.hcoCRD.Spec.Versions = []apiextensionsv1.CustomResourceDefinitionVersion{
+						{Name: "v1beta1", Served: true, Storage: false},
+						{Name: "v1", Served: true, Storage: true},
+					}
+					expected.hcoCRD.Status.StoredVersions = []string{"v1alpha1", "v1beta1"}
+
+					cl := expected.initClient()
+
+					origHC := &hcov1beta1.HyperConverged{}
+					Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(expected.hco), origHC)).To(Succeed())
+
+					foundHC, _, requeue := doReconcile(cl, expected.hco, nil)
+					Expect(requeue).To(BeTrue())
+					Expect(foundHC.ResourceVersion).ToNot(Equal(origHC.ResourceVersion))
+
+					foundCrd := &apiextensionsv1.CustomResourceDefinition{}
+					Expect(
+						cl.Get(context.TODO(),
+							client.ObjectKeyFromObject(expected.hcoCRD),
+							foundCrd),
+					).To(Succeed())
+					Expect(foundCrd.Status.StoredVersions).To(Equal([]string{"v1"}))
+				})
+
 				It("should not update .status.storedVersions on the HCO CRD if not in upgrade mode", func() {
 					expected.hcoCRD.Status.StoredVersions = []string{"v1alpha1", "v1beta1", "v1"}
 
@@ -2654,6 +2807,440 @@ var _ = Describe("HyperconvergedController", func() {
 				})
 
 			})
+
+			Context("upgrade patches on owned objects", func() {
+				var (
+					origUpgradeChanges     UpgradePatches
+					origUpgradeChangesRead bool
+				)
+
+				configMapGVK := schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+
+				BeforeEach(func() {
+					origUpgradeChanges = hcoUpgradeChanges
+					origUpgradeChangesRead = hcoUpgradeChangesRead
+					hcoUpgradeChangesRead = true
+
+					UpdateVersion(&expected.hco.Status, hcoVersionName, "1.9.0")
+				})
+
+				AfterEach(func() {
+					hcoUpgradeChanges = origUpgradeChanges
+					hcoUpgradeChangesRead = origUpgradeChangesRead
+				})
+
+				It("should patch objects that were deployed by HCO, and record the patches", func() {
+					cmToBePatched := &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "to-be-patched",
+							Namespace: namespace,
+							Labels: map[string]string{
+								hcoutil.AppLabel: expected.hco.Name,
+							},
+						},
+						Data: map[string]string{"key1": "value1", "key2": "value2"},
+					}
+					cmNotToBePatched := &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "not-to-be-patched",
+							Namespace: namespace,
+						},
+						Data: map[string]string{"key1": "value1", "key2": "value2"},
+					}
+
+					jsonPatch, err := jsonpatch.DecodePatch([]byte(`[{"op": "remove", "path": "/data/key1"}]`))
+					Expect(err).ToNot(HaveOccurred())
+
+					hcoUpgradeChanges = UpgradePatches{
+						ObjectPatches: []objectPatch{
+							{
+								SemverRange:      "<1.10.0",
+								GroupVersionKind: configMapGVK,
+								ObjectKey:        client.ObjectKeyFromObject(cmToBePatched),
+								JSONPatch:        jsonPatch,
+							},
+							{
+								SemverRange:      "<1.10.0",
+								GroupVersionKind: configMapGVK,
+								ObjectKey:        client.ObjectKeyFromObject(cmToBePatched),
+								MergePatch:       []byte(`{"data": {"key2": "new-value"}}`),
+							},
+							{
+								SemverRange:      "<1.10.0",
+								GroupVersionKind: configMapGVK,
+								ObjectKey:        client.ObjectKeyFromObject(cmNotToBePatched),
+								MergePatch:       []byte(`{"data": {"key2": "new-value"}}`),
+							},
+							{
+								SemverRange:      "<1.9.0",
+								GroupVersionKind: configMapGVK,
+								ObjectKey:        client.ObjectKeyFromObject(cmToBePatched),
+								MergePatch:       []byte(`{"data": {"key3": "value3"}}`),
+							},
+						},
+					}
+
+					resources := append(expected.toArray(), cmToBePatched, cmNotToBePatched)
+
+					cl := commontestutils.InitClient(resources)
+					foundResource, reconciler, requeue := doReconcile(cl, expected.hco, nil)
+					Expect(requeue).To(BeTrue())
+
+					foundCM := &corev1.ConfigMap{}
+					Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cmToBePatched), foundCM)).To(Succeed())
+					Expect(foundCM.Data).To(Equal(map[string]string{"key2": "new-value"}))
+
+					Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cmNotToBePatched), foundCM)).To(Succeed())
+					Expect(foundCM.Data).To(Equal(cmNotToBePatched.Data))
+
+					Expect(foundResource.Status.UpgradePatchHistory).To(HaveLen(2))
+					for _, record := range foundResource.Status.UpgradePatchHistory {
+						Expect(record.Type).To(Equal(hcov1beta1.UpgradePatchTypeObject))
+						Expect(record.Object.Kind).To(Equal("ConfigMap"))
+						Expect(record.Object.Name).To(Equal(cmToBePatched.Name))
+						Expect(record.SemverRange).To(Equal("<1.10.0"))
+						Expect(record.FromVersion).To(Equal("1.9.0"))
+						Expect(record.ToVersion).To(Equal(newHCOVersion))
+					}
+
+					expectedEvents := []commontestutils.MockEvent{
+						{
+							EventType: corev1.EventTypeNormal,
+							Reason:    upgradePatchAppliedReason,
+							Msg:       "Applied upgrade patch on ConfigMap " + namespace + "/" + cmToBePatched.Name,
+						},
+					}
+					Expect(reconciler.eventEmitter.(*commontestutils.EventEmitterMock).CheckEvents(expectedEvents)).To(BeTrue())
+				})
+
+				It("should remove objects that were deployed by HCO, by label selector", func() {
+					leftoverLabels := map[string]string{
+						hcoutil.AppLabel:          expected.hco.Name,
+						hcoutil.AppLabelComponent: "leftover",
+					}
+					cmToBeRemoved1 := &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "leftover1",
+							Namespace: namespace,
+							Labels:    leftoverLabels,
+						},
+					}
+					cmToBeRemoved2 := &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "leftover2",
+							Namespace: namespace,
+							Labels:    leftoverLabels,
+						},
+					}
+					cmNotToBeRemoved1 := &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "not-deployed-by-hco",
+							Namespace: namespace,
+							Labels: map[string]string{
+								hcoutil.AppLabelComponent: "leftover",
+							},
+						},
+					}
+					cmNotToBeRemoved2 := &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "other",
+							Namespace: namespace,
+							Labels: map[string]string{
+								hcoutil.AppLabel: expected.hco.Name,
+							},
+						},
+					}
+
+					hcoUpgradeChanges = UpgradePatches{
+						ObjectsToBeRemoved: []objectToBeRemoved{
+							{
+								SemverRange:      "<1.10.0",
+								GroupVersionKind: configMapGVK,
+								ObjectKey:        types.NamespacedName{Namespace: namespace},
+								LabelSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{hcoutil.AppLabelComponent: "leftover"},
+								},
+							},
+						},
+					}
+
+					resources := append(expected.toArray(), cmToBeRemoved1, cmToBeRemoved2, cmNotToBeRemoved1, cmNotToBeRemoved2)
+
+					cl := commontestutils.InitClient(resources)
+					foundResource, _, requeue := doReconcile(cl, expected.hco, nil)
+					Expect(requeue).To(BeTrue())
+
+					foundCM := &corev1.ConfigMap{}
+					Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cmToBeRemoved1), foundCM)).To(MatchError(apierrors.IsNotFound, "not found error"))
+					Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cmToBeRemoved2), foundCM)).To(MatchError(apierrors.IsNotFound, "not found error"))
+					Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cmNotToBeRemoved1), foundCM)).To(Succeed())
+					Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cmNotToBeRemoved2), foundCM)).To(Succeed())
+
+					Expect(foundResource.Status.UpgradePatchHistory).To(HaveLen(2))
+					for _, record := range foundResource.Status.UpgradePatchHistory {
+						Expect(record.Type).To(Equal(hcov1beta1.UpgradePatchTypeObjectRemoval))
+						Expect(record.Object.Name).To(HavePrefix("leftover"))
+					}
+				})
+
+				It("should block the upgrade if an upgrade assertion fails", func() {
+					jsonPatch, err := jsonpatch.DecodePatch([]byte(`[{"op": "test", "path": "/spec/featureGates/deployKubeSecondaryDNS", "value": true}]`))
+					Expect(err).ToNot(HaveOccurred())
+
+					hcoUpgradeChanges = UpgradePatches{
+						UpgradeAssertions: []upgradeAssertion{
+							{
+								SemverRange: "<1.10.0",
+								JSONPatch:   jsonPatch,
+								Message:     "the deployKubeSecondaryDNS feature gate must be enabled",
+							},
+						},
+					}
+
+					cl := expected.initClient()
+					foundResource, reconciler, _ := doReconcile(cl, expected.hco, nil)
+
+					ver, ok := GetVersion(&foundResource.Status, hcoVersionName)
+					Expect(ok).To(BeTrue())
+					Expect(ver).To(Equal("1.9.0"))
+
+					upgradeable := apimetav1.FindStatusCondition(foundResource.Status.Conditions, hcov1beta1.ConditionUpgradeable)
+					Expect(upgradeable).ToNot(BeNil())
+					Expect(upgradeable.Status).To(Equal(metav1.ConditionFalse))
+					Expect(upgradeable.Reason).To(Equal(upgradeBlockedReason))
+					Expect(upgradeable.Message).To(HavePrefix(upgradeBlockedMessagePrefix + "the deployKubeSecondaryDNS feature gate must be enabled"))
+
+					expectedEvents := []commontestutils.MockEvent{
+						{
+							EventType: corev1.EventTypeWarning,
+							Reason:    upgradeBlockedReason,
+							Msg:       upgradeable.Message,
+						},
+					}
+					Expect(reconciler.eventEmitter.(*commontestutils.EventEmitterMock).CheckEvents(expectedEvents)).To(BeTrue())
+
+					By("fixing the cluster, the upgrade should continue")
+					foundResource.Spec.FeatureGates.DeployKubeSecondaryDNS = ptr.To(true)
+					Expect(cl.Update(context.TODO(), foundResource)).To(Succeed())
+
+					foundResource, _, _ = doReconcile(cl, expected.hco, reconciler)
+
+					upgradeable = apimetav1.FindStatusCondition(foundResource.Status.Conditions, hcov1beta1.ConditionUpgradeable)
+					Expect(upgradeable).ToNot(BeNil())
+					Expect(upgradeable.Reason).ToNot(Equal(upgradeBlockedReason))
+				})
+			})
+
+			Context("pre-upgrade snapshots", func() {
+
+				newSnapshot := func(fromVersion string, hc *hcov1beta1.HyperConverged) *corev1.ConfigMap {
+					hcYAML, err := marshalHyperConvergedSnapshot(hc)
+					Expect(err).ToNot(HaveOccurred())
+
+					return &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      getUpgradeSnapshotName(fromVersion),
+							Namespace: namespace,
+							Labels: map[string]string{
+								hcoutil.AppLabel:     hc.Name,
+								upgradeSnapshotLabel: "true",
+							},
+							Annotations: map[string]string{
+								upgradeSnapshotFromVersionAnnName: fromVersion,
+							},
+						},
+						Data: map[string]string{
+							upgradeSnapshotHyperConvergedKey: string(hcYAML),
+						},
+					}
+				}
+
+				It("should store the pre-upgrade configuration before migrating the deprecated fields", func() {
+					expected.hco.Spec.MediatedDevicesConfiguration = &hcov1beta1.MediatedDevicesConfiguration{
+						MediatedDevicesTypes: []string{"nvidia-222"}, //nolint SA1019
+					}
+					UpdateVersion(&expected.hco.Status, hcoVersionName, "1.4.5")
+
+					cl := expected.initClient()
+					foundResource, _, _ := doReconcile(cl, expected.hco, nil)
+					Expect(foundResource.Spec.MediatedDevicesConfiguration.MediatedDeviceTypes).To(Equal([]string{"nvidia-222"}))
+
+					snapshot := &corev1.ConfigMap{}
+					Expect(cl.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: "hco-upgrade-snapshot-1.4.5"}, snapshot)).To(Succeed())
+
+					snapshotHC := &hcov1beta1.HyperConverged{}
+					Expect(yaml.Unmarshal([]byte(snapshot.Data[upgradeSnapshotHyperConvergedKey]), snapshotHC)).To(Succeed())
+					Expect(snapshotHC.Spec.MediatedDevicesConfiguration.MediatedDevicesTypes).To(Equal([]string{"nvidia-222"})) //nolint SA1019
+					Expect(snapshotHC.Spec.MediatedDevicesConfiguration.MediatedDeviceTypes).To(BeEmpty())
+				})
+
+				It("should store the pre-upgrade configuration before applying the upgrade patches", func() {
+					expected.hco.Spec.LiveMigrationConfig.BandwidthPerMigration = ptr.To("64Mi")
+					expected.hco.Annotations = map[string]string{common.JSONPatchKVAnnotationName: "[]"}
+					UpdateVersion(&expected.hco.Status, hcoVersionName, "1.4.5")
+					// the previous HCO version configured KubeVirt differently
+					expected.kv.Spec.ImageTag = "pre-upgrade-tag"
+
+					cl := expected.initClient()
+					foundResource, reconciler, _ := doReconcile(cl, expected.hco, nil)
+					Expect(foundResource.Spec.LiveMigrationConfig.BandwidthPerMigration).To(BeNil())
+
+					snapshot := &corev1.ConfigMap{}
+					Expect(cl.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: "hco-upgrade-snapshot-1.4.5"}, snapshot)).To(Succeed())
+					Expect(snapshot.Labels).To(HaveKeyWithValue(upgradeSnapshotLabel, "true"))
+					Expect(snapshot.Labels).To(HaveKeyWithValue(hcoutil.AppLabel, expected.hco.Name))
+					Expect(snapshot.Annotations).To(HaveKeyWithValue(upgradeSnapshotFromVersionAnnName, "1.4.5"))
+					Expect(snapshot.Annotations).To(HaveKeyWithValue(upgradeSnapshotToVersionAnnName, newHCOVersion))
+					Expect(snapshot.OwnerReferences).To(HaveLen(1))
+
+					snapshotHC := &hcov1beta1.HyperConverged{}
+					Expect(yaml.Unmarshal([]byte(snapshot.Data[upgradeSnapshotHyperConvergedKey]), snapshotHC)).To(Succeed())
+					Expect(snapshotHC.Name).To(Equal(expected.hco.Name))
+					Expect(snapshotHC.Annotations).To(Equal(expected.hco.Annotations))
+					Expect(snapshotHC.Spec.LiveMigrationConfig.BandwidthPerMigration).To(HaveValue(Equal("64Mi")))
+					Expect(snapshot.Data[upgradeSnapshotHyperConvergedKey]).ToNot(ContainSubstring("status"))
+
+					Expect(snapshot.Data[upgradeSnapshotOperandsKey]).To(ContainSubstring("kind: KubeVirt\n"))
+					Expect(snapshot.Data[upgradeSnapshotOperandsKey]).To(ContainSubstring("kind: CDI\n"))
+					Expect(snapshot.Data[upgradeSnapshotOperandsKey]).To(ContainSubstring("imageTag: pre-upgrade-tag\n"))
+					Expect(snapshot.Data[upgradeSnapshotOperandsKey]).ToNot(ContainSubstring("status:"))
+
+					expectedEvents := []commontestutils.MockEvent{
+						{
+							EventType: corev1.EventTypeNormal,
+							Reason:    upgradeSnapshotCreatedReason,
+							Msg:       "Stored the pre-upgrade snapshot in the hco-upgrade-snapshot-1.4.5 ConfigMap",
+						},
+					}
+					Expect(reconciler.eventEmitter.(*commontestutils.EventEmitterMock).CheckEvents(expectedEvents)).To(BeTrue())
+
+					By("not overriding the snapshot in the next reconciliation loops")
+					_, _, _ = doReconcile(cl, expected.hco, reconciler)
+
+					foundSnapshot := &corev1.ConfigMap{}
+					Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(snapshot), foundSnapshot)).To(Succeed())
+					Expect(foundSnapshot.Data).To(Equal(snapshot.Data))
+				})
+
+				It("should keep only the snapshots of the last versions", func() {
+					UpdateVersion(&expected.hco.Status, hcoVersionName, "1.9.0")
+
+					oldSnapshots := []*corev1.ConfigMap{
+						newSnapshot("1.10.0", expected.hco),
+						newSnapshot("1.6.0", expected.hco),
+						newSnapshot("1.8.0", expected.hco),
+					}
+
+					resources := expected.toArray()
+					for _, snapshot := range oldSnapshots {
+						resources = append(resources, snapshot)
+					}
+
+					cl := commontestutils.InitClient(resources)
+					_, _, _ = doReconcile(cl, expected.hco, nil)
+
+					snapshots := &corev1.ConfigMapList{}
+					Expect(cl.List(context.TODO(), snapshots, client.MatchingLabels{upgradeSnapshotLabel: "true"})).To(Succeed())
+
+					var names []string
+					for _, snapshot := range snapshots.Items {
+						names = append(names, snapshot.Name)
+					}
+					Expect(names).To(ConsistOf("hco-upgrade-snapshot-1.8.0", "hco-upgrade-snapshot-1.9.0", "hco-upgrade-snapshot-1.10.0"))
+				})
+
+				It("should restore the HyperConverged CR from a snapshot", func() {
+					snapshotHC := expected.hco.DeepCopy()
+					snapshotHC.Spec.LiveMigrationConfig.BandwidthPerMigration = ptr.To("64Mi")
+					snapshotHC.Annotations = map[string]string{common.JSONPatchKVAnnotationName: "[]"}
+					snapshot := newSnapshot("1.4.5", snapshotHC)
+
+					expected.hco.Annotations = map[string]string{
+						common.RestoreUpgradeSnapshotAnnotationName: snapshot.Name,
+						"other": "annotation",
+					}
+
+					cl := commontestutils.InitClient(append(expected.toArray(), snapshot))
+					foundResource, reconciler, _ := doReconcile(cl, expected.hco, nil)
+
+					Expect(foundResource.Spec.LiveMigrationConfig.BandwidthPerMigration).To(HaveValue(Equal("64Mi")))
+					Expect(foundResource.Annotations).To(Equal(map[string]string{
+						common.JSONPatchKVAnnotationName: "[]",
+						"other":                          "annotation",
+					}))
+
+					expectedEvents := []commontestutils.MockEvent{
+						{
+							EventType: corev1.EventTypeNormal,
+							Reason:    upgradeSnapshotRestoredReason,
+							Msg:       "Restored the spec and the annotations of the HyperConverged CR from the hco-upgrade-snapshot-1.4.5 pre-upgrade snapshot",
+						},
+					}
+					Expect(reconciler.eventEmitter.(*commontestutils.EventEmitterMock).CheckEvents(expectedEvents)).To(BeTrue())
+				})
+
+				It("should keep the annotations that were added after the snapshot", func() {
+					snapshotHC := expected.hco.DeepCopy()
+					snapshotHC.Annotations = map[string]string{
+						common.JSONPatchKVAnnotationName: "[]",
+						"user/annotation":                "old-value",
+					}
+					snapshot := newSnapshot("1.4.5", snapshotHC)
+
+					expected.hco.Annotations = map[string]string{
+						common.RestoreUpgradeSnapshotAnnotationName: snapshot.Name,
+						"user/annotation":                           "new-value",
+						"user/added-after-upgrade":                  "true",
+					}
+
+					cl := commontestutils.InitClient(append(expected.toArray(), snapshot))
+					foundResource, _, _ := doReconcile(cl, expected.hco, nil)
+
+					Expect(foundResource.Annotations).To(Equal(map[string]string{
+						common.JSONPatchKVAnnotationName: "[]",
+						"user/annotation":                "old-value",
+						"user/added-after-upgrade":       "true",
+					}))
+				})
+
+				It("should drop the restore annotation if the snapshot does not exist", func() {
+					expected.hco.Annotations = map[string]string{
+						common.RestoreUpgradeSnapshotAnnotationName: "hco-upgrade-snapshot-1.4.5",
+					}
+
+					cl := expected.initClient()
+					foundResource, reconciler, _ := doReconcile(cl, expected.hco, nil)
+
+					Expect(foundResource.Annotations).ToNot(HaveKey(common.RestoreUpgradeSnapshotAnnotationName))
+					Expect(foundResource.Spec).To(Equal(expected.hco.Spec))
+
+					expectedEvents := []commontestutils.MockEvent{
+						{
+							EventType: corev1.EventTypeWarning,
+							Reason:    upgradeSnapshotRestoreFailedReason,
+							Msg:       "Failed to restore the hco-upgrade-snapshot-1.4.5 pre-upgrade snapshot: can't find the hco-upgrade-snapshot-1.4.5 pre-upgrade snapshot",
+						},
+					}
+					Expect(reconciler.eventEmitter.(*commontestutils.EventEmitterMock).CheckEvents(expectedEvents)).To(BeTrue())
+				})
+
+				It("should postpone the restore until the upgrade is completed", func() {
+					snapshotHC := expected.hco.DeepCopy()
+					snapshotHC.Spec.LiveMigrationConfig.BandwidthPerMigration = ptr.To("64Mi")
+					snapshot := newSnapshot("1.4.5", snapshotHC)
+
+					UpdateVersion(&expected.hco.Status, hcoVersionName, oldVersion)
+					expected.hco.Annotations = map[string]string{
+						common.RestoreUpgradeSnapshotAnnotationName: snapshot.Name,
+					}
+
+					cl := commontestutils.InitClient(append(expected.toArray(), snapshot))
+					foundResource, _, _ := doReconcile(cl, expected.hco, nil)
+
+					Expect(foundResource.Annotations).To(HaveKeyWithValue(common.RestoreUpgradeSnapshotAnnotationName, snapshot.Name))
+					Expect(foundResource.Spec.LiveMigrationConfig.BandwidthPerMigration).To(BeNil())
+				})
+			})
 		})
 
 		Context("Aggregate Negative Conditions", func() {
@@ -3864,6 +4451,256 @@ var _ = Describe("HyperconvergedController", func() {
 					})
 				})
 			})
+
+			Context("Detection of a tainted configuration using operand overrides", func() {
+				It("Raises a TaintedConfiguration condition upon detection of such configuration", func() {
+					hco.Spec.OperandOverrides = &hcov1beta1.OperandOverrides{
+						KubeVirt: &hcov1beta1.OperandOverride{
+							Patches: []hcov1beta1.OperandPatch{
+								{
+									Op:    "add",
+									Path:  "/spec/configuration/migrations",
+									Value: &apiextensionsv1.JSON{Raw: []byte(`{"allowPostCopy": true}`)},
+								},
+							},
+						},
+						CDI: &hcov1beta1.OperandOverride{
+							Patches: []hcov1beta1.OperandPatch{
+								{
+									Op:    "add",
+									Path:  "/spec/config/featureGates/-",
+									Value: &apiextensionsv1.JSON{Raw: []byte(`"fg1"`)},
+								},
+								{
+									Op:    "add",
+									Path:  "/spec/config/featureGates/-",
+									Value: &apiextensionsv1.JSON{Raw: []byte(`"fg2"`)},
+								},
+							},
+						},
+					}
+					for _, operand := range operands.OperandOverrideNames {
+						metrics.SetUnsafeModificationCount(0, operandOverridesMetricLabelPrefix+operand)
+					}
+
+					cl := commontestutils.InitClient([]client.Object{hcoNamespace, hco})
+					r := initReconciler(cl, nil)
+
+					By("Reconcile", func() {
+						res, err := r.Reconcile(context.TODO(), request)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(res).To(Equal(reconcile.Result{Requeue: true}))
+					})
+
+					foundResource := &hcov1beta1.HyperConverged{}
+					Expect(
+						cl.Get(context.TODO(),
+							types.NamespacedName{Name: hco.Name, Namespace: hco.Namespace},
+							foundResource),
+					).To(Succeed())
+
+					By("Verify HC conditions", func() {
+						Expect(foundResource.Status.Conditions).To(ContainElement(commontestutils.RepresentCondition(metav1.Condition{
+							Type:    hcov1beta1.ConditionTaintedConfiguration,
+							Status:  metav1.ConditionTrue,
+							Reason:  taintedOverrideReason,
+							Message: taintedOverrideMessage,
+						})))
+					})
+
+					By("verify the operand overrides status", func() {
+						Expect(foundResource.Status.OperandOverrides).To(ConsistOf(
+							hcov1beta1.OperandOverrideStatus{Operand: hcov1beta1.OperandKubeVirt, Applied: true, Operations: 1, ChangedPaths: []string{"/spec/configuration/migrations"}},
+							hcov1beta1.OperandOverrideStatus{Operand: hcov1beta1.OperandCDI, Applied: true, Operations: 2, ChangedPaths: []string{"/spec/config/featureGates/-"}},
+						))
+					})
+
+					By("verify that the metrics match to the overrides", func() {
+						verifyUnsafeMetrics(1, operandOverridesMetricLabelPrefix+hcov1beta1.OperandKubeVirt)
+						verifyUnsafeMetrics(2, operandOverridesMetricLabelPrefix+hcov1beta1.OperandCDI)
+						verifyUnsafeMetrics(0, operandOverridesMetricLabelPrefix+hcov1beta1.OperandSSP)
+					})
+
+					By("Verify that KV was modified by the override", func() {
+						kv := operands.NewKubeVirtWithNameOnly(hco)
+						Expect(
+							cl.Get(context.TODO(),
+								types.NamespacedName{Name: kv.Name, Namespace: kv.Namespace},
+								kv),
+						).To(Succeed())
+
+						Expect(kv.Spec.Configuration.MigrationConfiguration).ToNot(BeNil())
+						Expect(kv.Spec.Configuration.MigrationConfiguration.AllowPostCopy).To(HaveValue(BeTrue()))
+					})
+
+					By("Verify that CDI was modified by the override", func() {
+						cdi := operands.NewCDIWithNameOnly(hco)
+						Expect(
+							cl.Get(context.TODO(),
+								types.NamespacedName{Name: cdi.Name, Namespace: cdi.Namespace},
+								cdi),
+						).To(Succeed())
+
+						Expect(cdi.Spec.Config.FeatureGates).To(ContainElements("fg1", "fg2"))
+					})
+				})
+
+				It("Removes the TaintedConfiguration condition and the status upon removal of the overrides", func() {
+					hco.Status.Conditions = append(hco.Status.Conditions, metav1.Condition{
+						Type:    hcov1beta1.ConditionTaintedConfiguration,
+						Status:  metav1.ConditionTrue,
+						Reason:  taintedOverrideReason,
+						Message: taintedOverrideMessage,
+					})
+					hco.Status.OperandOverrides = []hcov1beta1.OperandOverrideStatus{
+						{Operand: hcov1beta1.OperandKubeVirt, Applied: true, Operations: 1},
+					}
+
+					metrics.SetUnsafeModificationCount(1, operandOverridesMetricLabelPrefix+hcov1beta1.OperandKubeVirt)
+
+					cl := commontestutils.InitClient([]client.Object{hcoNamespace, hco})
+					r := initReconciler(cl, nil)
+
+					res, err := r.Reconcile(context.TODO(), request)
+					Expect(err).ToNot(HaveOccurred())
+					// Expecting "Requeue: false" since the conditions aren't empty
+					Expect(res).To(Equal(reconcile.Result{Requeue: false}))
+
+					foundResource := &hcov1beta1.HyperConverged{}
+					Expect(
+						cl.Get(context.TODO(),
+							types.NamespacedName{Name: hco.Name, Namespace: hco.Namespace},
+							foundResource),
+					).To(Succeed())
+
+					Expect(apimetav1.FindStatusCondition(foundResource.Status.Conditions, hcov1beta1.ConditionTaintedConfiguration)).To(BeNil())
+					Expect(foundResource.Status.OperandOverrides).To(BeEmpty())
+					verifyUnsafeMetrics(0, operandOverridesMetricLabelPrefix+hcov1beta1.OperandKubeVirt)
+				})
+			})
+		})
+
+		Context("Reconcile policy", func() {
+			var (
+				hcoNamespace *corev1.Namespace
+				hco          *hcov1beta1.HyperConverged
+			)
+			BeforeEach(func() {
+				hcoNamespace = commontestutils.NewHcoNamespace()
+				hco = commontestutils.NewHco()
+				UpdateVersion(&hco.Status, hcoVersionName, version.Version)
+				_ = os.Setenv(hcoutil.HcoKvIoVersionName, version.Version)
+			})
+
+			getHC := func(cl client.Client) *hcov1beta1.HyperConverged {
+				foundResource := &hcov1beta1.HyperConverged{}
+				ExpectWithOffset(1,
+					cl.Get(context.TODO(),
+						types.NamespacedName{Name: hco.Name, Namespace: hco.Namespace},
+						foundResource),
+				).To(Succeed())
+				return foundResource
+			}
+
+			It("Raises the ReconcilePaused condition when the reconciliation is paused", func() {
+				hco.Spec.ReconcilePolicy = &hcov1beta1.ReconcilePolicy{Paused: true}
+
+				cl := commontestutils.InitClient([]client.Object{hcoNamespace, hco})
+				r := initReconciler(cl, nil)
+
+				_, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+
+				foundResource := getHC(cl)
+				Expect(foundResource.Status.Conditions).To(ContainElement(commontestutils.RepresentCondition(metav1.Condition{
+					Type:    hcov1beta1.ConditionReconcilePaused,
+					Status:  metav1.ConditionTrue,
+					Reason:  reconcilePausedReason,
+					Message: reconcilePausedMessage,
+				})))
+
+				for _, operand := range operands.OperandOverrideNames {
+					verifyReconcilePausedMetric(operand, 1)
+				}
+			})
+
+			It("Raises the ReconcilePaused condition when some operands are Unmanaged", func() {
+				hco.Spec.ReconcilePolicy = &hcov1beta1.ReconcilePolicy{
+					Operands: &hcov1beta1.OperandManagementStates{
+						CDI: hcov1beta1.ManagementStateUnmanaged,
+						SSP: hcov1beta1.ManagementStateUnmanaged,
+					},
+				}
+
+				cl := commontestutils.InitClient([]client.Object{hcoNamespace, hco})
+				r := initReconciler(cl, nil)
+
+				_, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+
+				foundResource := getHC(cl)
+				Expect(foundResource.Status.Conditions).To(ContainElement(commontestutils.RepresentCondition(metav1.Condition{
+					Type:    hcov1beta1.ConditionReconcilePaused,
+					Status:  metav1.ConditionTrue,
+					Reason:  reconcilePausedUnmanagedReason,
+					Message: "The following operands are Unmanaged by the spec.reconcilePolicy field: cdi, ssp",
+				})))
+
+				verifyReconcilePausedMetric(hcov1beta1.OperandKubeVirt, 0)
+				verifyReconcilePausedMetric(hcov1beta1.OperandCDI, 1)
+				verifyReconcilePausedMetric(hcov1beta1.OperandSSP, 1)
+			})
+
+			It("Removes the ReconcilePaused condition when the policy is removed", func() {
+				hco.Status.Conditions = append(hco.Status.Conditions, metav1.Condition{
+					Type:    hcov1beta1.ConditionReconcilePaused,
+					Status:  metav1.ConditionTrue,
+					Reason:  reconcilePausedReason,
+					Message: reconcilePausedMessage,
+				})
+				metrics.SetReconcilePaused(hcov1beta1.OperandKubeVirt, true)
+
+				cl := commontestutils.InitClient([]client.Object{hcoNamespace, hco})
+				r := initReconciler(cl, nil)
+
+				_, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+
+				foundResource := getHC(cl)
+				Expect(apimetav1.FindStatusCondition(foundResource.Status.Conditions, hcov1beta1.ConditionReconcilePaused)).To(BeNil())
+				verifyReconcilePausedMetric(hcov1beta1.OperandKubeVirt, 0)
+			})
+		})
+
+		Context("Deprecated field migrations", func() {
+			It("should migrate the deprecated fields of the stored HyperConverged CR", func() {
+				hcoNamespace := commontestutils.NewHcoNamespace()
+				hco := commontestutils.NewHco()
+				UpdateVersion(&hco.Status, hcoVersionName, version.Version)
+				_ = os.Setenv(hcoutil.HcoKvIoVersionName, version.Version)
+				hco.Spec.MediatedDevicesConfiguration = &hcov1beta1.MediatedDevicesConfiguration{
+					MediatedDevicesTypes: []string{"nvidia-222"}, //nolint SA1019
+				}
+
+				cl := commontestutils.InitClient([]client.Object{hcoNamespace, hco})
+				r := initReconciler(cl, nil)
+
+				_, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+
+				foundResource := &hcov1beta1.HyperConverged{}
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(hco), foundResource)).To(Succeed())
+				Expect(foundResource.Spec.MediatedDevicesConfiguration.MediatedDeviceTypes).To(Equal([]string{"nvidia-222"}))
+
+				expectedEvents := []commontestutils.MockEvent{
+					{
+						EventType: corev1.EventTypeWarning,
+						Reason:    "DeprecatedFieldMigrated",
+						Msg:       "spec.mediatedDevicesConfiguration.mediatedDevicesTypes: the deprecated field was copied to spec.mediatedDevicesConfiguration.mediatedDeviceTypes; the field is removed in version 1.15.0",
+					},
+				}
+				Expect(r.eventEmitter.(*commontestutils.EventEmitterMock).CheckEvents(expectedEvents)).To(BeTrue())
+			})
 		})
 
 	})
@@ -3875,6 +4712,12 @@ func verifyUnsafeMetrics(expected int, annotation string) {
 	ExpectWithOffset(1, count).Should(BeEquivalentTo(expected))
 }
 
+func verifyReconcilePausedMetric(operand string, expected float64) {
+	paused, err := metrics.GetReconcilePaused(operand)
+	ExpectWithOffset(1, err).ShouldNot(HaveOccurred())
+	ExpectWithOffset(1, paused).Should(Equal(expected))
+}
+
 func verifyHyperConvergedCRExistsMetricTrue() {
 	hcExists, err := metrics.IsHCOMetricHyperConvergedExists()
 	ExpectWithOffset(1, err).ShouldNot(HaveOccurred())
@@ -3,9 +3,11 @@ package hyperconverged
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"strings"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -40,6 +42,44 @@ type objectToBeRemoved struct {
 	GroupVersionKind schema.GroupVersionKind `json:"groupVersionKind"`
 	// objectKey contains name and namespace of the object to be removed.
 	ObjectKey types.NamespacedName `json:"objectKey"`
+	// LabelSelector selects the objects to be removed, instead of objectKey.name. If objectKey.namespace is set, only
+	// the objects in this namespace are removed. Only objects that were deployed by HCO are removed.
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
+type objectPatch struct {
+	// SemverRange is a set of conditions which specify which versions satisfy the range
+	// (see https://github.com/blang/semver#ranges as a reference).
+	SemverRange string `json:"semverRange"`
+	// GroupVersionKind unambiguously identifies the kind of the object to be patched
+	GroupVersionKind schema.GroupVersionKind `json:"groupVersionKind"`
+	// objectKey contains name and namespace of the object to be patched.
+	ObjectKey types.NamespacedName `json:"objectKey"`
+	// JSONPatch contains a sequence of operations to apply to the object during upgrades
+	// (see: https://datatracker.ietf.org/doc/html/rfc6902 as the format reference).
+	JSONPatch jsonpatch.Patch `json:"jsonPatch,omitempty"`
+	// jsonPatchApplyOptions specifies options for calls to ApplyWithOptions.
+	// jsonpatch.NewApplyOptions defaults are applied if empty.
+	JSONPatchApplyOptions *jsonpatch.ApplyOptions `json:"jsonPatchApplyOptions,omitempty"`
+	// MergePatch is a JSON merge patch to apply to the object during upgrades, instead of jsonPatch
+	// (see: https://datatracker.ietf.org/doc/html/rfc7386 as the format reference).
+	MergePatch json.RawMessage `json:"mergePatch,omitempty"`
+}
+
+type upgradeAssertion struct {
+	// SemverRange is a set of conditions which specify which versions satisfy the range
+	// (see https://github.com/blang/semver#ranges as a reference).
+	SemverRange string `json:"semverRange"`
+	// GroupVersionKind unambiguously identifies the kind of the object to be tested. If empty, the HyperConverged CR
+	// is tested.
+	GroupVersionKind schema.GroupVersionKind `json:"groupVersionKind,omitempty"`
+	// objectKey contains name and namespace of the object to be tested.
+	ObjectKey types.NamespacedName `json:"objectKey,omitempty"`
+	// JSONPatch contains a sequence of "test" operations. If any of them fails, or if the object is missing, the
+	// upgrade is blocked.
+	JSONPatch jsonpatch.Patch `json:"jsonPatch"`
+	// Message explains why the upgrade is blocked, and what should be done to unblock it.
+	Message string `json:"message,omitempty"`
 }
 
 type UpgradePatches struct {
@@ -49,6 +89,13 @@ type UpgradePatches struct {
 	// ObjectsToBeRemoved is a list of objects to be removed on upgrades.
 	// Each objectToBeRemoved consists in a semver range of affected source versions and schema.GroupVersionKind and types.NamespacedName of the object to be eventually removed during the upgrade.
 	ObjectsToBeRemoved []objectToBeRemoved `json:"objectsToBeRemoved"`
+	// ObjectPatches is a list of patches to apply on objects owned by HCO, other than the HyperConverged CR.
+	// Each objectPatch consists in a semver range of affected source versions, the object to patch, and either a json
+	// patch or a json merge patch.
+	ObjectPatches []objectPatch `json:"objectPatches,omitempty"`
+	// UpgradeAssertions is a list of checks to run before upgrading. If any of them fails, the upgrade is blocked until
+	// the cluster is fixed.
+	UpgradeAssertions []upgradeAssertion `json:"upgradeAssertions,omitempty"`
 }
 
 var (
@@ -98,6 +145,16 @@ func validateUpgradePatches(req *common.HcoRequest) error {
 			return verr
 		}
 	}
+	for _, p := range hcoUpgradeChanges.ObjectPatches {
+		if verr := validateObjectPatch(p); verr != nil {
+			return verr
+		}
+	}
+	for _, a := range hcoUpgradeChanges.UpgradeAssertions {
+		if verr := validateUpgradeAssertion(a); verr != nil {
+			return verr
+		}
+	}
 	return nil
 }
 
@@ -144,8 +201,119 @@ func validateUpgradeLeftover(_ *common.HcoRequest, r objectToBeRemoved) error {
 	if r.GroupVersionKind.Version == "" {
 		return errors.New("missing object API version")
 	}
+	if r.LabelSelector != nil {
+		if r.ObjectKey.Name != "" {
+			return errors.New("objectKey.name and labelSelector are mutually exclusive")
+		}
+		return validateLabelSelector(r.LabelSelector)
+	}
 	if r.ObjectKey.Name == "" {
 		return errors.New("missing object name")
 	}
 	return nil
 }
+
+func validateLabelSelector(labelSelector *metav1.LabelSelector) error {
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return fmt.Errorf("invalid labelSelector: %w", err)
+	}
+	if selector.Empty() {
+		return errors.New("empty labelSelector")
+	}
+	return nil
+}
+
+func validateObjectPatch(p objectPatch) error {
+	if err := validateUpgradeObject(p.SemverRange, p.GroupVersionKind, p.ObjectKey); err != nil {
+		return err
+	}
+
+	switch {
+	case len(p.JSONPatch) > 0 && len(p.MergePatch) > 0:
+		return errors.New("jsonPatch and mergePatch are mutually exclusive")
+	case len(p.JSONPatch) > 0:
+		for _, patch := range p.JSONPatch {
+			path, err := patch.Path()
+			if err != nil {
+				return err
+			}
+			if isProtectedObjectPath(path) {
+				return fmt.Errorf("can't modify %s", path)
+			}
+		}
+	case len(p.MergePatch) > 0:
+		mergePatch := map[string]any{}
+		if err := json.Unmarshal(p.MergePatch, &mergePatch); err != nil {
+			return fmt.Errorf("invalid mergePatch: %w", err)
+		}
+		for field := range mergePatch {
+			if isProtectedObjectPath("/" + field) {
+				return fmt.Errorf("can't modify /%s", field)
+			}
+		}
+		if metadata, ok := mergePatch["metadata"].(map[string]any); ok {
+			for field := range metadata {
+				if isProtectedObjectPath("/metadata/" + field) {
+					return fmt.Errorf("can't modify /metadata/%s", field)
+				}
+			}
+		}
+	default:
+		return errors.New("missing jsonPatch or mergePatch")
+	}
+
+	return nil
+}
+
+// protectedObjectPaths are the fields that identify an object, or that are not modified by the object owner
+var protectedObjectPaths = []string{"/apiVersion", "/kind", "/metadata/name", "/metadata/namespace", "/status"}
+
+func isProtectedObjectPath(path string) bool {
+	for _, protected := range protectedObjectPaths {
+		if path == protected || strings.HasPrefix(path, protected+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func validateUpgradeAssertion(a upgradeAssertion) error {
+	if a.GroupVersionKind.Kind != "" || a.GroupVersionKind.Version != "" {
+		if err := validateUpgradeObject(a.SemverRange, a.GroupVersionKind, a.ObjectKey); err != nil {
+			return err
+		}
+	} else if _, err := semver.ParseRange(a.SemverRange); err != nil {
+		return err
+	}
+
+	if len(a.JSONPatch) == 0 {
+		return errors.New("missing jsonPatch")
+	}
+	for _, patch := range a.JSONPatch {
+		if patch.Kind() != "test" {
+			return fmt.Errorf("upgrade assertions only support test operations; found %s", patch.Kind())
+		}
+		if _, err := patch.Path(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateUpgradeObject(semverRange string, gvk schema.GroupVersionKind, objectKey types.NamespacedName) error {
+	if _, err := semver.ParseRange(semverRange); err != nil {
+		return err
+	}
+	if gvk.Kind == "" {
+		return errors.New("missing object kind")
+	}
+	if gvk.Version == "" {
+		return errors.New("missing object API version")
+	}
+	if objectKey.Name == "" {
+		return errors.New("missing object name")
+	}
+	return nil
+}
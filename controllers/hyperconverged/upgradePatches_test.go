@@ -173,6 +173,95 @@ var _ = Describe("upgradePatches", func() {
 					"badObject3m.json",
 					"missing object name",
 				),
+				Entry(
+					"both object name and label selector",
+					"badSelector1.json",
+					"objectKey.name and labelSelector are mutually exclusive",
+				),
+				Entry(
+					"empty label selector",
+					"badSelector2.json",
+					"empty labelSelector",
+				),
+				Entry(
+					"invalid label selector",
+					"badSelector3.json",
+					"invalid labelSelector",
+				),
+			)
+
+		})
+
+		Context("objectPatches", func() {
+
+			It("should correctly parse and validate object patches, label selectors and upgrade assertions", func() {
+				Expect(copyTestFile("validObjectPatches.json")).To(Succeed())
+				Expect(validateUpgradePatches(req)).To(Succeed())
+
+				Expect(hcoUpgradeChanges.ObjectsToBeRemoved).To(HaveLen(1))
+				Expect(hcoUpgradeChanges.ObjectsToBeRemoved[0].LabelSelector).ToNot(BeNil())
+				Expect(hcoUpgradeChanges.ObjectPatches).To(HaveLen(2))
+				Expect(hcoUpgradeChanges.UpgradeAssertions).To(HaveLen(2))
+			})
+
+			DescribeTable(
+				"should fail validating upgradePatches with bad object patches",
+				func(filename, message string) {
+					Expect(copyTestFile(filename)).To(Succeed())
+					Expect(validateUpgradePatches(req)).To(MatchError(HavePrefix(message)))
+				},
+				Entry(
+					"missing object name",
+					"badObjectPatch1.json",
+					"missing object name",
+				),
+				Entry(
+					"both jsonPatch and mergePatch",
+					"badObjectPatch2.json",
+					"jsonPatch and mergePatch are mutually exclusive",
+				),
+				Entry(
+					"neither jsonPatch nor mergePatch",
+					"badObjectPatch3.json",
+					"missing jsonPatch or mergePatch",
+				),
+				Entry(
+					"jsonPatch modifies the object name",
+					"badObjectPatch4.json",
+					"can't modify /metadata/name",
+				),
+				Entry(
+					"mergePatch modifies the object status",
+					"badObjectPatch5.json",
+					"can't modify /status",
+				),
+			)
+
+		})
+
+		Context("upgradeAssertions", func() {
+
+			DescribeTable(
+				"should fail validating upgradePatches with bad upgrade assertions",
+				func(filename, message string) {
+					Expect(copyTestFile(filename)).To(Succeed())
+					Expect(validateUpgradePatches(req)).To(MatchError(HavePrefix(message)))
+				},
+				Entry(
+					"non-test operation",
+					"badAssertion1.json",
+					"upgrade assertions only support test operations",
+				),
+				Entry(
+					"missing jsonPatch",
+					"badAssertion2.json",
+					"missing jsonPatch",
+				),
+				Entry(
+					"bad semver range",
+					"badAssertion3.json",
+					"Could not get version from string:",
+				),
 			)
 
 		})
@@ -0,0 +1,293 @@
+package hyperconverged
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/ghodss/yaml"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	upgradeSnapshotPrefix = "hco-upgrade-snapshot-"
+	maxUpgradeSnapshots   = 3
+
+	// upgradeSnapshotLabel marks the ConfigMaps that hold the pre-upgrade snapshots
+	upgradeSnapshotLabel              = "hco.kubevirt.io/upgrade-snapshot"
+	upgradeSnapshotFromVersionAnnName = "hco.kubevirt.io/from-version"
+	upgradeSnapshotToVersionAnnName   = "hco.kubevirt.io/to-version"
+
+	upgradeSnapshotHyperConvergedKey = "hyperconverged.yaml"
+	upgradeSnapshotOperandsKey       = "operands.yaml"
+
+	upgradeSnapshotCreatedReason       = "UpgradeSnapshotCreated"
+	upgradeSnapshotRestoredReason      = "UpgradeSnapshotRestored"
+	upgradeSnapshotRestoreFailedReason = "UpgradeSnapshotRestoreFailed"
+)
+
+var invalidSnapshotNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// getUpgradeSnapshotName returns the name of the ConfigMap that holds the snapshot that HCO takes before upgrading
+// from fromVersion
+func getUpgradeSnapshotName(fromVersion string) string {
+	if fromVersion == "" {
+		fromVersion = "unknown"
+	}
+	return upgradeSnapshotPrefix + strings.Trim(invalidSnapshotNameChars.ReplaceAllString(strings.ToLower(fromVersion), "-"), "-.")
+}
+
+// ensureUpgradeSnapshot stores the spec and the annotations of the HyperConverged CR, and the operand objects rendered
+// from them, in a ConfigMap, before the first upgrade patch or removal runs. The snapshot is taken once per version
+// HCO is upgraded from, and only the last maxUpgradeSnapshots snapshots are kept.
+func (r *ReconcileHyperConverged) ensureUpgradeSnapshot(req *common.HcoRequest) error {
+	knownHcoVersion, _ := GetVersion(&req.Instance.Status, hcoVersionName)
+	name := getUpgradeSnapshotName(knownHcoVersion)
+
+	err := r.client.Get(req.Ctx, client.ObjectKey{Namespace: req.Namespace, Name: name}, &corev1.ConfigMap{})
+	if err == nil {
+		return nil
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	snapshot, err := r.newUpgradeSnapshot(req, name, knownHcoVersion)
+	if err != nil {
+		req.Logger.Error(err, "failed to take the pre-upgrade snapshot")
+		return err
+	}
+
+	if err = r.client.Create(req.Ctx, snapshot); err != nil {
+		req.Logger.Error(err, "failed to store the pre-upgrade snapshot", "name", name)
+		return err
+	}
+
+	req.Logger.Info("stored the pre-upgrade snapshot", "name", name)
+	r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, upgradeSnapshotCreatedReason,
+		fmt.Sprintf("Stored the pre-upgrade snapshot in the %s ConfigMap", name))
+
+	return r.pruneUpgradeSnapshots(req)
+}
+
+func (r *ReconcileHyperConverged) newUpgradeSnapshot(req *common.HcoRequest, name, fromVersion string) (*corev1.ConfigMap, error) {
+	hc := req.Instance.DeepCopy()
+
+	hcYAML, err := marshalHyperConvergedSnapshot(hc)
+	if err != nil {
+		return nil, err
+	}
+
+	operandsYAML, err := r.getLiveOperandsSnapshot(req, hc)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := hcoutil.GetLabels(hc.Name, hcoutil.AppComponentDeployment)
+	labels[upgradeSnapshotLabel] = "true"
+
+	snapshot := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: req.Namespace,
+			Labels:    labels,
+			Annotations: map[string]string{
+				upgradeSnapshotFromVersionAnnName: fromVersion,
+				upgradeSnapshotToVersionAnnName:   r.ownVersion,
+			},
+		},
+		Data: map[string]string{
+			upgradeSnapshotHyperConvergedKey: string(hcYAML),
+			upgradeSnapshotOperandsKey:       strings.Join(operandsYAML, "---\n"),
+		},
+	}
+
+	if err = controllerutil.SetControllerReference(req.Instance, snapshot, r.scheme); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// getLiveOperandsSnapshot returns the operand objects, as they are in the cluster before the upgrade, as YAML. The
+// new HCO version only renders the list of the objects; their spec is read from the cluster, so the snapshot keeps
+// the configuration the previous HCO version applied. The objects that do not exist are skipped.
+func (r *ReconcileHyperConverged) getLiveOperandsSnapshot(req *common.HcoRequest, hc *hcov1beta1.HyperConverged) ([]string, error) {
+	objects, err := operands.Render(hc, r.scheme, hcoutil.GetClusterInfo())
+	if err != nil {
+		return nil, err
+	}
+
+	var operandsYAML []string
+	for _, obj := range objects {
+		if _, hasSpec := obj.Object["spec"]; !hasSpec {
+			continue
+		}
+
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(obj.GroupVersionKind())
+		err = r.client.Get(req.Ctx, client.ObjectKeyFromObject(obj), live)
+		if apierrors.IsNotFound(err) || apimeta.IsNoMatchError(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		objYAML, err := yaml.Marshal(getOperandSnapshot(live))
+		if err != nil {
+			return nil, err
+		}
+		operandsYAML = append(operandsYAML, string(objYAML))
+	}
+
+	return operandsYAML, nil
+}
+
+// getOperandSnapshot returns the identity, the labels, the annotations and the spec of a live operand object
+func getOperandSnapshot(live *unstructured.Unstructured) map[string]any {
+	snapshot := &unstructured.Unstructured{Object: map[string]any{}}
+	snapshot.SetAPIVersion(live.GetAPIVersion())
+	snapshot.SetKind(live.GetKind())
+	snapshot.SetName(live.GetName())
+	snapshot.SetNamespace(live.GetNamespace())
+	snapshot.SetLabels(live.GetLabels())
+	snapshot.SetAnnotations(live.GetAnnotations())
+	snapshot.Object["spec"] = live.Object["spec"]
+
+	return snapshot.Object
+}
+
+// marshalHyperConvergedSnapshot returns the HyperConverged CR as YAML, with only its identity, labels, annotations and
+// spec
+func marshalHyperConvergedSnapshot(hc *hcov1beta1.HyperConverged) ([]byte, error) {
+	snapshot := &hcov1beta1.HyperConverged{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: hcov1beta1.SchemeGroupVersion.String(),
+			Kind:       hcoutil.HyperConvergedKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        hc.Name,
+			Namespace:   hc.Namespace,
+			Labels:      hc.Labels,
+			Annotations: hc.Annotations,
+		},
+		Spec: hc.Spec,
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	delete(content, "status")
+	unstructured.RemoveNestedField(content, "metadata", "creationTimestamp")
+
+	return yaml.Marshal(content)
+}
+
+// pruneUpgradeSnapshots removes the snapshots of the oldest versions, and keeps only the last maxUpgradeSnapshots
+func (r *ReconcileHyperConverged) pruneUpgradeSnapshots(req *common.HcoRequest) error {
+	snapshots := &corev1.ConfigMapList{}
+	err := r.client.List(req.Ctx, snapshots,
+		client.InNamespace(req.Namespace),
+		client.MatchingLabels{hcoutil.AppLabel: req.Instance.Name, upgradeSnapshotLabel: "true"},
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(snapshots.Items) <= maxUpgradeSnapshots {
+		return nil
+	}
+
+	slices.SortFunc(snapshots.Items, func(a, b corev1.ConfigMap) int {
+		return getSnapshotFromVersion(a).Compare(getSnapshotFromVersion(b))
+	})
+
+	for i := range snapshots.Items[:len(snapshots.Items)-maxUpgradeSnapshots] {
+		snapshot := &snapshots.Items[i]
+		if err = r.client.Delete(req.Ctx, snapshot); err != nil && !apierrors.IsNotFound(err) {
+			req.Logger.Error(err, "failed to remove an old pre-upgrade snapshot", "name", snapshot.Name)
+			return err
+		}
+		req.Logger.Info("removed an old pre-upgrade snapshot", "name", snapshot.Name)
+	}
+
+	return nil
+}
+
+func getSnapshotFromVersion(snapshot corev1.ConfigMap) semver.Version {
+	sv, err := semver.ParseTolerant(snapshot.Annotations[upgradeSnapshotFromVersionAnnName])
+	if err != nil {
+		return semver.Version{}
+	}
+	return sv
+}
+
+// restoreUpgradeSnapshot restores the spec and the annotations of the HyperConverged CR from the pre-upgrade snapshot
+// that is named in the restore annotation. The snapshot annotations are merged over the current ones, so the
+// annotations added since the upgrade are kept. The restore is postponed until the upgrade is completed, so the
+// upgrade patches won't run again on the restored spec.
+//
+// The operands.yaml part of the snapshot is for reference only, and is not restored: HCO renders the operand objects
+// from the restored spec.
+func (r *ReconcileHyperConverged) restoreUpgradeSnapshot(req *common.HcoRequest) error {
+	name, requested := req.Instance.Annotations[common.RestoreUpgradeSnapshotAnnotationName]
+	if !requested {
+		return nil
+	}
+
+	if r.upgradeMode {
+		req.Logger.Info("the HyperConverged CR is being upgraded; postponing the restore of the pre-upgrade snapshot", "name", name)
+		return nil
+	}
+
+	snapshot := &corev1.ConfigMap{}
+	err := r.client.Get(req.Ctx, client.ObjectKey{Namespace: req.Namespace, Name: name}, snapshot)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	var restored *hcov1beta1.HyperConverged
+	if err == nil && snapshot.Labels[upgradeSnapshotLabel] == "true" {
+		restored = &hcov1beta1.HyperConverged{}
+		err = yaml.Unmarshal([]byte(snapshot.Data[upgradeSnapshotHyperConvergedKey]), restored)
+	} else {
+		err = fmt.Errorf("can't find the %s pre-upgrade snapshot", name)
+	}
+
+	if err != nil {
+		req.Logger.Error(err, "failed to restore the pre-upgrade snapshot", "name", name)
+		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, upgradeSnapshotRestoreFailedReason,
+			fmt.Sprintf("Failed to restore the %s pre-upgrade snapshot: %v", name, err))
+
+		// remove the annotation, so the restore is not retried with the same bad snapshot
+		delete(req.Instance.Annotations, common.RestoreUpgradeSnapshotAnnotationName)
+		req.Dirty = true
+		return nil
+	}
+
+	req.Instance.Spec = restored.Spec
+	maps.Copy(req.Instance.Annotations, restored.Annotations)
+	delete(req.Instance.Annotations, common.RestoreUpgradeSnapshotAnnotationName)
+	req.Dirty = true
+
+	req.Logger.Info("restored the pre-upgrade snapshot", "name", name)
+	r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, upgradeSnapshotRestoredReason,
+		fmt.Sprintf("Restored the spec and the annotations of the HyperConverged CR from the %s pre-upgrade snapshot", name))
+
+	return nil
+}
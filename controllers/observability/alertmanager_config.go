@@ -0,0 +1,167 @@
+package observability
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager"
+)
+
+// The Alertmanager endpoint and authentication are configured by the environment variables of the HCO deployment. On
+// OpenShift, they default to the Alertmanager of the cluster monitoring stack; on other clusters, the URL must be set.
+const (
+	alertmanagerURLEnv            = "ALERTMANAGER_URL"
+	alertmanagerCAFileEnv         = "ALERTMANAGER_CA_FILE"
+	alertmanagerAuthEnv           = "ALERTMANAGER_AUTH"
+	alertmanagerTokenFileEnv      = "ALERTMANAGER_TOKEN_FILE"
+	alertmanagerClientCertFileEnv = "ALERTMANAGER_CLIENT_CERT_FILE"
+	alertmanagerClientKeyFileEnv  = "ALERTMANAGER_CLIENT_KEY_FILE"
+
+	alertmanagerAuthBearer = "bearer"
+	alertmanagerAuthMTLS   = "mtls"
+	alertmanagerAuthNone   = "none"
+
+	openshiftAlertmanagerURL = "https://alertmanager-main.openshift-monitoring.svc.cluster.local:9094"
+	openshiftServiceCAPath   = "/var/run/secrets/kubernetes.io/serviceaccount/service-ca.crt"
+)
+
+// alertmanagerRequestTimeout bounds each request to Alertmanager, so an endpoint that doesn't answer can't block the
+// reconciliation; the AlertmanagerUnavailable condition is raised instead.
+var alertmanagerRequestTimeout = 30 * time.Second
+
+var errAlertmanagerNotConfigured = fmt.Errorf("the Alertmanager endpoint is not configured; set the %s environment variable", alertmanagerURLEnv)
+
+type alertmanagerConfig struct {
+	url            string
+	caFile         string
+	auth           string
+	tokenFile      string
+	clientCertFile string
+	clientKeyFile  string
+}
+
+func getAlertmanagerConfig(isOpenshift bool) (*alertmanagerConfig, error) {
+	cfg := &alertmanagerConfig{
+		url:            os.Getenv(alertmanagerURLEnv),
+		caFile:         os.Getenv(alertmanagerCAFileEnv),
+		auth:           strings.ToLower(os.Getenv(alertmanagerAuthEnv)),
+		tokenFile:      os.Getenv(alertmanagerTokenFileEnv),
+		clientCertFile: os.Getenv(alertmanagerClientCertFileEnv),
+		clientKeyFile:  os.Getenv(alertmanagerClientKeyFileEnv),
+	}
+
+	if cfg.url == "" {
+		if !isOpenshift {
+			return nil, errAlertmanagerNotConfigured
+		}
+		cfg.url = openshiftAlertmanagerURL
+		if cfg.caFile == "" {
+			cfg.caFile = openshiftServiceCAPath
+		}
+	}
+
+	amURL, err := url.Parse(cfg.url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s environment variable: %w", alertmanagerURLEnv, err)
+	}
+	if (amURL.Scheme != "http" && amURL.Scheme != "https") || amURL.Host == "" {
+		return nil, fmt.Errorf("invalid %s environment variable: %q; must be an http or https URL", alertmanagerURLEnv, cfg.url)
+	}
+
+	if cfg.auth == "" {
+		cfg.auth = alertmanagerAuthNone
+		if isOpenshift {
+			cfg.auth = alertmanagerAuthBearer
+		}
+	}
+
+	switch cfg.auth {
+	case alertmanagerAuthBearer, alertmanagerAuthNone:
+	case alertmanagerAuthMTLS:
+		if cfg.clientCertFile == "" || cfg.clientKeyFile == "" {
+			return nil, fmt.Errorf("the %s and %s environment variables are required for the mtls authentication", alertmanagerClientCertFileEnv, alertmanagerClientKeyFileEnv)
+		}
+	default:
+		return nil, fmt.Errorf("invalid %s environment variable: %q; must be one of bearer, mtls and none", alertmanagerAuthEnv, cfg.auth)
+	}
+
+	return cfg, nil
+}
+
+func (r *Reconciler) NewAlertmanagerApi() (*alertmanager.Api, error) {
+	cfg, err := getAlertmanagerConfig(r.isOpenshift)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{}
+	if cfg.caFile != "" {
+		caCert, err := os.ReadFile(cfg.caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ca cert: %w", err)
+		}
+
+		caCertPool := x509.NewCertPool()
+		if !caCertPool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("failed to parse the ca cert of %s", cfg.caFile)
+		}
+		tlsConfig.RootCAs = caCertPool
+	}
+
+	token := ""
+	switch cfg.auth {
+	case alertmanagerAuthBearer:
+		token, err = r.getAlertmanagerToken(cfg)
+		if err != nil {
+			return nil, err
+		}
+	case alertmanagerAuthMTLS:
+		cert, err := tls.LoadX509KeyPair(cfg.clientCertFile, cfg.clientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	httpClient := http.Client{
+		Timeout: alertmanagerRequestTimeout,
+	}
+	httpClient.Transport = &http.Transport{
+		TLSClientConfig: tlsConfig,
+	}
+
+	return alertmanager.NewAPI(httpClient, cfg.url, token), nil
+}
+
+// getAlertmanagerToken returns the token of the token file, or else the token of the service account of HCO. The
+// files are read on each call, as the projected service account tokens are rotated.
+func (r *Reconciler) getAlertmanagerToken(cfg *alertmanagerConfig) (string, error) {
+	if cfg.tokenFile != "" {
+		return readTokenFile(cfg.tokenFile)
+	}
+
+	if r.config != nil && r.config.BearerTokenFile != "" {
+		return readTokenFile(r.config.BearerTokenFile)
+	}
+
+	if r.config == nil || r.config.BearerToken == "" {
+		return "", errors.New("no bearer token is available for the Alertmanager authentication")
+	}
+
+	return r.config.BearerToken, nil
+}
+
+func readTokenFile(tokenFile string) (string, error) {
+	token, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the token file: %w", err)
+	}
+	return strings.TrimSpace(string(token)), nil
+}
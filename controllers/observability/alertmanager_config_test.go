@@ -0,0 +1,235 @@
+package observability
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+
+	"k8s.io/client-go/rest"
+)
+
+var _ = Describe("Alertmanager configuration", func() {
+	setEnv := func(env map[string]string) {
+		for _, name := range []string{alertmanagerURLEnv, alertmanagerCAFileEnv, alertmanagerAuthEnv, alertmanagerTokenFileEnv, alertmanagerClientCertFileEnv, alertmanagerClientKeyFileEnv} {
+			GinkgoT().Setenv(name, env[name])
+		}
+	}
+
+	It("should default to the cluster monitoring Alertmanager on OpenShift", func() {
+		setEnv(nil)
+
+		cfg, err := getAlertmanagerConfig(true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.url).To(Equal(openshiftAlertmanagerURL))
+		Expect(cfg.caFile).To(Equal(openshiftServiceCAPath))
+		Expect(cfg.auth).To(Equal(alertmanagerAuthBearer))
+	})
+
+	It("should return errAlertmanagerNotConfigured if the URL is not set on other clusters", func() {
+		setEnv(nil)
+
+		_, err := getAlertmanagerConfig(false)
+		Expect(err).To(MatchError(errAlertmanagerNotConfigured))
+	})
+
+	It("should read the configuration from the environment", func() {
+		setEnv(map[string]string{
+			alertmanagerURLEnv:            "https://alertmanager.monitoring.svc:9093",
+			alertmanagerCAFileEnv:         "/etc/alertmanager/ca.crt",
+			alertmanagerAuthEnv:           "mTLS",
+			alertmanagerClientCertFileEnv: "/etc/alertmanager/tls.crt",
+			alertmanagerClientKeyFileEnv:  "/etc/alertmanager/tls.key",
+		})
+
+		cfg, err := getAlertmanagerConfig(false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*cfg).To(Equal(alertmanagerConfig{
+			url:            "https://alertmanager.monitoring.svc:9093",
+			caFile:         "/etc/alertmanager/ca.crt",
+			auth:           alertmanagerAuthMTLS,
+			clientCertFile: "/etc/alertmanager/tls.crt",
+			clientKeyFile:  "/etc/alertmanager/tls.key",
+		}))
+	})
+
+	DescribeTable("should validate the configuration", func(env map[string]string, expected types.GomegaMatcher) {
+		setEnv(env)
+
+		_, err := getAlertmanagerConfig(false)
+		Expect(err).To(expected)
+	},
+		Entry("no authentication by default", map[string]string{
+			alertmanagerURLEnv: "http://alertmanager:9093",
+		}, Succeed()),
+		Entry("invalid URL", map[string]string{
+			alertmanagerURLEnv: "alertmanager:9093",
+		}, MatchError(ContainSubstring("invalid ALERTMANAGER_URL environment variable"))),
+		Entry("unknown authentication", map[string]string{
+			alertmanagerURLEnv:  "http://alertmanager:9093",
+			alertmanagerAuthEnv: "basic",
+		}, MatchError(ContainSubstring("invalid ALERTMANAGER_AUTH environment variable"))),
+		Entry("mtls without a client certificate", map[string]string{
+			alertmanagerURLEnv:           "https://alertmanager:9093",
+			alertmanagerAuthEnv:          "mtls",
+			alertmanagerClientKeyFileEnv: "/etc/alertmanager/tls.key",
+		}, MatchError(ContainSubstring("required for the mtls authentication"))),
+	)
+
+	Context("NewAlertmanagerApi", func() {
+		var (
+			ts         *httptest.Server
+			authHeader string
+		)
+
+		BeforeEach(func() {
+			ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				authHeader = r.Header.Get("Authorization")
+				_, _ = w.Write([]byte("[]"))
+			}))
+			authHeader = ""
+		})
+
+		AfterEach(func() {
+			ts.Close()
+		})
+
+		It("should use the token of the token file", func() {
+			tokenFile := filepath.Join(GinkgoT().TempDir(), "token")
+			Expect(os.WriteFile(tokenFile, []byte("file-token\n"), 0600)).To(Succeed())
+			setEnv(map[string]string{
+				alertmanagerURLEnv:       ts.URL,
+				alertmanagerAuthEnv:      alertmanagerAuthBearer,
+				alertmanagerTokenFileEnv: tokenFile,
+			})
+
+			r := NewReconciler(&rest.Config{BearerToken: "sa-token"}, false)
+			amApi, err := r.NewAlertmanagerApi()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = amApi.ListSilences(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(authHeader).To(Equal("Bearer file-token"))
+		})
+
+		It("should use the token of the service account by default", func() {
+			setEnv(map[string]string{
+				alertmanagerURLEnv:  ts.URL,
+				alertmanagerAuthEnv: alertmanagerAuthBearer,
+			})
+
+			r := NewReconciler(&rest.Config{BearerToken: "sa-token"}, false)
+			amApi, err := r.NewAlertmanagerApi()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = amApi.ListSilences(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(authHeader).To(Equal("Bearer sa-token"))
+		})
+
+		It("should read the token of the service account from its token file", func() {
+			tokenFile := filepath.Join(GinkgoT().TempDir(), "token")
+			Expect(os.WriteFile(tokenFile, []byte("rotated-sa-token"), 0600)).To(Succeed())
+			setEnv(map[string]string{
+				alertmanagerURLEnv:  ts.URL,
+				alertmanagerAuthEnv: alertmanagerAuthBearer,
+			})
+
+			r := NewReconciler(&rest.Config{BearerToken: "sa-token", BearerTokenFile: tokenFile}, false)
+			amApi, err := r.NewAlertmanagerApi()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = amApi.ListSilences(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(authHeader).To(Equal("Bearer rotated-sa-token"))
+		})
+
+		It("should read the token again, after Alertmanager rejected it", func() {
+			rejectingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") != "Bearer new-token" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				_, _ = w.Write([]byte("[]"))
+			}))
+			defer rejectingServer.Close()
+
+			tokenFile := filepath.Join(GinkgoT().TempDir(), "token")
+			Expect(os.WriteFile(tokenFile, []byte("old-token"), 0600)).To(Succeed())
+			setEnv(map[string]string{
+				alertmanagerURLEnv:       rejectingServer.URL,
+				alertmanagerAuthEnv:      alertmanagerAuthBearer,
+				alertmanagerTokenFileEnv: tokenFile,
+			})
+
+			r := NewReconciler(&rest.Config{}, false)
+			_, cond, err := r.listAlertmanagerSilences(context.Background())
+			Expect(err).To(MatchError(ContainSubstring("401")))
+			Expect(cond.Reason).To(Equal(alertmanagerUnreachableReason))
+
+			Expect(os.WriteFile(tokenFile, []byte("new-token"), 0600)).To(Succeed())
+
+			_, cond, err = r.listAlertmanagerSilences(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cond).To(BeNil())
+		})
+
+		It("should not authenticate without authentication", func() {
+			setEnv(map[string]string{
+				alertmanagerURLEnv: ts.URL,
+			})
+
+			r := NewReconciler(&rest.Config{BearerToken: "sa-token"}, false)
+			amApi, err := r.NewAlertmanagerApi()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = amApi.ListSilences(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(authHeader).To(BeEmpty())
+		})
+
+		It("should fail if the CA file can't be read", func() {
+			setEnv(map[string]string{
+				alertmanagerURLEnv:    ts.URL,
+				alertmanagerCAFileEnv: filepath.Join(GinkgoT().TempDir(), "missing.crt"),
+			})
+
+			r := NewReconciler(&rest.Config{}, false)
+			_, err := r.NewAlertmanagerApi()
+			Expect(err).To(MatchError(ContainSubstring("failed to read ca cert")))
+		})
+
+		It("should report Alertmanager as unreachable, if it doesn't answer", func(ctx context.Context) {
+			done := make(chan struct{})
+			hangingServer := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				select {
+				case <-r.Context().Done():
+				case <-done:
+				}
+			}))
+			defer hangingServer.Close()
+			defer close(done)
+
+			origTimeout := alertmanagerRequestTimeout
+			alertmanagerRequestTimeout = 100 * time.Millisecond
+			DeferCleanup(func() {
+				alertmanagerRequestTimeout = origTimeout
+			})
+
+			setEnv(map[string]string{
+				alertmanagerURLEnv: hangingServer.URL,
+			})
+
+			r := NewReconciler(&rest.Config{}, false)
+			_, cond, err := r.listAlertmanagerSilences(ctx)
+			Expect(err).To(MatchError(ContainSubstring("Client.Timeout exceeded")))
+			Expect(cond.Reason).To(Equal(alertmanagerUnreachableReason))
+		}, NodeTimeout(10*time.Second))
+	})
+})
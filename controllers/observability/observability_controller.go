@@ -2,52 +2,79 @@ package observability
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
 var (
 	log         = logf.Log.WithName("controller_observability")
 	periodicity = 1 * time.Hour
+
+	hcInitRequeueDelay = 10 * time.Second
 )
 
 type Reconciler struct {
-	config *rest.Config
-	events chan event.GenericEvent
+	config      *rest.Config
+	client      client.Client
+	namespace   string
+	isOpenshift bool
+	events      chan event.GenericEvent
 
 	amApi *alertmanager.Api
 }
 
-func (r *Reconciler) Reconcile(_ context.Context, _ ctrl.Request) (ctrl.Result, error) {
+func (r *Reconciler) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
 	log.Info("Reconciling Observability")
 
-	if err := r.ensurePodDisruptionBudgetAtLimitIsSilenced(); err != nil {
+	hc, err := r.getHyperConverged(ctx)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if hc != nil && hc.Status.Conditions == nil {
+		// the HyperConverged controller initializes the status of a new HyperConverged CR; wait for it, before
+		// updating the status
+		log.Info("The HyperConverged CR is not initialized yet")
+		return ctrl.Result{RequeueAfter: hcInitRequeueDelay}, nil
+	}
+
+	if err = r.reconcileAlertSilences(ctx, hc); err != nil {
 		return ctrl.Result{}, err
 	}
 
 	return ctrl.Result{}, nil
 }
 
-func NewReconciler(config *rest.Config) *Reconciler {
+func NewReconciler(config *rest.Config, isOpenshift bool) *Reconciler {
 	return &Reconciler{
-		config: config,
-		events: make(chan event.GenericEvent, 1),
+		config:      config,
+		isOpenshift: isOpenshift,
+		events:      make(chan event.GenericEvent, 1),
 	}
 }
 
-func SetupWithManager(mgr ctrl.Manager) error {
+func SetupWithManager(mgr ctrl.Manager, namespace string, ci hcoutil.ClusterInfo) error {
 	log.Info("Setting up controller")
 
-	r := NewReconciler(mgr.GetConfig())
+	r := NewReconciler(mgr.GetConfig(), ci.IsOpenshift())
+	r.client = mgr.GetClient()
+	r.namespace = namespace
 	r.startEventLoop()
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -56,6 +83,12 @@ func SetupWithManager(mgr ctrl.Manager) error {
 			r.events,
 			&handler.EnqueueRequestForObject{},
 		)).
+		// reconcile the alert silences as soon as the HyperConverged spec is changed
+		Watches(
+			&hcov1beta1.HyperConverged{},
+			&handler.EnqueueRequestForObject{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
 		Complete(r)
 }
 
@@ -74,3 +107,21 @@ func (r *Reconciler) startEventLoop() {
 		}
 	}()
 }
+
+// getHyperConverged returns the HyperConverged CR, or nil if it does not exist.
+func (r *Reconciler) getHyperConverged(ctx context.Context) (*hcov1beta1.HyperConverged, error) {
+	if r.client == nil {
+		return nil, nil
+	}
+
+	hc := &hcov1beta1.HyperConverged{}
+	err := r.client.Get(ctx, client.ObjectKey{Namespace: r.namespace, Name: hcoutil.HyperConvergedName}, hc)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read the HyperConverged CR: %w", err)
+	}
+
+	return hc, nil
+}
@@ -0,0 +1,13 @@
+package observability
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestObservability(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Observability Controller Suite")
+}
@@ -1,82 +1,30 @@
 package observability
 
 import (
-	"crypto/tls"
-	"crypto/x509"
-	"fmt"
-	"net/http"
-	"os"
-	"time"
-
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager"
 )
 
-const (
-	alertmanagerSvcHost = "https://alertmanager-main.openshift-monitoring.svc.cluster.local:9094"
-	tlsCertPath         = "/var/run/secrets/kubernetes.io/serviceaccount/service-ca.crt"
-)
-
-func (r *Reconciler) ensurePodDisruptionBudgetAtLimitIsSilenced() error {
-	if r.amApi == nil {
-		var err error
-		r.amApi, err = r.NewAlertmanagerApi()
-		if err != nil {
-			return fmt.Errorf("failed to initialize alertmanager api: %w", err)
-		}
-	}
-
-	amSilences, err := r.amApi.ListSilences()
-	if err != nil {
-		return fmt.Errorf("failed to list alertmanager silences: %w", err)
-	}
-
-	if FindPodDisruptionBudgetAtLimitSilence(amSilences) != nil {
-		log.Info("KubeVirt PodDisruptionBudgetAtLimit alerts are already silenced")
-		return nil
-	}
-
-	silence := alertmanager.Silence{
-		Comment:   "Silence KubeVirt PodDisruptionBudgetAtLimit alerts",
-		CreatedBy: "hyperconverged-cluster-operator",
-		EndsAt:    "3000-01-01T00:00:00Z",
-		Matchers: []alertmanager.Matcher{
-			{
-				IsEqual: true,
-				Name:    "alertname",
-				Value:   "PodDisruptionBudgetAtLimit",
-			},
-			{
-				IsRegex: true,
-				Name:    "poddisruptionbudget",
-				Value:   "kubevirt-disruption-budget-.*",
-			},
+const podDisruptionBudgetAtLimitSilenceName = "pod-disruption-budget-at-limit"
+
+// podDisruptionBudgetAtLimitSilence is the built-in silence of the PodDisruptionBudgetAtLimit alerts of the KubeVirt
+// VMs. The PDBs of the VMs are always at their limit, by design, so these alerts are just noise.
+var podDisruptionBudgetAtLimitSilence = desiredSilence{
+	name:    podDisruptionBudgetAtLimitSilenceName,
+	builtIn: true,
+	comment: "Silence KubeVirt PodDisruptionBudgetAtLimit alerts",
+	matchers: []alertmanager.Matcher{
+		{
+			IsEqual: true,
+			Name:    "alertname",
+			Value:   "PodDisruptionBudgetAtLimit",
 		},
-		StartsAt: time.Now().Format(time.RFC3339),
-	}
-
-	if err := r.amApi.CreateSilence(silence); err != nil {
-		return fmt.Errorf("failed to create alertmanager silence: %w", err)
-	}
-	log.Info("Silenced PodDisruptionBudgetAtLimit alerts")
-
-	return nil
-}
-
-func (r *Reconciler) NewAlertmanagerApi() (*alertmanager.Api, error) {
-	caCert, err := os.ReadFile(tlsCertPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read ca cert: %w", err)
-	}
-
-	caCertPool := x509.NewCertPool()
-	caCertPool.AppendCertsFromPEM(caCert)
-
-	httpClient := http.Client{}
-	httpClient.Transport = &http.Transport{
-		TLSClientConfig: &tls.Config{RootCAs: caCertPool},
-	}
-
-	return alertmanager.NewAPI(httpClient, alertmanagerSvcHost, r.config.BearerToken), nil
+		{
+			IsEqual: true,
+			IsRegex: true,
+			Name:    "poddisruptionbudget",
+			Value:   "kubevirt-disruption-budget-.*",
+		},
+	},
 }
 
 func FindPodDisruptionBudgetAtLimitSilence(amSilences []alertmanager.Silence) *alertmanager.Silence {
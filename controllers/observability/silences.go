@@ -0,0 +1,396 @@
+package observability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager"
+)
+
+const (
+	silenceCreator = "hyperconverged-cluster-operator"
+
+	// silences without a duration are created for silenceWindow, and are refreshed when less than
+	// silenceRefreshThreshold remains; the reconciliation periodicity must be shorter than the threshold.
+	silenceWindow           = 24 * time.Hour
+	silenceRefreshThreshold = 12 * time.Hour
+
+	amSilenceStateActive  = "active"
+	amSilenceStatePending = "pending"
+
+	alertmanagerNotConfiguredReason = "AlertmanagerNotConfigured"
+	invalidAlertmanagerConfigReason = "InvalidAlertmanagerConfig"
+	alertmanagerUnreachableReason   = "AlertmanagerUnreachable"
+)
+
+// desiredSilence is a silence that HCO should manage; a built-in one, or a user-declared one
+type desiredSilence struct {
+	name     string
+	builtIn  bool
+	comment  string
+	matchers []alertmanager.Matcher
+	duration *time.Duration
+}
+
+// reconcileAlertSilences creates and refreshes the built-in and the user-declared silences, deletes the silences
+// that HCO created and that are no longer desired, and reports the state of the silences in the HyperConverged
+// status, if the HyperConverged CR exists.
+func (r *Reconciler) reconcileAlertSilences(ctx context.Context, hc *hcov1beta1.HyperConverged) error {
+	amSilences, unavailableCond, err := r.listAlertmanagerSilences(ctx)
+	if unavailableCond != nil {
+		// keep the last known state of the silences, and report why they can't be reconciled
+		if hc != nil {
+			if statusErr := r.updateAlertSilencesStatus(ctx, hc, hc.Status.AlertSilences, unavailableCond); statusErr != nil {
+				return errors.Join(err, statusErr)
+			}
+		}
+		return err
+	}
+
+	var prevStatuses []hcov1beta1.AlertSilenceStatus
+	if hc != nil {
+		prevStatuses = hc.Status.AlertSilences
+	}
+
+	sr := &silenceReconciler{
+		amApi:    r.amApi,
+		now:      time.Now().UTC().Truncate(time.Second),
+		managed:  getManagedSilences(amSilences),
+		claimed:  make(map[string]bool),
+		statuses: prevStatuses,
+	}
+
+	var errs []error
+	var statuses []hcov1beta1.AlertSilenceStatus
+	for _, desired := range getDesiredSilences(hc) {
+		status, err := sr.reconcileSilence(ctx, desired)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		statuses = append(statuses, status)
+	}
+
+	errs = append(errs, sr.deleteUnclaimedSilences(ctx)...)
+
+	if hc != nil {
+		if err = r.updateAlertSilencesStatus(ctx, hc, statuses, nil); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// listAlertmanagerSilences returns the silences of Alertmanager. If Alertmanager is not available, it returns the
+// AlertmanagerUnavailable condition. A missing Alertmanager endpoint is not an error; HCO just can't manage the
+// silences until the endpoint is configured.
+func (r *Reconciler) listAlertmanagerSilences(ctx context.Context) ([]alertmanager.Silence, *metav1.Condition, error) {
+	if r.amApi == nil {
+		amApi, err := r.NewAlertmanagerApi()
+		if errors.Is(err, errAlertmanagerNotConfigured) {
+			log.Info("The Alertmanager endpoint is not configured; the alert silences are not managed")
+			return nil, newAlertmanagerUnavailableCondition(alertmanagerNotConfiguredReason, err), nil
+		} else if err != nil {
+			return nil, newAlertmanagerUnavailableCondition(invalidAlertmanagerConfigReason, err), fmt.Errorf("failed to initialize alertmanager api: %w", err)
+		}
+		r.amApi = amApi
+	}
+
+	amSilences, err := r.amApi.ListSilences(ctx)
+	if err != nil {
+		// the bearer token and the client certificate may have been rotated; build the API again on the next
+		// reconciliation, to read them again
+		r.amApi = nil
+		return nil, newAlertmanagerUnavailableCondition(alertmanagerUnreachableReason, err), fmt.Errorf("failed to list alertmanager silences: %w", err)
+	}
+
+	return amSilences, nil, nil
+}
+
+func newAlertmanagerUnavailableCondition(reason string, err error) *metav1.Condition {
+	return &metav1.Condition{
+		Type:    hcov1beta1.ConditionAlertmanagerUnavailable,
+		Status:  metav1.ConditionTrue,
+		Reason:  reason,
+		Message: err.Error(),
+	}
+}
+
+// updateAlertSilencesStatus updates the state of the silences in the HyperConverged status, and raises the
+// AlertmanagerUnavailable condition if unavailableCond is set, or removes it otherwise.
+func (r *Reconciler) updateAlertSilencesStatus(ctx context.Context, hc *hcov1beta1.HyperConverged, statuses []hcov1beta1.AlertSilenceStatus, unavailableCond *metav1.Condition) error {
+	conditions := slices.Clone(hc.Status.Conditions)
+	if unavailableCond != nil {
+		unavailableCond.ObservedGeneration = hc.Generation
+		meta.SetStatusCondition(&conditions, *unavailableCond)
+	} else {
+		meta.RemoveStatusCondition(&conditions, hcov1beta1.ConditionAlertmanagerUnavailable)
+	}
+
+	if equality.Semantic.DeepEqual(hc.Status.AlertSilences, statuses) && equality.Semantic.DeepEqual(hc.Status.Conditions, conditions) {
+		return nil
+	}
+
+	orig := hc.DeepCopy()
+	hc.Status.AlertSilences = statuses
+	hc.Status.Conditions = conditions
+	// the conditions are replaced as a whole, so make sure not to override the ones HCO sets concurrently
+	if err := r.client.Status().Patch(ctx, hc, client.MergeFromWithOptions(orig, client.MergeFromWithOptimisticLock{})); err != nil {
+		return fmt.Errorf("failed to update the state of the alert silences in the HyperConverged status: %w", err)
+	}
+
+	return nil
+}
+
+func getDesiredSilences(hc *hcov1beta1.HyperConverged) []desiredSilence {
+	var cfg *hcov1beta1.AlertSilencesConfig
+	if hc != nil {
+		cfg = hc.Spec.AlertSilences
+	}
+
+	var desired []desiredSilence
+	if cfg == nil || !cfg.DisableBuiltInSilences {
+		desired = append(desired, podDisruptionBudgetAtLimitSilence)
+	}
+
+	if cfg == nil {
+		return desired
+	}
+
+	for _, silence := range cfg.Silences {
+		d := desiredSilence{
+			name:    silence.Name,
+			comment: silence.Comment,
+		}
+
+		if d.comment == "" {
+			d.comment = fmt.Sprintf("%s silence, managed by the HyperConverged CR", silence.Name)
+		}
+
+		if silence.Duration != nil {
+			d.duration = ptr.To(silence.Duration.Duration)
+		}
+
+		for _, matcher := range silence.Matchers {
+			d.matchers = append(d.matchers, alertmanager.Matcher{
+				IsEqual: ptr.Deref(matcher.IsEqual, true),
+				IsRegex: matcher.IsRegex,
+				Name:    matcher.Name,
+				Value:   matcher.Value,
+			})
+		}
+
+		desired = append(desired, d)
+	}
+
+	return desired
+}
+
+// getManagedSilences returns the active and pending silences that HCO created
+func getManagedSilences(amSilences []alertmanager.Silence) []alertmanager.Silence {
+	var managed []alertmanager.Silence
+	for _, silence := range amSilences {
+		if silence.CreatedBy != silenceCreator {
+			continue
+		}
+
+		if silence.Status.State == amSilenceStateActive || silence.Status.State == amSilenceStatePending {
+			managed = append(managed, silence)
+		}
+	}
+	return managed
+}
+
+type silenceReconciler struct {
+	amApi    *alertmanager.Api
+	now      time.Time
+	managed  []alertmanager.Silence
+	claimed  map[string]bool
+	statuses []hcov1beta1.AlertSilenceStatus
+}
+
+func (sr *silenceReconciler) reconcileSilence(ctx context.Context, desired desiredSilence) (hcov1beta1.AlertSilenceStatus, error) {
+	status := hcov1beta1.AlertSilenceStatus{
+		Name:    desired.name,
+		BuiltIn: desired.builtIn,
+	}
+
+	prevStatus := sr.getPrevStatus(desired)
+	existing := sr.claimSilence(desired, prevStatus)
+
+	start := sr.now
+	if existing != nil {
+		status.ID = existing.ID
+		if startsAt, err := parseSilenceTime(existing.StartsAt); err == nil {
+			start = startsAt
+		}
+	} else if prevStatus != nil {
+		status.ID = prevStatus.ID
+	}
+
+	var end time.Time
+	if desired.duration != nil {
+		// the duration is counted from when HCO first created the silence, even if it was deleted in the meantime
+		if prevStatus != nil && prevStatus.StartsAt != nil {
+			start = prevStatus.StartsAt.UTC()
+		}
+		end = start.Add(*desired.duration)
+
+		if !sr.now.Before(end) {
+			status.State = hcov1beta1.AlertSilenceStateExpired
+			status.StartsAt = ptr.To(metav1.NewTime(start))
+			status.EndsAt = ptr.To(metav1.NewTime(end))
+
+			if existing != nil {
+				if err := sr.amApi.DeleteSilence(ctx, existing.ID); err != nil {
+					return failedSilenceStatus(status, fmt.Errorf("failed to delete the expired %s silence: %w", desired.name, err))
+				}
+			}
+
+			return status, nil
+		}
+	} else {
+		end = sr.now.Add(silenceWindow)
+		if existing != nil {
+			if endsAt, err := parseSilenceTime(existing.EndsAt); err == nil && endsAt.Sub(sr.now) > silenceRefreshThreshold {
+				end = endsAt
+			}
+		}
+	}
+
+	status.StartsAt = ptr.To(metav1.NewTime(start))
+	status.EndsAt = ptr.To(metav1.NewTime(end))
+	status.State = hcov1beta1.AlertSilenceStateActive
+
+	if existing != nil && !silenceNeedsUpdate(existing, desired, end) {
+		return status, nil
+	}
+
+	silence := alertmanager.Silence{
+		Comment:   desired.comment,
+		CreatedBy: silenceCreator,
+		EndsAt:    end.Format(time.RFC3339),
+		Matchers:  desired.matchers,
+		StartsAt:  start.Format(time.RFC3339),
+	}
+	if existing != nil {
+		// keep the exact start time, so Alertmanager updates the silence in place
+		silence.ID = existing.ID
+		silence.StartsAt = existing.StartsAt
+	}
+
+	id, err := sr.amApi.CreateSilence(ctx, silence)
+	if err != nil {
+		return failedSilenceStatus(status, fmt.Errorf("failed to create the %s silence: %w", desired.name, err))
+	}
+	status.ID = id
+
+	log.Info("Reconciled the alert silence", "name", desired.name, "id", id, "endsAt", silence.EndsAt)
+
+	return status, nil
+}
+
+func (sr *silenceReconciler) getPrevStatus(desired desiredSilence) *hcov1beta1.AlertSilenceStatus {
+	for i := range sr.statuses {
+		if sr.statuses[i].Name == desired.name && sr.statuses[i].BuiltIn == desired.builtIn {
+			return &sr.statuses[i]
+		}
+	}
+	return nil
+}
+
+// claimSilence finds the silence that HCO already created for the desired silence: the silence with the ID
+// reported in the status, or else a silence with the same matchers.
+func (sr *silenceReconciler) claimSilence(desired desiredSilence, prevStatus *hcov1beta1.AlertSilenceStatus) *alertmanager.Silence {
+	found := -1
+	if prevStatus != nil && prevStatus.ID != "" {
+		found = slices.IndexFunc(sr.managed, func(silence alertmanager.Silence) bool {
+			return silence.ID == prevStatus.ID && !sr.claimed[silence.ID]
+		})
+	}
+
+	if found == -1 {
+		found = slices.IndexFunc(sr.managed, func(silence alertmanager.Silence) bool {
+			return !sr.claimed[silence.ID] && matchersEqual(silence.Matchers, desired.matchers)
+		})
+	}
+
+	if found == -1 {
+		return nil
+	}
+
+	sr.claimed[sr.managed[found].ID] = true
+	return &sr.managed[found]
+}
+
+// deleteUnclaimedSilences deletes the silences that HCO created, and that are no longer desired
+func (sr *silenceReconciler) deleteUnclaimedSilences(ctx context.Context) []error {
+	var errs []error
+	for _, silence := range sr.managed {
+		if sr.claimed[silence.ID] {
+			continue
+		}
+
+		if err := sr.amApi.DeleteSilence(ctx, silence.ID); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete the %s silence: %w", silence.ID, err))
+			continue
+		}
+		log.Info("Deleted the alert silence", "id", silence.ID, "comment", silence.Comment)
+	}
+	return errs
+}
+
+func silenceNeedsUpdate(existing *alertmanager.Silence, desired desiredSilence, end time.Time) bool {
+	if existing.Comment != desired.comment || !matchersEqual(existing.Matchers, desired.matchers) {
+		return true
+	}
+
+	endsAt, err := parseSilenceTime(existing.EndsAt)
+	return err != nil || !endsAt.Equal(end)
+}
+
+// parseSilenceTime parses a time of an Alertmanager silence, truncated to the second, as reported in the status
+func parseSilenceTime(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC().Truncate(time.Second), nil
+}
+
+// matchersEqual compares two lists of matchers, regardless of their order
+func matchersEqual(a, b []alertmanager.Matcher) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for _, matcher := range a {
+		if !slices.Contains(b, matcher) {
+			return false
+		}
+	}
+
+	for _, matcher := range b {
+		if !slices.Contains(a, matcher) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func failedSilenceStatus(status hcov1beta1.AlertSilenceStatus, err error) (hcov1beta1.AlertSilenceStatus, error) {
+	status.State = hcov1beta1.AlertSilenceStateFailed
+	status.Message = err.Error()
+	return status, err
+}
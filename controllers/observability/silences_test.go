@@ -0,0 +1,384 @@
+package observability
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager"
+)
+
+// fakeAlertmanager is a minimal in-memory implementation of the Alertmanager silences API
+type fakeAlertmanager struct {
+	lock        sync.Mutex
+	silences    map[string]alertmanager.Silence
+	nextID      int
+	failPosts   bool
+	unavailable bool
+}
+
+func (am *fakeAlertmanager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	am.lock.Lock()
+	defer am.lock.Unlock()
+
+	if am.unavailable {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v2/silences":
+		silences := make([]alertmanager.Silence, 0, len(am.silences))
+		for _, silence := range am.silences {
+			silences = append(silences, silence)
+		}
+		Expect(json.NewEncoder(w).Encode(silences)).To(Succeed())
+
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v2/silences":
+		if am.failPosts {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		silence := alertmanager.Silence{}
+		Expect(json.NewDecoder(r.Body).Decode(&silence)).To(Succeed())
+		if silence.ID == "" {
+			am.nextID++
+			silence.ID = fmt.Sprintf("silence-%d", am.nextID)
+		}
+		silence.Status.State = amSilenceStateActive
+		am.silences[silence.ID] = silence
+		fmt.Fprintf(w, `{"silenceID":%q}`, silence.ID)
+
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/v2/silence/"):
+		id := strings.TrimPrefix(r.URL.Path, "/api/v2/silence/")
+		silence, ok := am.silences[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		silence.Status.State = "expired"
+		am.silences[id] = silence
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (am *fakeAlertmanager) add(silence alertmanager.Silence) {
+	am.lock.Lock()
+	defer am.lock.Unlock()
+	am.silences[silence.ID] = silence
+}
+
+func (am *fakeAlertmanager) setUnavailable(unavailable bool) {
+	am.lock.Lock()
+	defer am.lock.Unlock()
+	am.unavailable = unavailable
+}
+
+func (am *fakeAlertmanager) get(id string) alertmanager.Silence {
+	am.lock.Lock()
+	defer am.lock.Unlock()
+	return am.silences[id]
+}
+
+func (am *fakeAlertmanager) active() []alertmanager.Silence {
+	am.lock.Lock()
+	defer am.lock.Unlock()
+
+	var silences []alertmanager.Silence
+	for _, silence := range am.silences {
+		if silence.Status.State == amSilenceStateActive {
+			silences = append(silences, silence)
+		}
+	}
+	return silences
+}
+
+var _ = Describe("Alert silences", func() {
+	var (
+		am  *fakeAlertmanager
+		ts  *httptest.Server
+		hc  *hcov1beta1.HyperConverged
+		ctx context.Context
+	)
+
+	userSilence := hcov1beta1.AlertSilence{
+		Name: "vm-stuck",
+		Matchers: []hcov1beta1.AlertSilenceMatcher{
+			{Name: "alertname", Value: "KubeVirtVMStuckInErrorState"},
+			{Name: "namespace", Value: "test-.*", IsRegex: true, IsEqual: ptr.To(false)},
+		},
+		Comment: "known issue",
+	}
+
+	userMatchers := []alertmanager.Matcher{
+		{Name: "namespace", Value: "test-.*", IsRegex: true},
+		{Name: "alertname", Value: "KubeVirtVMStuckInErrorState", IsEqual: true},
+	}
+
+	newReconciler := func(objects ...client.Object) *Reconciler {
+		return &Reconciler{
+			client:    commontestutils.InitClient(objects),
+			namespace: commontestutils.Namespace,
+			amApi:     alertmanager.NewAPI(http.Client{}, ts.URL, "token"),
+		}
+	}
+
+	reconcile := func(r *Reconciler) error {
+		res, err := r.Reconcile(ctx, ctrl.Request{})
+		Expect(res.IsZero()).To(BeTrue())
+		return err
+	}
+
+	getHC := func(r *Reconciler) *hcov1beta1.HyperConverged {
+		foundHC := &hcov1beta1.HyperConverged{}
+		Expect(r.client.Get(ctx, client.ObjectKeyFromObject(hc), foundHC)).To(Succeed())
+		return foundHC
+	}
+
+	timeString := func(t time.Time) string {
+		return t.UTC().Format(time.RFC3339)
+	}
+
+	BeforeEach(func() {
+		am = &fakeAlertmanager{silences: make(map[string]alertmanager.Silence)}
+		ts = httptest.NewServer(am)
+		hc = commontestutils.NewHco()
+		hc.Status.Conditions = []metav1.Condition{
+			{
+				Type:               hcov1beta1.ConditionAvailable,
+				Status:             metav1.ConditionTrue,
+				Reason:             "ReconcileCompleted",
+				LastTransitionTime: metav1.Now(),
+			},
+		}
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		ts.Close()
+	})
+
+	It("should create the built-in silence when there is no HyperConverged CR", func() {
+		r := newReconciler()
+		Expect(reconcile(r)).To(Succeed())
+
+		silences := am.active()
+		Expect(silences).To(HaveLen(1))
+		Expect(FindPodDisruptionBudgetAtLimitSilence(silences)).ToNot(BeNil())
+		Expect(silences[0].CreatedBy).To(Equal(silenceCreator))
+	})
+
+	It("should create the built-in and the user silences, and report them in the status", func() {
+		hc.Spec.AlertSilences = &hcov1beta1.AlertSilencesConfig{
+			Silences: []hcov1beta1.AlertSilence{userSilence},
+		}
+		r := newReconciler(hc)
+		Expect(reconcile(r)).To(Succeed())
+
+		Expect(am.active()).To(HaveLen(2))
+
+		statuses := getHC(r).Status.AlertSilences
+		Expect(statuses).To(HaveLen(2))
+
+		Expect(statuses[0].Name).To(Equal(podDisruptionBudgetAtLimitSilenceName))
+		Expect(statuses[0].BuiltIn).To(BeTrue())
+		Expect(statuses[0].State).To(Equal(hcov1beta1.AlertSilenceStateActive))
+
+		Expect(statuses[1].Name).To(Equal("vm-stuck"))
+		Expect(statuses[1].BuiltIn).To(BeFalse())
+		Expect(statuses[1].State).To(Equal(hcov1beta1.AlertSilenceStateActive))
+		Expect(statuses[1].EndsAt).ToNot(BeNil())
+
+		userAmSilence := am.get(statuses[1].ID)
+		Expect(userAmSilence.Comment).To(Equal("known issue"))
+		Expect(userAmSilence.Matchers).To(ConsistOf(userMatchers))
+
+		By("not changing anything on the next reconciliation")
+		Expect(reconcile(r)).To(Succeed())
+		Expect(am.active()).To(HaveLen(2))
+		Expect(getHC(r).Status.AlertSilences).To(Equal(statuses))
+	})
+
+	It("should refresh a silence before it expires", func() {
+		now := time.Now()
+		am.add(alertmanager.Silence{
+			ID:        "soon-expired",
+			Comment:   podDisruptionBudgetAtLimitSilence.comment,
+			CreatedBy: silenceCreator,
+			StartsAt:  timeString(now.Add(-18 * time.Hour)),
+			EndsAt:    timeString(now.Add(6 * time.Hour)),
+			Matchers:  podDisruptionBudgetAtLimitSilence.matchers,
+			Status:    alertmanager.Status{State: amSilenceStateActive},
+		})
+
+		r := newReconciler(hc)
+		Expect(reconcile(r)).To(Succeed())
+
+		silence := am.get("soon-expired")
+		Expect(silence.Status.State).To(Equal(amSilenceStateActive))
+		Expect(silence.StartsAt).To(Equal(timeString(now.Add(-18 * time.Hour))))
+		Expect(parseSilenceTime(silence.EndsAt)).To(BeTemporally(">", now.Add(23*time.Hour)))
+	})
+
+	It("should not refresh a silence that does not expire soon", func() {
+		now := time.Now()
+		endsAt := timeString(now.Add(20 * time.Hour))
+		am.add(alertmanager.Silence{
+			ID:        "long-lived",
+			Comment:   podDisruptionBudgetAtLimitSilence.comment,
+			CreatedBy: silenceCreator,
+			StartsAt:  timeString(now.Add(-4 * time.Hour)),
+			EndsAt:    endsAt,
+			Matchers:  podDisruptionBudgetAtLimitSilence.matchers,
+			Status:    alertmanager.Status{State: amSilenceStateActive},
+		})
+
+		r := newReconciler(hc)
+		Expect(reconcile(r)).To(Succeed())
+
+		Expect(am.active()).To(HaveLen(1))
+		Expect(am.get("long-lived").EndsAt).To(Equal(endsAt))
+	})
+
+	It("should delete the silences that are removed from the spec, and keep the silences of other users", func() {
+		hc.Spec.AlertSilences = &hcov1beta1.AlertSilencesConfig{
+			Silences: []hcov1beta1.AlertSilence{userSilence},
+		}
+		am.add(alertmanager.Silence{
+			ID:        "not-ours",
+			CreatedBy: "someone-else",
+			StartsAt:  timeString(time.Now()),
+			EndsAt:    timeString(time.Now().Add(time.Hour)),
+			Matchers:  userMatchers,
+			Status:    alertmanager.Status{State: amSilenceStateActive},
+		})
+
+		r := newReconciler(hc)
+		Expect(reconcile(r)).To(Succeed())
+		Expect(am.active()).To(HaveLen(3))
+
+		hc = getHC(r)
+		hc.Spec.AlertSilences.Silences = nil
+		hc.Spec.AlertSilences.DisableBuiltInSilences = true
+		Expect(r.client.Update(ctx, hc)).To(Succeed())
+
+		Expect(reconcile(r)).To(Succeed())
+
+		silences := am.active()
+		Expect(silences).To(HaveLen(1))
+		Expect(silences[0].ID).To(Equal("not-ours"))
+		Expect(getHC(r).Status.AlertSilences).To(BeEmpty())
+	})
+
+	It("should expire a silence after its duration", func() {
+		silence := userSilence.DeepCopy()
+		silence.Duration = &metav1.Duration{Duration: time.Hour}
+		hc.Spec.AlertSilences = &hcov1beta1.AlertSilencesConfig{
+			DisableBuiltInSilences: true,
+			Silences:               []hcov1beta1.AlertSilence{*silence},
+		}
+
+		r := newReconciler(hc)
+		Expect(reconcile(r)).To(Succeed())
+
+		statuses := getHC(r).Status.AlertSilences
+		Expect(statuses).To(HaveLen(1))
+		Expect(statuses[0].State).To(Equal(hcov1beta1.AlertSilenceStateActive))
+		Expect(statuses[0].EndsAt.Sub(statuses[0].StartsAt.Time)).To(Equal(time.Hour))
+
+		By("moving the start time of the silence two hours back")
+		hc = getHC(r)
+		hc.Status.AlertSilences[0].StartsAt = ptr.To(metav1.NewTime(statuses[0].StartsAt.Add(-2 * time.Hour)))
+		Expect(r.client.Status().Update(ctx, hc)).To(Succeed())
+
+		Expect(reconcile(r)).To(Succeed())
+
+		Expect(am.active()).To(BeEmpty())
+		statuses = getHC(r).Status.AlertSilences
+		Expect(statuses).To(HaveLen(1))
+		Expect(statuses[0].State).To(Equal(hcov1beta1.AlertSilenceStateExpired))
+	})
+
+	It("should report the silences that HCO failed to create", func() {
+		am.failPosts = true
+		r := newReconciler(hc)
+		Expect(reconcile(r)).To(MatchError(ContainSubstring("failed to create the pod-disruption-budget-at-limit silence")))
+
+		statuses := getHC(r).Status.AlertSilences
+		Expect(statuses).To(HaveLen(1))
+		Expect(statuses[0].State).To(Equal(hcov1beta1.AlertSilenceStateFailed))
+		Expect(statuses[0].Message).To(ContainSubstring("400 Bad Request"))
+	})
+
+	It("should wait for the HyperConverged controller to initialize the status", func() {
+		hc.Status.Conditions = nil
+		r := newReconciler(hc)
+
+		res, err := r.Reconcile(ctx, ctrl.Request{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.RequeueAfter).To(Equal(hcInitRequeueDelay))
+
+		Expect(am.active()).To(BeEmpty())
+		Expect(getHC(r).Status.Conditions).To(BeNil())
+	})
+
+	Context("AlertmanagerUnavailable condition", func() {
+		It("should raise the condition if the Alertmanager endpoint is not configured", func() {
+			GinkgoT().Setenv(alertmanagerURLEnv, "")
+			r := newReconciler(hc)
+			r.amApi = nil
+
+			Expect(reconcile(r)).To(Succeed())
+
+			cond := meta.FindStatusCondition(getHC(r).Status.Conditions, hcov1beta1.ConditionAlertmanagerUnavailable)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(alertmanagerNotConfiguredReason))
+			Expect(cond.Message).To(ContainSubstring(alertmanagerURLEnv))
+			Expect(meta.IsStatusConditionTrue(getHC(r).Status.Conditions, hcov1beta1.ConditionAvailable)).To(BeTrue())
+		})
+
+		It("should raise the condition while Alertmanager is unreachable, and keep the state of the silences", func() {
+			r := newReconciler(hc)
+			Expect(reconcile(r)).To(Succeed())
+			statuses := getHC(r).Status.AlertSilences
+			Expect(statuses).To(HaveLen(1))
+
+			am.setUnavailable(true)
+
+			Expect(reconcile(r)).To(MatchError(ContainSubstring("failed to list alertmanager silences")))
+
+			foundHC := getHC(r)
+			Expect(foundHC.Status.AlertSilences).To(Equal(statuses))
+			cond := meta.FindStatusCondition(foundHC.Status.Conditions, hcov1beta1.ConditionAlertmanagerUnavailable)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Reason).To(Equal(alertmanagerUnreachableReason))
+
+			By("removing the condition when Alertmanager is reachable again")
+			am.setUnavailable(false)
+			GinkgoT().Setenv(alertmanagerURLEnv, ts.URL)
+
+			Expect(reconcile(r)).To(Succeed())
+			Expect(meta.FindStatusCondition(getHC(r).Status.Conditions, hcov1beta1.ConditionAlertmanagerUnavailable)).To(BeNil())
+		})
+	})
+})
@@ -1,9 +1,6 @@
 package operands
 
 import (
-	"errors"
-	"reflect"
-
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -40,7 +37,11 @@ type aaqHooks struct {
 
 func (h *aaqHooks) getFullCr(hc *hcov1beta1.HyperConverged) (client.Object, error) {
 	if h.cache == nil {
-		h.cache = NewAAQ(hc)
+		aaq, err := NewAAQ(hc)
+		if err != nil {
+			return nil, err
+		}
+		h.cache = aaq
 	}
 	return h.cache, nil
 }
@@ -60,36 +61,15 @@ func (h *aaqHooks) reset() {
 	h.cache = nil
 }
 
-func (*aaqHooks) updateCr(req *common.HcoRequest, Client client.Client, exists runtime.Object, required runtime.Object) (bool, bool, error) {
-	aaq, ok1 := required.(*aaqv1alpha1.AAQ)
-	found, ok2 := exists.(*aaqv1alpha1.AAQ)
-	if !ok1 || !ok2 {
-		return false, false, errors.New("can't convert to AAQ")
-	}
+func (*aaqHooks) justBeforeComplete(_ *common.HcoRequest) { /* no implementation */ }
 
-	if !reflect.DeepEqual(found.Spec, aaq.Spec) ||
-		!hcoutil.CompareLabels(aaq, found) {
-		overwritten := false
-		if req.HCOTriggered {
-			req.Logger.Info("Updating existing AAQ's Spec to new opinionated values")
-		} else {
-			req.Logger.Info("Reconciling an externally updated AAQ's Spec to its opinionated values")
-			overwritten = true
-		}
-		hcoutil.MergeLabels(&aaq.ObjectMeta, &found.ObjectMeta)
-		aaq.Spec.DeepCopyInto(&found.Spec)
-		err := Client.Update(req.Ctx, found)
-		if err != nil {
-			return false, false, err
-		}
-		return true, overwritten, nil
-	}
-	return false, false, nil
+func (*aaqHooks) getOperandName() string { return hcov1beta1.OperandAAQ }
+func (*aaqHooks) getObservedVersion(cr runtime.Object) string {
+	return cr.(*aaqv1alpha1.AAQ).Status.ObservedVersion
 }
+func (*aaqHooks) getVersionEnvName() string { return hcoutil.AaqVersionEnvV }
 
-func (*aaqHooks) justBeforeComplete(_ *common.HcoRequest) { /* no implementation */ }
-
-func NewAAQ(hc *hcov1beta1.HyperConverged) *aaqv1alpha1.AAQ {
+func NewAAQ(hc *hcov1beta1.HyperConverged) (*aaqv1alpha1.AAQ, error) {
 	spec := aaqv1alpha1.AAQSpec{
 		PriorityClass:   ptr.To[aaqv1alpha1.AAQPriorityClass](kvPriorityClass),
 		ImagePullPolicy: corev1.PullIfNotPresent,
@@ -128,7 +108,12 @@ func NewAAQ(hc *hcov1beta1.HyperConverged) *aaqv1alpha1.AAQ {
 
 	aaq := NewAAQWithNameOnly(hc)
 	aaq.Spec = spec
-	return aaq
+
+	if err := applyOperandOverride(hc, hcov1beta1.OperandAAQ, aaq); err != nil {
+		return nil, err
+	}
+
+	return aaq, nil
 }
 
 func NewAAQWithNameOnly(hc *hcov1beta1.HyperConverged) *aaqv1alpha1.AAQ {
@@ -1,9 +1,6 @@
 package operands
 
 import (
-	"errors"
-	"reflect"
-
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -13,7 +10,6 @@ import (
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
-	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -62,35 +58,14 @@ func (h *cdiHooks) reset() {
 	h.cache = nil
 }
 
-func (*cdiHooks) updateCr(req *common.HcoRequest, Client client.Client, exists runtime.Object, required runtime.Object) (bool, bool, error) {
-	cdi, ok1 := required.(*cdiv1beta1.CDI)
-	found, ok2 := exists.(*cdiv1beta1.CDI)
-	if !ok1 || !ok2 {
-		return false, false, errors.New("can't convert to CDI")
-	}
-
-	if !reflect.DeepEqual(found.Spec, cdi.Spec) ||
-		!util.CompareLabels(cdi, found) {
-		overwritten := false
-		if req.HCOTriggered {
-			req.Logger.Info("Updating existing CDI's Spec to new opinionated values")
-		} else {
-			req.Logger.Info("Reconciling an externally updated CDI's Spec to its opinionated values")
-			overwritten = true
-		}
-		util.MergeLabels(&cdi.ObjectMeta, &found.ObjectMeta)
-		cdi.Spec.DeepCopyInto(&found.Spec)
-		err := Client.Update(req.Ctx, found)
-		if err != nil {
-			return false, false, err
-		}
-		return true, overwritten, nil
-	}
-	return false, false, nil
-}
-
 func (*cdiHooks) justBeforeComplete(_ *common.HcoRequest) { /* no implementation */ }
 
+func (*cdiHooks) getOperandName() string { return hcov1beta1.OperandCDI }
+func (*cdiHooks) getObservedVersion(cr runtime.Object) string {
+	return cr.(*cdiv1beta1.CDI).Status.ObservedVersion
+}
+func (*cdiHooks) getVersionEnvName() string { return hcoutil.CdiVersionEnvV }
+
 func getDefaultFeatureGates() []string {
 	return []string{honorWaitForFirstConsumerGate, dataVolumeClaimAdoptionGate}
 }
@@ -157,6 +132,10 @@ func NewCDI(hc *hcov1beta1.HyperConverged, opts ...string) (*cdiv1beta1.CDI, err
 		return nil, err
 	}
 
+	if err := applyOperandOverride(hc, hcov1beta1.OperandCDI, cdi); err != nil {
+		return nil, err
+	}
+
 	return cdi, nil
 }
 
@@ -0,0 +1,141 @@
+package operands
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"slices"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+const (
+	noConditionsReason = "NoConditions"
+	unknownReason      = "Unknown"
+)
+
+// the operand conditions that are copied to the status.components list
+var componentConditionTypes = []string{
+	hcov1beta1.ConditionAvailable,
+	hcov1beta1.ConditionProgressing,
+	hcov1beta1.ConditionDegraded,
+	hcov1beta1.ConditionUpgradeable,
+}
+
+// same as the validation of the reason field in metav1.Condition
+var conditionReasonRegex = regexp.MustCompile(`^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$`)
+
+// Set of hooks, to be implemented by the operands that are reported in the status.components list
+type componentStatusHooks interface {
+	overridableHooks
+	// get the version of the operand, as reported in the status of its CR
+	getObservedVersion(runtime.Object) string
+	// get the name of the environment variable that holds the version that HCO deploys
+	getVersionEnvName() string
+}
+
+// setComponentReconcileError sets the result of the last reconciliation of the operand in its status.components
+// entry. If the entry does not exist yet, it is created.
+func (h *genericOperand) setComponentReconcileError(req *common.HcoRequest, csh componentStatusHooks, ensureErr error) {
+	h.updateComponentStatus(req, csh, func(cs *hcov1beta1.ComponentStatus) {
+		cs.LastReconcileError = ""
+		if ensureErr != nil {
+			cs.LastReconcileError = ensureErr.Error()
+		}
+	})
+}
+
+// setComponentConditions copies the conditions and the observed version of the operand CR, to its status.components
+// entry.
+func (h *genericOperand) setComponentConditions(req *common.HcoRequest, csh componentStatusHooks, found runtime.Object, conditions []metav1.Condition) {
+	h.updateComponentStatus(req, csh, func(cs *hcov1beta1.ComponentStatus) {
+		cs.ObservedVersion = csh.getObservedVersion(found)
+
+		if len(conditions) == 0 {
+			conditions = h.getNoConditionsComponentConditions()
+		}
+
+		for _, cond := range conditions {
+			if !slices.Contains(componentConditionTypes, cond.Type) {
+				continue
+			}
+
+			if !conditionReasonRegex.MatchString(cond.Reason) {
+				cond.Reason = unknownReason
+			}
+			cond.ObservedGeneration = req.Instance.Generation
+			apimeta.SetStatusCondition(&cs.Conditions, cond)
+		}
+
+		cs.LastTransitionTime = nil
+		for _, cond := range cs.Conditions {
+			if cs.LastTransitionTime == nil || cs.LastTransitionTime.Before(&cond.LastTransitionTime) {
+				cs.LastTransitionTime = cond.LastTransitionTime.DeepCopy()
+			}
+		}
+	})
+}
+
+func (h *genericOperand) getNoConditionsComponentConditions() []metav1.Condition {
+	message := fmt.Sprintf("%s resource has no conditions", h.crType)
+	return []metav1.Condition{
+		{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionFalse, Reason: noConditionsReason, Message: message},
+		{Type: hcov1beta1.ConditionProgressing, Status: metav1.ConditionTrue, Reason: noConditionsReason, Message: message},
+		{Type: hcov1beta1.ConditionUpgradeable, Status: metav1.ConditionFalse, Reason: noConditionsReason, Message: message},
+	}
+}
+
+func (h *genericOperand) updateComponentStatus(req *common.HcoRequest, csh componentStatusHooks, update func(*hcov1beta1.ComponentStatus)) {
+	name := csh.getOperandName()
+	statuses := req.Instance.Status.Components
+
+	idx := slices.IndexFunc(statuses, func(cs hcov1beta1.ComponentStatus) bool {
+		return cs.Name == name
+	})
+
+	newStatus := hcov1beta1.ComponentStatus{Name: name}
+	if idx >= 0 {
+		statuses[idx].DeepCopyInto(&newStatus)
+	}
+
+	if gvk, err := apiutil.GVKForObject(h.hooks.getEmptyCr(), h.Scheme); err == nil {
+		newStatus.Group = gvk.Group
+		newStatus.Version = gvk.Version
+		newStatus.Kind = gvk.Kind
+	}
+	newStatus.TargetVersion = os.Getenv(csh.getVersionEnvName())
+
+	update(&newStatus)
+
+	if idx >= 0 {
+		if reflect.DeepEqual(statuses[idx], newStatus) {
+			return
+		}
+		statuses[idx] = newStatus
+	} else {
+		req.Instance.Status.Components = append(statuses, newStatus)
+	}
+
+	req.StatusDirty = true
+}
+
+func removeComponentStatus(req *common.HcoRequest, name string) {
+	statuses := req.Instance.Status.Components
+	for i, cs := range statuses {
+		if cs.Name == name {
+			req.Instance.Status.Components = append(statuses[:i], statuses[i+1:]...)
+			if len(req.Instance.Status.Components) == 0 {
+				req.Instance.Status.Components = nil
+			}
+			req.StatusDirty = true
+			return
+		}
+	}
+}
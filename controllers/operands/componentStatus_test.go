@@ -0,0 +1,194 @@
+package operands
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/metrics"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Component Status", func() {
+	var (
+		hco *hcov1beta1.HyperConverged
+		req *common.HcoRequest
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		req = commontestutils.NewReq(hco)
+	})
+
+	It("should add the component when its CR is created", func() {
+		cl := commontestutils.InitClient([]client.Object{hco})
+		handler := (*genericOperand)(newKubevirtHandler(cl, commontestutils.GetScheme()))
+		res := handler.ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Created).To(BeTrue())
+
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.Components).To(HaveLen(1))
+
+		cs := hco.Status.Components[0]
+		Expect(cs.Name).To(Equal(hcov1beta1.OperandKubeVirt))
+		Expect(cs.Group).To(Equal("kubevirt.io"))
+		Expect(cs.Version).To(Equal("v1"))
+		Expect(cs.Kind).To(Equal("KubeVirt"))
+		Expect(cs.TargetVersion).To(Equal(os.Getenv(hcoutil.KubevirtVersionEnvV)))
+		Expect(cs.LastReconcileError).To(BeEmpty())
+	})
+
+	It("should copy the conditions and the observed version of the operand CR", func() {
+		const observedVersion = "1.2.3"
+
+		cdi, err := NewCDI(hco)
+		Expect(err).ToNot(HaveOccurred())
+		cdi.Status.ObservedVersion = observedVersion
+		cdi.Status.Conditions = []conditionsv1.Condition{
+			{Type: conditionsv1.ConditionAvailable, Status: corev1.ConditionTrue, Reason: "Available"},
+			{Type: conditionsv1.ConditionProgressing, Status: corev1.ConditionFalse, Reason: "NotProgressing"},
+			{Type: conditionsv1.ConditionDegraded, Status: corev1.ConditionTrue, Reason: "bad reason!", Message: "something went wrong"},
+			{Type: "SomethingElse", Status: corev1.ConditionTrue, Reason: "Ignored"},
+		}
+		cdi.Status.Phase = sdkapi.PhaseDeployed
+
+		cl := commontestutils.InitClient([]client.Object{hco, cdi})
+		handler := (*genericOperand)(newCdiHandler(cl, commontestutils.GetScheme()))
+		res := handler.ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+
+		Expect(hco.Status.Components).To(HaveLen(1))
+		cs := hco.Status.Components[0]
+		Expect(cs.Name).To(Equal(hcov1beta1.OperandCDI))
+		Expect(cs.Kind).To(Equal("CDI"))
+		Expect(cs.ObservedVersion).To(Equal(observedVersion))
+		Expect(cs.LastTransitionTime).ToNot(BeNil())
+
+		Expect(cs.Conditions).To(HaveLen(3))
+		Expect(cs.Conditions[0]).To(commontestutils.RepresentCondition(metav1.Condition{
+			Type:   hcov1beta1.ConditionAvailable,
+			Status: metav1.ConditionTrue,
+			Reason: "Available",
+		}))
+		Expect(cs.Conditions[1]).To(commontestutils.RepresentCondition(metav1.Condition{
+			Type:   hcov1beta1.ConditionProgressing,
+			Status: metav1.ConditionFalse,
+			Reason: "NotProgressing",
+		}))
+		Expect(cs.Conditions[2]).To(commontestutils.RepresentCondition(metav1.Condition{
+			Type:    hcov1beta1.ConditionDegraded,
+			Status:  metav1.ConditionTrue,
+			Reason:  unknownReason,
+			Message: "something went wrong",
+		}))
+
+		By("reporting the conditions of the component in the metrics")
+		Expect(metrics.GetComponentCondition("CDI", hcov1beta1.ConditionAvailable)).To(Equal(1.0))
+		Expect(metrics.GetComponentCondition("CDI", hcov1beta1.ConditionProgressing)).To(Equal(0.0))
+		Expect(metrics.GetComponentCondition("CDI", hcov1beta1.ConditionDegraded)).To(Equal(1.0))
+
+		By("not changing the status if nothing was changed")
+		req = commontestutils.NewReq(hco)
+		res = handler.ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(req.StatusDirty).To(BeFalse())
+	})
+
+	It("should report an operand without conditions", func() {
+		kv, err := NewKubeVirt(hco)
+		Expect(err).ToNot(HaveOccurred())
+
+		metrics.SetComponentCondition("KubeVirt", hcov1beta1.ConditionAvailable, true)
+
+		cl := commontestutils.InitClient([]client.Object{hco, kv})
+		handler := (*genericOperand)(newKubevirtHandler(cl, commontestutils.GetScheme()))
+		res := handler.ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+
+		Expect(hco.Status.Components).To(HaveLen(1))
+		Expect(hco.Status.Components[0].Conditions).To(ContainElement(commontestutils.RepresentCondition(metav1.Condition{
+			Type:    hcov1beta1.ConditionAvailable,
+			Status:  metav1.ConditionFalse,
+			Reason:  noConditionsReason,
+			Message: "KubeVirt resource has no conditions",
+		})))
+		Expect(metrics.GetComponentCondition("KubeVirt", hcov1beta1.ConditionAvailable)).To(Equal(0.0))
+	})
+
+	It("should set and then clear the last reconcile error", func() {
+		kv, err := NewKubeVirt(hco)
+		Expect(err).ToNot(HaveOccurred())
+		kv.Status.Conditions = []kubevirtcorev1.KubeVirtCondition{
+			{Type: kubevirtcorev1.KubeVirtConditionAvailable, Status: corev1.ConditionTrue, Reason: "Available"},
+		}
+
+		fakeErr := errors.New("fake get error")
+		failGet := true
+		cl := fake.NewClientBuilder().
+			WithScheme(commontestutils.GetScheme()).
+			WithObjects(hco, kv).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					if _, isKV := obj.(*kubevirtcorev1.KubeVirt); isKV && failGet {
+						return fakeErr
+					}
+					return c.Get(ctx, key, obj, opts...)
+				},
+			}).
+			Build()
+
+		handler := (*genericOperand)(newKubevirtHandler(cl, commontestutils.GetScheme()))
+		res := handler.ensure(req)
+		Expect(res.Err).To(MatchError(fakeErr))
+
+		Expect(hco.Status.Components).To(HaveLen(1))
+		Expect(hco.Status.Components[0].LastReconcileError).To(Equal(fakeErr.Error()))
+
+		failGet = false
+		res = handler.ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+
+		Expect(hco.Status.Components).To(HaveLen(1))
+		Expect(hco.Status.Components[0].LastReconcileError).To(BeEmpty())
+		Expect(hco.Status.Components[0].Conditions).To(ContainElement(commontestutils.RepresentCondition(metav1.Condition{
+			Type:   hcov1beta1.ConditionAvailable,
+			Status: metav1.ConditionTrue,
+			Reason: "Available",
+		})))
+	})
+
+	It("should remove the AAQ component when AAQ is not deployed", func() {
+		hco.Spec.FeatureGates.EnableApplicationAwareQuota = ptr.To(false)
+		hco.Status.Components = []hcov1beta1.ComponentStatus{
+			{Name: hcov1beta1.OperandKubeVirt},
+			{Name: hcov1beta1.OperandAAQ},
+		}
+
+		cl := commontestutils.InitClient([]client.Object{hco})
+		handler := newAAQHandler(cl, commontestutils.GetScheme())
+		res := handler.ensure(req)
+		Expect(res.Err).ToNot(HaveOccurred())
+
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.Components).To(HaveLen(1))
+		Expect(hco.Status.Components[0].Name).To(Equal(hcov1beta1.OperandKubeVirt))
+	})
+})
@@ -8,6 +8,7 @@ import (
 
 	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/metrics"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -31,6 +32,12 @@ func (ch *conditionalHandler) reset() {
 }
 
 func (ch *conditionalHandler) ensureDeleted(req *common.HcoRequest) *EnsureResult {
+	if oh, ok := ch.operand.hooks.(overridableHooks); ok {
+		removeOperandOverrideStatus(req, oh.getOperandName())
+		removeComponentStatus(req, oh.getOperandName())
+	}
+	metrics.DeleteComponentConditions(ch.operand.crType)
+
 	cr := ch.getCRWithName(req.Instance)
 	res := NewEnsureResult(req.Instance)
 	res.SetName(cr.GetName())
@@ -0,0 +1,107 @@
+package operands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// The schedule of a DataImportCron is a standard cron expression, as used by the Kubernetes CronJob. It is parsed
+// only to find the longest interval between two scheduled polls, that is the period that is reported in the
+// kubevirt_hco_dataimportcron_schedule_period_seconds metric.
+
+const (
+	// the schedule is checked over 8 years, to include the schedules that only run on February 29th
+	schedulePeriodWindowYears = 8
+
+	// the bit the cron parser sets in the day of month and day of week fields, when they are "*" or "?"
+	cronStarBit = 1 << 63
+)
+
+var schedulePeriodWindowStart = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// getSchedulePeriod returns the longest interval between two consecutive runs of a cron schedule
+func getSchedulePeriod(schedule string) (time.Duration, error) {
+	sched, err := cron.ParseStandard(schedule)
+	if err != nil {
+		return 0, fmt.Errorf("invalid schedule %q: %w", schedule, err)
+	}
+
+	switch s := sched.(type) {
+	case cron.ConstantDelaySchedule:
+		return s.Delay, nil
+	case *cron.SpecSchedule:
+		period := longestInterval(s)
+		if period == 0 {
+			return 0, fmt.Errorf("invalid schedule %q: the schedule never runs", schedule)
+		}
+		return period, nil
+	default:
+		return 0, fmt.Errorf("invalid schedule %q: unsupported schedule type %T", schedule, sched)
+	}
+}
+
+func matchesDay(s *cron.SpecSchedule, day time.Time) bool {
+	if s.Month&(1<<uint(day.Month())) == 0 {
+		return false
+	}
+
+	domMatch := s.Dom&(1<<uint(day.Day())) != 0
+	dowMatch := s.Dow&(1<<uint(day.Weekday())) != 0
+
+	// same as the Kubernetes CronJob: if one of the day fields is "any value", both fields must match; otherwise, any
+	// of them
+	if s.Dom&cronStarBit != 0 || s.Dow&cronStarBit != 0 {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// longestInterval returns the longest interval between two consecutive runs of the schedule, or 0 if the schedule
+// does not run at least twice. The timezone of the schedule is ignored; it does not change the interval between the
+// runs, except for the DST changes.
+func longestInterval(s *cron.SpecSchedule) time.Duration {
+	var offsets []time.Duration
+	for hour := 0; hour < 24; hour++ {
+		if s.Hour&(1<<uint(hour)) == 0 {
+			continue
+		}
+		for minute := 0; minute < 60; minute++ {
+			if s.Minute&(1<<uint(minute)) != 0 {
+				offsets = append(offsets, time.Duration(hour)*time.Hour+time.Duration(minute)*time.Minute)
+			}
+		}
+	}
+
+	if len(offsets) == 0 {
+		return 0
+	}
+
+	// the runs in the same day are always at the same offsets, so the intervals between them are computed only once
+	var longest time.Duration
+	for i := 1; i < len(offsets); i++ {
+		longest = max(longest, offsets[i]-offsets[i-1])
+	}
+
+	var lastRun time.Time
+	runs := 0
+	windowEnd := schedulePeriodWindowStart.AddDate(schedulePeriodWindowYears, 0, 0)
+	for day := schedulePeriodWindowStart; day.Before(windowEnd); day = day.AddDate(0, 0, 1) {
+		if !matchesDay(s, day) {
+			continue
+		}
+
+		if !lastRun.IsZero() {
+			longest = max(longest, day.Add(offsets[0]).Sub(lastRun))
+		}
+		lastRun = day.Add(offsets[len(offsets)-1])
+		runs += len(offsets)
+	}
+
+	if runs < 2 {
+		return 0
+	}
+
+	return longest
+}
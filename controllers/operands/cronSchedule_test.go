@@ -0,0 +1,53 @@
+package operands
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Test getSchedulePeriod", func() {
+	DescribeTable("should return the longest interval of the schedule", func(schedule string, expected time.Duration) {
+		Expect(getSchedulePeriod(schedule)).To(Equal(expected))
+	},
+		Entry("every minute", "* * * * *", time.Minute),
+		Entry("every 15 minutes", "*/15 * * * *", 15*time.Minute),
+		Entry("twice a day", "1 */12 * * *", 12*time.Hour),
+		Entry("irregular hours", "0 1,5,20 * * *", 15*time.Hour),
+		Entry("hours range", "30 9-17 * * *", 16*time.Hour),
+		Entry("weekdays", "0 8 * * mon-fri", 72*time.Hour),
+		Entry("sunday", "0 0 * * sun", 7*24*time.Hour),
+		Entry("day of month", "0 0 15 * *", 31*24*time.Hour),
+		Entry("day of month or day of week", "0 0 1 * 0", 7*24*time.Hour),
+		Entry("month names", "0 0 1 jan,jul *", 184*24*time.Hour),
+		Entry("leap day", "0 0 29 2 *", 4*365*24*time.Hour+24*time.Hour),
+		Entry("question mark", "0 0 ? * 3", 7*24*time.Hour),
+		Entry("hourly", "@hourly", time.Hour),
+		Entry("daily", "@daily", 24*time.Hour),
+		Entry("midnight", "@midnight", 24*time.Hour),
+		Entry("weekly", "@weekly", 7*24*time.Hour),
+		Entry("monthly", "@monthly", 31*24*time.Hour),
+		Entry("yearly", "@yearly", 366*24*time.Hour),
+		Entry("annually", "@annually", 366*24*time.Hour),
+		Entry("every", "@every 1h30m", 90*time.Minute),
+		Entry("timezone", "CRON_TZ=Europe/Paris 0 */6 * * *", 6*time.Hour),
+	)
+
+	DescribeTable("should reject invalid schedules", func(schedule string) {
+		_, err := getSchedulePeriod(schedule)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("empty", ""),
+		Entry("missing fields", "0 0 * *"),
+		Entry("too many fields", "0 0 0 * * *"),
+		Entry("out of range", "60 * * * *"),
+		Entry("invalid value", "0 noon * * *"),
+		Entry("invalid range", "0 20-10 * * *"),
+		Entry("zero step", "*/0 * * * *"),
+		Entry("invalid every", "@every soon"),
+		Entry("sunday as 7", "0 0 * * 7"),
+		Entry("unknown descriptor", "@sometimes"),
+		Entry("never runs", "0 0 30 2 *"),
+	)
+})
@@ -23,8 +23,12 @@ const (
 	dashboardManifestLocationDefault = "./dashboard"
 )
 
+func getDashboardFileLocation() string {
+	return util.GetManifestDirPath(dashboardManifestLocationVarName, dashboardManifestLocationDefault)
+}
+
 func getDashboardHandlers(logger log.Logger, Client client.Client, Scheme *runtime.Scheme, hc *hcov1beta1.HyperConverged) ([]Operand, error) {
-	filesLocation := util.GetManifestDirPath(dashboardManifestLocationVarName, dashboardManifestLocationDefault)
+	filesLocation := getDashboardFileLocation()
 
 	err := util.ValidateManifestDir(filesLocation)
 	if err != nil {
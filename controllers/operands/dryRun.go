@@ -0,0 +1,95 @@
+package operands
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// DryRunner writes one of the objects of a HyperConverged CR to the cluster in dry-run mode, the way HCO reconciles
+// it, so the object is validated by the API server and by the admission webhooks of the operand, without persisting
+// it.
+type DryRunner struct {
+	// Kind is the printable type of the object
+	Kind string
+
+	dryRun func(ctx context.Context) error
+}
+
+// DryRun writes the object in dry-run mode. The timeout of the call is controlled by ctx.
+func (r DryRunner) DryRun(ctx context.Context) error {
+	return r.dryRun(ctx)
+}
+
+// GetDryRunners returns a DryRunner for each object that HCO creates and reconciles for the requested HyperConverged
+// CR. The objects that HCO reconciles with server-side apply are applied, and the other objects are updated by their
+// handlers. Missing objects are created, except for the operand CRs that are already deployed for the existing
+// HyperConverged CR: HCO creates them on its first reconciliation, so a missing one is reported as an error.
+//
+// It also returns the kinds of the objects that can't be rendered in this process, e.g. because their manifest
+// directories are not available; these objects are not dry-run.
+//
+// As with Render, the operands read the cluster facts from hcoutil.GetClusterInfo, so the caller should make it
+// return ci as well.
+func GetDryRunners(cli client.Client, scheme *runtime.Scheme, ci hcoutil.ClusterInfo, requested, exists *hcov1beta1.HyperConverged) ([]DryRunner, []string, error) {
+	var runners []DryRunner
+	operands, skipped := getAllOperands(client.NewDryRunClient(cli), scheme, ci, requested)
+	for _, operand := range operands {
+		h, cr, err := renderOperand(operand, requested)
+		if err != nil {
+			return nil, nil, err
+		}
+		if cr == nil {
+			continue
+		}
+
+		_, isOperandCR := h.hooks.(hcoOperandHooks)
+		mustExist := isOperandCR && isDeployed(operand, exists)
+
+		runners = append(runners, DryRunner{
+			Kind: h.crType,
+			dryRun: func(ctx context.Context) error {
+				return h.dryRun(ctx, requested, cr, mustExist)
+			},
+		})
+	}
+
+	return runners, skipped, nil
+}
+
+func (h *genericOperand) dryRun(ctx context.Context, hc *hcov1beta1.HyperConverged, cr client.Object, mustExist bool) error {
+	req := common.NewHcoRequest(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(hc)}, logger, false, true)
+	req.Instance = hc.DeepCopy()
+
+	if err := h.doSetControllerReference(req, cr); err != nil {
+		return err
+	}
+
+	found := h.hooks.getEmptyCr()
+	err := h.Client.Get(ctx, client.ObjectKeyFromObject(cr), found)
+	if apierrors.IsNotFound(err) && !mustExist {
+		return h.createNewCr(req, cr, NewEnsureResult(cr)).Err
+	} else if err != nil {
+		return err
+	}
+
+	if uh, ok := h.hooks.(updateHooks); ok {
+		_, _, err = uh.updateCr(req, h.Client, found, cr)
+		return err
+	}
+
+	// unlike the reconciliation, the object is applied even if HCO does not need to update it, so it is validated
+	// against the current state of the cluster
+	applyCfg, err := h.toApplyConfiguration(cr)
+	if err != nil {
+		return err
+	}
+	return h.applyCr(req, applyCfg)
+}
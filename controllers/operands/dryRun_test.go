@@ -0,0 +1,144 @@
+package operands
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("GetDryRunners", func() {
+	var (
+		hco *hcov1beta1.HyperConverged
+		ctx context.Context
+	)
+
+	getClusterInfo := hcoutil.GetClusterInfo
+
+	BeforeEach(func() {
+		_ = os.Setenv("VIRTIOWIN_CONTAINER", "just-a-value:version")
+		hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+			return &commontestutils.ClusterInfoMock{}
+		}
+		hco = commontestutils.NewHco()
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		hcoutil.GetClusterInfo = getClusterInfo
+		_ = os.Unsetenv("VIRTIOWIN_CONTAINER")
+	})
+
+	findRunner := func(runners []DryRunner, kind string) *DryRunner {
+		for i, runner := range runners {
+			if runner.Kind == kind {
+				return &runners[i]
+			}
+		}
+		return nil
+	}
+
+	getRunners := func(cl client.Client, requested *hcov1beta1.HyperConverged) []DryRunner {
+		runners, _, err := GetDryRunners(cl, commontestutils.GetScheme(), commontestutils.ClusterInfoMock{}, requested, hco)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		return runners
+	}
+
+	It("should return a runner for each deployed object", func() {
+		runners := getRunners(commontestutils.InitClient(nil), hco)
+
+		for _, kind := range []string{"KubeVirt", "CDI", "NetworkAddonsConfig", "SSP", "KubeVirtPriorityClass"} {
+			Expect(findRunner(runners, kind)).ToNot(BeNil(), kind)
+		}
+		Expect(findRunner(runners, "AAQ")).To(BeNil())
+
+		requested := hco.DeepCopy()
+		requested.Spec.FeatureGates.EnableApplicationAwareQuota = ptr.To(true)
+		Expect(findRunner(getRunners(commontestutils.InitClient(nil), requested), "AAQ")).ToNot(BeNil())
+	})
+
+	It("should report the objects that can't be rendered", func() {
+		origGetImageStreamFileLocation := getImageStreamFileLocation
+		DeferCleanup(func() {
+			getImageStreamFileLocation = origGetImageStreamFileLocation
+			_ = os.Unsetenv(dashboardManifestLocationVarName)
+		})
+		getImageStreamFileLocation = func() string {
+			return "/not/existing/dir"
+		}
+		_ = os.Setenv(dashboardManifestLocationVarName, getTestFilesLocation()+"/dashboards")
+		_ = os.Unsetenv("VIRTIOWIN_CONTAINER")
+
+		runners, skipped, err := GetDryRunners(commontestutils.InitClient(nil), commontestutils.GetScheme(), commontestutils.ClusterInfoMock{}, hco, hco)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(skipped).To(ConsistOf("ImageStream", "virtio-win ConfigMap"))
+		Expect(findRunner(runners, "ConfigMap")).ToNot(BeNil())
+	})
+
+	It("should return an error if the object can't be rendered", func() {
+		requested := hco.DeepCopy()
+		requested.Annotations = map[string]string{"kubevirt.kubevirt.io/jsonpatch": "not a json"}
+
+		_, _, err := GetDryRunners(commontestutils.InitClient(nil), commontestutils.GetScheme(), commontestutils.ClusterInfoMock{}, requested, hco)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not modify the existing objects", func() {
+		kv, err := NewKubeVirt(hco)
+		Expect(err).ToNot(HaveOccurred())
+		cl := commontestutils.InitClient([]client.Object{hco, kv})
+
+		requested := hco.DeepCopy()
+		requested.Spec.Workloads.NodePlacement = commontestutils.NewNodePlacement()
+
+		Expect(findRunner(getRunners(cl, requested), "KubeVirt").DryRun(ctx)).To(Succeed())
+
+		found := &kubevirtcorev1.KubeVirt{}
+		Expect(cl.Get(ctx, client.ObjectKeyFromObject(kv), found)).To(Succeed())
+		Expect(found.Spec).To(Equal(kv.Spec))
+	})
+
+	It("should dry-run create the missing objects, without creating them", func() {
+		cl := commontestutils.InitClient([]client.Object{hco})
+
+		Expect(findRunner(getRunners(cl, hco), "KubeVirtPriorityClass").DryRun(ctx)).To(Succeed())
+
+		pc := &schedulingv1.PriorityClass{}
+		Expect(cl.Get(ctx, client.ObjectKey{Name: kvPriorityClass}, pc)).To(MatchError(apierrors.IsNotFound, "not found error"))
+	})
+
+	It("should reject a missing operand CR that is already deployed", func() {
+		cl := commontestutils.InitClient([]client.Object{hco})
+
+		Expect(findRunner(getRunners(cl, hco), "KubeVirt").DryRun(ctx)).To(MatchError(apierrors.IsNotFound, "not found error"))
+	})
+
+	It("should dry-run create the CR of a newly deployed operand", func() {
+		cl := commontestutils.InitClient([]client.Object{hco})
+		errFakeAAQError := errors.New("fake AAQ error")
+		cl.InitiateCreateErrors(func(obj client.Object) error {
+			if obj.GetName() == "aaq-"+hco.Name {
+				return errFakeAAQError
+			}
+			return nil
+		})
+
+		requested := hco.DeepCopy()
+		requested.Spec.FeatureGates.EnableApplicationAwareQuota = ptr.To(true)
+
+		Expect(findRunner(getRunners(cl, requested), "AAQ").DryRun(ctx)).To(MatchError(errFakeAAQError))
+	})
+})
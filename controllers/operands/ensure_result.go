@@ -16,6 +16,8 @@ type EnsureResult struct {
 	Err         error
 	Type        string
 	Name        string
+	// the fields that were modified by other managers, and were overwritten by HCO
+	OverwrittenFields []string
 }
 
 func NewEnsureResult(resource runtime.Object) *EnsureResult {
@@ -44,6 +46,11 @@ func (r *EnsureResult) SetOverwritten(overwritten bool) *EnsureResult {
 	return r
 }
 
+func (r *EnsureResult) SetOverwrittenFields(fields []string) *EnsureResult {
+	r.OverwrittenFields = fields
+	return r
+}
+
 func (r *EnsureResult) SetUpgradeDone(upgradeDone bool) *EnsureResult {
 	r.UpgradeDone = upgradeDone
 	return r
@@ -0,0 +1,208 @@
+package operands
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/metrics"
+)
+
+const (
+	// the annotation CDI sets on the DataImportCron, with the digest of the latest polled source image
+	cdiSourceDesiredDigestAnnotation = "cdi.kubevirt.io/storage.import.sourceDesiredDigest"
+
+	dataImportCronNotFoundReason = "DataImportCronNotFound"
+	dataSourceNotFoundReason     = "DataSourceNotFound"
+)
+
+// observeGoldenImages updates the status of the DataImportCronTemplates with the state of the DataImportCrons that SSP
+// created from them, and of the DataSources they manage. It also reports the golden image metrics.
+func observeGoldenImages(req *common.HcoRequest, cl client.Client, dictStatuses []hcov1beta1.DataImportCronTemplateStatus) {
+	crons := &cdiv1beta1.DataImportCronList{}
+	if err := cl.List(req.Ctx, crons); err != nil {
+		req.Logger.Error(err, "failed to read the DataImportCrons; can't report the state of the golden images")
+		return
+	}
+
+	metrics.ResetGoldenImages()
+
+	for i := range dictStatuses {
+		dictStatus := &dictStatuses[i]
+		prevStatus := getPrevDictStatus(req, dictStatus.Name)
+
+		cron := findDataImportCron(crons.Items, dictStatus.DataImportCronTemplate)
+		if cron == nil {
+			dictStatus.Status.FailingCondition = keepTransitionTime(&metav1.Condition{
+				Type:    string(cdiv1beta1.DataImportCronUpToDate),
+				Status:  metav1.ConditionFalse,
+				Reason:  dataImportCronNotFoundReason,
+				Message: fmt.Sprintf("can't find the %s DataImportCron", dictStatus.Name),
+			}, prevStatus)
+			continue
+		}
+
+		dictStatus.Status.LastImportTimestamp = cron.Status.LastImportTimestamp.DeepCopy()
+		dictStatus.Status.CurrentDigest = cron.Annotations[cdiSourceDesiredDigestAnnotation]
+
+		failingCond, err := getGoldenImageFailingCondition(req, cl, cron)
+		if err != nil {
+			req.Logger.Error(err, "failed to read the DataSource of the golden image", "DataImportCron", cron.Name, "namespace", cron.Namespace)
+		} else {
+			dictStatus.Status.FailingCondition = keepTransitionTime(failingCond, prevStatus)
+		}
+
+		reportGoldenImageMetrics(req, cron, dictStatus.Status)
+	}
+}
+
+func getPrevDictStatus(req *common.HcoRequest, name string) *hcov1beta1.DataImportCronStatus {
+	for _, dictStatus := range req.Instance.Status.DataImportCronTemplates {
+		if dictStatus.Name == name {
+			return &dictStatus.Status
+		}
+	}
+	return nil
+}
+
+// findDataImportCron returns the DataImportCron of the DataImportCronTemplate. If the template does not specify the
+// namespace, SSP creates the DataImportCron in its golden images namespace; in this case, the DataImportCron is only
+// found if its name is unique in the cluster.
+func findDataImportCron(crons []cdiv1beta1.DataImportCron, dict hcov1beta1.DataImportCronTemplate) *cdiv1beta1.DataImportCron {
+	var found *cdiv1beta1.DataImportCron
+	for i := range crons {
+		if crons[i].Name != dict.Name {
+			continue
+		}
+
+		if dict.Namespace != "" {
+			if crons[i].Namespace == dict.Namespace {
+				return &crons[i]
+			}
+			continue
+		}
+
+		if found != nil {
+			return nil
+		}
+		found = &crons[i]
+	}
+
+	return found
+}
+
+// getGoldenImageFailingCondition returns the UpToDate condition of the DataImportCron if it is not true, or else the
+// Ready condition of its DataSource if it is not true, or else nil.
+func getGoldenImageFailingCondition(req *common.HcoRequest, cl client.Client, cron *cdiv1beta1.DataImportCron) (*metav1.Condition, error) {
+	upToDate := findDataImportCronCondition(cron.Status.Conditions, cdiv1beta1.DataImportCronUpToDate)
+	if upToDate == nil {
+		return &metav1.Condition{
+			Type:    string(cdiv1beta1.DataImportCronUpToDate),
+			Status:  metav1.ConditionUnknown,
+			Reason:  noConditionsReason,
+			Message: "the DataImportCron does not report its state yet",
+		}, nil
+	}
+
+	if upToDate.Status != corev1.ConditionTrue {
+		return cdiConditionToK8s(string(upToDate.Type), upToDate.ConditionState), nil
+	}
+
+	dataSource := &cdiv1beta1.DataSource{}
+	err := cl.Get(req.Ctx, client.ObjectKey{Namespace: cron.Namespace, Name: cron.Spec.ManagedDataSource}, dataSource)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return &metav1.Condition{
+				Type:    string(cdiv1beta1.DataSourceReady),
+				Status:  metav1.ConditionFalse,
+				Reason:  dataSourceNotFoundReason,
+				Message: fmt.Sprintf("can't find the %s DataSource", cron.Spec.ManagedDataSource),
+			}, nil
+		}
+		return nil, err
+	}
+
+	for _, cond := range dataSource.Status.Conditions {
+		if cond.Type == cdiv1beta1.DataSourceReady && cond.Status != corev1.ConditionTrue {
+			return cdiConditionToK8s(string(cond.Type), cond.ConditionState), nil
+		}
+	}
+
+	return nil, nil
+}
+
+func findDataImportCronCondition(conditions []cdiv1beta1.DataImportCronCondition, condType cdiv1beta1.DataImportCronConditionType) *cdiv1beta1.DataImportCronCondition {
+	for i := range conditions {
+		if conditions[i].Type == condType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+func cdiConditionToK8s(condType string, state cdiv1beta1.ConditionState) *metav1.Condition {
+	cond := &metav1.Condition{
+		Type:               condType,
+		Status:             metav1.ConditionStatus(state.Status),
+		LastTransitionTime: state.LastTransitionTime,
+		Reason:             state.Reason,
+		Message:            state.Message,
+	}
+
+	if !conditionReasonRegex.MatchString(cond.Reason) {
+		cond.Reason = unknownReason
+	}
+
+	return cond
+}
+
+// keepTransitionTime sets the last transition time of the failing condition, if it is missing. If the condition was
+// already reported, its previous transition time is kept; otherwise, it is set to now.
+func keepTransitionTime(cond *metav1.Condition, prevStatus *hcov1beta1.DataImportCronStatus) *metav1.Condition {
+	if cond == nil || !cond.LastTransitionTime.IsZero() {
+		return cond
+	}
+
+	if prevStatus != nil && prevStatus.FailingCondition != nil &&
+		prevStatus.FailingCondition.Type == cond.Type &&
+		prevStatus.FailingCondition.Status == cond.Status &&
+		prevStatus.FailingCondition.Reason == cond.Reason {
+		cond.LastTransitionTime = prevStatus.FailingCondition.LastTransitionTime
+	} else {
+		cond.LastTransitionTime = metav1.Now()
+	}
+
+	return cond
+}
+
+func reportGoldenImageMetrics(req *common.HcoRequest, cron *cdiv1beta1.DataImportCron, status hcov1beta1.DataImportCronStatus) {
+	lastImport := cron.CreationTimestamp.Time
+	if status.LastImportTimestamp != nil {
+		lastImport = status.LastImportTimestamp.Time
+	}
+	metrics.SetDataImportCronLastImportTimestamp(cron.Namespace, cron.Name, lastImport)
+
+	if status.CurrentDigest != "" {
+		metrics.SetDataImportCronDigest(cron.Namespace, cron.Name, status.CurrentDigest)
+	}
+
+	reason := ""
+	if status.FailingCondition != nil {
+		reason = status.FailingCondition.Reason
+	}
+	metrics.SetDataImportCronFailing(cron.Namespace, cron.Name, status.FailingCondition != nil, reason)
+
+	period, err := getSchedulePeriod(cron.Spec.Schedule)
+	if err != nil {
+		req.Logger.Error(err, "can't report the schedule period of the golden image", "DataImportCron", cron.Name, "namespace", cron.Namespace)
+		return
+	}
+	metrics.SetDataImportCronSchedulePeriod(cron.Namespace, cron.Name, period)
+}
@@ -0,0 +1,166 @@
+package operands
+
+import (
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// The HostPathProvisioner API is not imported as a go module. HCO handles the HostPathProvisioner CR as an
+// unstructured object, using the types below to read and write the fields it cares about.
+
+// HostPathProvisionerGroupVersionKind is the GVK of the HostPathProvisioner CR
+var HostPathProvisionerGroupVersionKind = schema.GroupVersionKind{
+	Group:   "hostpathprovisioner.kubevirt.io",
+	Version: "v1beta1",
+	Kind:    "HostPathProvisioner",
+}
+
+// hppSpec is the spec of the HostPathProvisioner CR, as rendered by HCO
+type hppSpec struct {
+	ImagePullPolicy corev1.PullPolicy                `json:"imagePullPolicy,omitempty"`
+	StoragePools    []hcov1beta1.HostPathStoragePool `json:"storagePools,omitempty"`
+	Workload        sdkapi.NodePlacement             `json:"workload,omitempty"`
+}
+
+// hppStatus is the part of the HostPathProvisioner CR status that HCO reads
+type hppStatus struct {
+	Conditions      []conditionsv1.Condition `json:"conditions,omitempty"`
+	ObservedVersion string                   `json:"observedVersion,omitempty"`
+}
+
+func newHppHandler(Client client.Client, Scheme *runtime.Scheme) Operand {
+	return &conditionalHandler{
+		operand: &genericOperand{
+			Client: Client,
+			Scheme: Scheme,
+			crType: "HostPathProvisioner",
+			hooks:  &hppHooks{},
+		},
+		shouldDeploy: func(hc *hcov1beta1.HyperConverged) bool {
+			return hc.Spec.FeatureGates.EnableHostPathProvisioner != nil && *hc.Spec.FeatureGates.EnableHostPathProvisioner
+		},
+		getCRWithName: func(hc *hcov1beta1.HyperConverged) client.Object {
+			return NewHostPathProvisionerWithNameOnly(hc)
+		},
+	}
+}
+
+type hppHooks struct {
+	cache *unstructured.Unstructured
+}
+
+func (h *hppHooks) getFullCr(hc *hcov1beta1.HyperConverged) (client.Object, error) {
+	if h.cache == nil {
+		hpp, err := NewHostPathProvisioner(hc)
+		if err != nil {
+			return nil, err
+		}
+		h.cache = hpp
+	}
+	return h.cache, nil
+}
+
+func (*hppHooks) getEmptyCr() client.Object { return NewEmptyHostPathProvisioner() }
+
+func (*hppHooks) getConditions(cr runtime.Object) []metav1.Condition {
+	return osConditionsToK8s(getHppStatus(cr).Conditions)
+}
+
+func (*hppHooks) checkComponentVersion(cr runtime.Object) bool {
+	return checkComponentVersion(hcoutil.HppoVersionEnvV, getHppStatus(cr).ObservedVersion)
+}
+
+func (h *hppHooks) reset() {
+	h.cache = nil
+}
+
+func (*hppHooks) justBeforeComplete(_ *common.HcoRequest) { /* no implementation */ }
+
+func (*hppHooks) getOperandName() string { return hcov1beta1.OperandHostPathProvisioner }
+func (*hppHooks) getObservedVersion(cr runtime.Object) string {
+	return getHppStatus(cr).ObservedVersion
+}
+func (*hppHooks) getVersionEnvName() string { return hcoutil.HppoVersionEnvV }
+
+// NewHostPathProvisioner renders the HostPathProvisioner CR, according to the spec.hostPathProvisioner field of the
+// HyperConverged CR.
+//
+// Notice that the spec.certConfig field is not propagated to the HostPathProvisioner CR: the HostPathProvisioner API has
+// no certificate configuration, because the HPP operator rotates the certificate of its webhook with its own, fixed,
+// policy. An operand override can't set it either. The validating webhook warns when a custom certConfig is set while
+// HPP is deployed.
+func NewHostPathProvisioner(hc *hcov1beta1.HyperConverged) (*unstructured.Unstructured, error) {
+	spec := hppSpec{
+		ImagePullPolicy: corev1.PullIfNotPresent,
+	}
+
+	if hc.Spec.Workloads.NodePlacement != nil {
+		hc.Spec.Workloads.NodePlacement.DeepCopyInto(&spec.Workload)
+	}
+
+	if config := hc.Spec.HostPathProvisioner; config != nil {
+		for _, pool := range config.StoragePools {
+			spec.StoragePools = append(spec.StoragePools, *pool.DeepCopy())
+		}
+
+		if config.NodePlacement != nil {
+			spec.Workload = sdkapi.NodePlacement{}
+			config.NodePlacement.DeepCopyInto(&spec.Workload)
+		}
+	}
+
+	specMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&spec)
+	if err != nil {
+		return nil, err
+	}
+
+	hpp := NewHostPathProvisionerWithNameOnly(hc)
+	hpp.Object["spec"] = specMap
+
+	if err = applyOperandOverride(hc, hcov1beta1.OperandHostPathProvisioner, hpp); err != nil {
+		return nil, err
+	}
+
+	return hpp, nil
+}
+
+func NewHostPathProvisionerWithNameOnly(hc *hcov1beta1.HyperConverged) *unstructured.Unstructured {
+	hpp := NewEmptyHostPathProvisioner()
+	hpp.SetName("hostpath-provisioner-" + hc.Name)
+	hpp.SetLabels(getLabels(hc, hcoutil.AppComponentStorage))
+
+	return hpp
+}
+
+// NewEmptyHostPathProvisioner returns an unstructured object with the GVK of the HostPathProvisioner CR
+func NewEmptyHostPathProvisioner() *unstructured.Unstructured {
+	hpp := &unstructured.Unstructured{}
+	hpp.SetGroupVersionKind(HostPathProvisionerGroupVersionKind)
+	return hpp
+}
+
+func getHppStatus(cr runtime.Object) hppStatus {
+	status := hppStatus{}
+
+	u, ok := cr.(*unstructured.Unstructured)
+	if !ok {
+		return status
+	}
+
+	if statusMap, found, err := unstructured.NestedMap(u.Object, "status"); err == nil && found {
+		_ = runtime.DefaultUnstructuredConverter.FromUnstructured(statusMap, &status)
+	}
+
+	return status
+}
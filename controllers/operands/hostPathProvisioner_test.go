@@ -0,0 +1,371 @@
+package operands
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"kubevirt.io/controller-lifecycle-operator-sdk/api"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/metrics"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("HostPathProvisioner tests", func() {
+	var (
+		hco *v1beta1.HyperConverged
+		req *common.HcoRequest
+
+		testNodePlacement = api.NodePlacement{
+			NodeSelector: map[string]string{
+				"test": "testing",
+			},
+			Tolerations: []corev1.Toleration{{Key: "test", Operator: corev1.TolerationOpEqual, Value: "test", Effect: corev1.TaintEffectNoSchedule}},
+		}
+
+		testStoragePools = []v1beta1.HostPathStoragePool{
+			{
+				Name: "local",
+				Path: "/var/hpvolumes",
+			},
+			{
+				Name: "pvc-backed",
+				Path: "/var/hpvolumes-pvc",
+				PVCTemplate: &corev1.PersistentVolumeClaimSpec{
+					AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceStorage: resource.MustParse("50Gi"),
+						},
+					},
+					StorageClassName: ptr.To("local-block"),
+				},
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		req = commontestutils.NewReq(hco)
+	})
+
+	getSpec := func(hpp *unstructured.Unstructured) hppSpec {
+		spec := hppSpec{}
+		specMap, found, err := unstructured.NestedMap(hpp.Object, "spec")
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		ExpectWithOffset(1, found).To(BeTrue())
+		ExpectWithOffset(1, runtime.DefaultUnstructuredConverter.FromUnstructured(specMap, &spec)).To(Succeed())
+		return spec
+	}
+
+	getHpp := func(cl client.Client, name string) *unstructured.Unstructured {
+		found := NewEmptyHostPathProvisioner()
+		ExpectWithOffset(1, cl.Get(context.Background(), client.ObjectKey{Name: name}, found)).To(Succeed())
+		return found
+	}
+
+	Context("test NewHostPathProvisioner", func() {
+		It("should have all default fields", func() {
+			hco.Spec.HostPathProvisioner = &v1beta1.HostPathProvisionerConfig{
+				StoragePools: testStoragePools,
+			}
+
+			hpp, err := NewHostPathProvisioner(hco)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(hpp.GroupVersionKind()).To(Equal(HostPathProvisionerGroupVersionKind))
+			Expect(hpp.GetName()).To(Equal("hostpath-provisioner-" + hco.Name))
+			Expect(hpp.GetNamespace()).To(BeEmpty())
+			Expect(hpp.GetLabels()).To(HaveKeyWithValue(hcoutil.AppLabelComponent, string(hcoutil.AppComponentStorage)))
+
+			spec := getSpec(hpp)
+			Expect(spec.ImagePullPolicy).To(Equal(corev1.PullIfNotPresent))
+			Expect(spec.StoragePools).To(Equal(testStoragePools))
+			Expect(spec.Workload.NodeSelector).To(BeEmpty())
+			Expect(spec.Workload.Tolerations).To(BeEmpty())
+			Expect(spec.Workload.Affinity).To(BeNil())
+		})
+
+		It("should get the storage pools from the HyperConverged CR", func() {
+			hco.Spec.HostPathProvisioner = &v1beta1.HostPathProvisionerConfig{
+				StoragePools: testStoragePools,
+			}
+
+			hpp, err := NewHostPathProvisioner(hco)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(getSpec(hpp).StoragePools).To(Equal(testStoragePools))
+
+			pools, _, err := unstructured.NestedSlice(hpp.Object, "spec", "storagePools")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pools).To(HaveLen(2))
+			storage, _, err := unstructured.NestedString(pools[1].(map[string]any), "pvcTemplate", "resources", "requests", "storage")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(storage).To(Equal("50Gi"))
+		})
+
+		It("should use the workloads node placement by default", func() {
+			hco.Spec.Workloads.NodePlacement = testNodePlacement.DeepCopy()
+
+			hpp, err := NewHostPathProvisioner(hco)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(getSpec(hpp).Workload).To(Equal(testNodePlacement))
+		})
+
+		It("should prefer the node placement of the hostPathProvisioner field", func() {
+			hco.Spec.Workloads.NodePlacement = &api.NodePlacement{
+				NodeSelector: map[string]string{"other": "value"},
+			}
+			hco.Spec.HostPathProvisioner = &v1beta1.HostPathProvisionerConfig{
+				NodePlacement: testNodePlacement.DeepCopy(),
+			}
+
+			hpp, err := NewHostPathProvisioner(hco)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(getSpec(hpp).Workload).To(Equal(testNodePlacement))
+		})
+
+		It("should apply the operand override", func() {
+			hco.Spec.OperandOverrides = &v1beta1.OperandOverrides{
+				HostPathProvisioner: &v1beta1.OperandOverride{
+					Patches: []v1beta1.OperandPatch{
+						{
+							Op:    "add",
+							Path:  "/spec/featureGates",
+							Value: &apiextensionsv1.JSON{Raw: []byte(`["fg1"]`)},
+						},
+					},
+				},
+			}
+
+			hpp, err := NewHostPathProvisioner(hco)
+			Expect(err).ToNot(HaveOccurred())
+
+			fgs, found, err := unstructured.NestedStringSlice(hpp.Object, "spec", "featureGates")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(fgs).To(Equal([]string{"fg1"}))
+		})
+	})
+
+	Context("check FG", func() {
+		It("should not create HPP if the FG is not set", func() {
+			cl := commontestutils.InitClient([]client.Object{hco})
+
+			handler := newHppHandler(cl, commontestutils.GetScheme())
+			res := handler.ensure(req)
+
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeFalse())
+			Expect(res.Updated).To(BeFalse())
+			Expect(res.Deleted).To(BeFalse())
+
+			found := NewEmptyHostPathProvisioner()
+			Expect(cl.Get(context.Background(), client.ObjectKeyFromObject(NewHostPathProvisionerWithNameOnly(hco)), found)).ToNot(Succeed())
+		})
+
+		It("should delete HPP if the enableHostPathProvisioner FG is not set", func() {
+			hpp, err := NewHostPathProvisioner(hco)
+			Expect(err).ToNot(HaveOccurred())
+			cl := commontestutils.InitClient([]client.Object{hco, hpp})
+
+			handler := newHppHandler(cl, commontestutils.GetScheme())
+			res := handler.ensure(req)
+
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Name).To(Equal(hpp.GetName()))
+			Expect(res.Deleted).To(BeTrue())
+
+			found := NewEmptyHostPathProvisioner()
+			Expect(cl.Get(context.Background(), client.ObjectKeyFromObject(hpp), found)).ToNot(Succeed())
+		})
+
+		It("should remove the HPP component status and condition metrics if the enableHostPathProvisioner FG is not set", func() {
+			hco.Status.Components = []v1beta1.ComponentStatus{{Name: v1beta1.OperandHostPathProvisioner}}
+			metrics.SetComponentCondition("HostPathProvisioner", v1beta1.ConditionAvailable, true)
+			metrics.SetComponentCondition("HostPathProvisioner", v1beta1.ConditionDegraded, false)
+			Expect(metrics.GetComponentConditionsCount("HostPathProvisioner")).To(Equal(2))
+
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := newHppHandler(cl, commontestutils.GetScheme())
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+
+			Expect(hco.Status.Components).To(BeEmpty())
+			Expect(metrics.GetComponentConditionsCount("HostPathProvisioner")).To(BeZero())
+		})
+
+		It("should create HPP if the enableHostPathProvisioner FG is true", func() {
+			hco.Spec.FeatureGates.EnableHostPathProvisioner = ptr.To(true)
+			hco.Spec.HostPathProvisioner = &v1beta1.HostPathProvisionerConfig{
+				StoragePools: testStoragePools,
+			}
+			cl := commontestutils.InitClient([]client.Object{hco})
+
+			handler := newHppHandler(cl, commontestutils.GetScheme())
+			res := handler.ensure(req)
+
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Name).To(Equal("hostpath-provisioner-kubevirt-hyperconverged"))
+			Expect(res.Created).To(BeTrue())
+
+			found := getHpp(cl, res.Name)
+			Expect(getSpec(found).StoragePools).To(Equal(testStoragePools))
+
+			By("not updating HPP if nothing was changed")
+			handler.reset()
+			req = commontestutils.NewReq(hco)
+			res = handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeFalse())
+			Expect(hco.Status.RelatedObjects).To(ContainElement(HaveField("Kind", "HostPathProvisioner")))
+		})
+	})
+
+	Context("check update", func() {
+		It("should update HPP fields, if not matched to the requirements", func() {
+			hco.Spec.FeatureGates.EnableHostPathProvisioner = ptr.To(true)
+			hco.Spec.HostPathProvisioner = &v1beta1.HostPathProvisionerConfig{
+				StoragePools: testStoragePools,
+			}
+
+			hpp := NewHostPathProvisionerWithNameOnly(hco)
+			Expect(unstructured.SetNestedField(hpp.Object, "Always", "spec", "imagePullPolicy")).To(Succeed())
+			Expect(unstructured.SetNestedStringSlice(hpp.Object, []string{"outOfBand"}, "spec", "featureGates")).To(Succeed())
+
+			cl := commontestutils.InitClient([]client.Object{hco, hpp})
+			handler := newHppHandler(cl, commontestutils.GetScheme())
+
+			req.HCOTriggered = false
+			res := handler.ensure(req)
+
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+			Expect(res.Overwritten).To(BeTrue())
+
+			found := getHpp(cl, res.Name)
+			spec := getSpec(found)
+			Expect(spec.ImagePullPolicy).To(Equal(corev1.PullIfNotPresent))
+			Expect(spec.StoragePools).To(Equal(testStoragePools))
+
+			_, exists, err := unstructured.NestedFieldNoCopy(found.Object, "spec", "featureGates")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+
+		It("should reconcile managed labels to default without touching user added ones", func() {
+			const userLabelKey = "userLabelKey"
+			const userLabelValue = "userLabelValue"
+			hco.Spec.FeatureGates.EnableHostPathProvisioner = ptr.To(true)
+			hco.Spec.HostPathProvisioner = &v1beta1.HostPathProvisionerConfig{
+				StoragePools: testStoragePools,
+			}
+
+			outdatedResource, err := NewHostPathProvisioner(hco)
+			Expect(err).ToNot(HaveOccurred())
+			expectedLabels := outdatedResource.GetLabels()
+			labels := map[string]string{userLabelKey: userLabelValue}
+			for k, v := range expectedLabels {
+				labels[k] = "wrong_" + v
+			}
+			outdatedResource.SetLabels(labels)
+
+			cl := commontestutils.InitClient([]client.Object{hco, outdatedResource})
+			handler := newHppHandler(cl, commontestutils.GetScheme())
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			foundLabels := getHpp(cl, outdatedResource.GetName()).GetLabels()
+			for k, v := range expectedLabels {
+				Expect(foundLabels).To(HaveKeyWithValue(k, v))
+			}
+			Expect(foundLabels).To(HaveKeyWithValue(userLabelKey, userLabelValue))
+		})
+	})
+
+	Context("check conditions", func() {
+		const expectedVersion = "v1.2.3"
+
+		BeforeEach(func() {
+			origVersion, versionSet := os.LookupEnv(hcoutil.HppoVersionEnvV)
+			Expect(os.Setenv(hcoutil.HppoVersionEnvV, expectedVersion)).To(Succeed())
+			DeferCleanup(func() {
+				if versionSet {
+					_ = os.Setenv(hcoutil.HppoVersionEnvV, origVersion)
+				} else {
+					_ = os.Unsetenv(hcoutil.HppoVersionEnvV)
+				}
+			})
+
+			hco.Spec.FeatureGates.EnableHostPathProvisioner = ptr.To(true)
+			hco.Spec.HostPathProvisioner = &v1beta1.HostPathProvisionerConfig{
+				StoragePools: testStoragePools,
+			}
+		})
+
+		It("should aggregate the HPP conditions", func() {
+			hpp, err := NewHostPathProvisioner(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(unstructured.SetNestedSlice(hpp.Object, []any{
+				map[string]any{"type": "Available", "status": "False", "reason": "Foo", "message": "Bar"},
+				map[string]any{"type": "Progressing", "status": "False", "reason": "Foo"},
+				map[string]any{"type": "Degraded", "status": "False", "reason": "Foo"},
+			}, "status", "conditions")).To(Succeed())
+			Expect(unstructured.SetNestedField(hpp.Object, expectedVersion, "status", "observedVersion")).To(Succeed())
+
+			cl := commontestutils.InitClient([]client.Object{hco, hpp})
+			handler := newHppHandler(cl, commontestutils.GetScheme())
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+
+			Expect(req.Conditions[v1beta1.ConditionAvailable]).To(commontestutils.RepresentCondition(metav1.Condition{
+				Type:    v1beta1.ConditionAvailable,
+				Status:  metav1.ConditionFalse,
+				Reason:  "HostPathProvisionerNotAvailable",
+				Message: "HostPathProvisioner is not available: Bar",
+			}))
+
+			Expect(hco.Status.Components).To(HaveLen(1))
+			Expect(hco.Status.Components[0].Name).To(Equal(v1beta1.OperandHostPathProvisioner))
+			Expect(hco.Status.Components[0].Kind).To(Equal("HostPathProvisioner"))
+			Expect(hco.Status.Components[0].ObservedVersion).To(Equal(expectedVersion))
+		})
+
+		It("should complete the upgrade when HPP is ready and with the expected version", func() {
+			hpp, err := NewHostPathProvisioner(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(unstructured.SetNestedSlice(hpp.Object, []any{
+				map[string]any{"type": "Available", "status": "True", "reason": "Foo"},
+				map[string]any{"type": "Progressing", "status": "False", "reason": "Foo"},
+				map[string]any{"type": "Degraded", "status": "False", "reason": "Foo"},
+			}, "status", "conditions")).To(Succeed())
+			Expect(unstructured.SetNestedField(hpp.Object, expectedVersion, "status", "observedVersion")).To(Succeed())
+
+			cl := commontestutils.InitClient([]client.Object{hco, hpp})
+			handler := newHppHandler(cl, commontestutils.GetScheme())
+
+			req.UpgradeMode = true
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.UpgradeDone).To(BeTrue())
+		})
+	})
+})
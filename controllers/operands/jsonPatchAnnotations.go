@@ -0,0 +1,102 @@
+package operands
+
+import (
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+// JSONPatchAnnotationNames is the list of the jsonpatch annotations of the HyperConverged CR, that patch the operand CRs
+var JSONPatchAnnotationNames = []string{
+	common.JSONPatchKVAnnotationName,
+	common.JSONPatchCDIAnnotationName,
+	common.JSONPatchCNAOAnnotationName,
+	common.JSONPatchSSPAnnotationName,
+}
+
+// render the operand CR that a jsonpatch annotation patches
+var jsonPatchAnnotationRenderers = map[string]func(hc *hcov1beta1.HyperConverged) (runtime.Object, error){
+	common.JSONPatchKVAnnotationName: func(hc *hcov1beta1.HyperConverged) (runtime.Object, error) {
+		return NewKubeVirt(hc)
+	},
+	common.JSONPatchCDIAnnotationName: func(hc *hcov1beta1.HyperConverged) (runtime.Object, error) {
+		return NewCDI(hc)
+	},
+	common.JSONPatchCNAOAnnotationName: func(hc *hcov1beta1.HyperConverged) (runtime.Object, error) {
+		return NewNetworkAddons(hc)
+	},
+	common.JSONPatchSSPAnnotationName: func(hc *hcov1beta1.HyperConverged) (runtime.Object, error) {
+		ssp, _, err := NewSSP(hc)
+		return ssp, err
+	},
+}
+
+// JSONPatchOperation identifies an operation of a JSON patch
+type JSONPatchOperation struct {
+	// Index is the index of the operation in the patch
+	Index int
+	// Op is the kind of the operation, e.g. "add"
+	Op string
+	// Path is the path of the operation; it's empty if the path can't be read
+	Path string
+}
+
+func (o JSONPatchOperation) String() string {
+	if o.Path == "" {
+		return fmt.Sprintf("operation %d (%s)", o.Index, o.Op)
+	}
+	return fmt.Sprintf("operation %d (%s %s)", o.Index, o.Op, o.Path)
+}
+
+// JSONPatchOperationError is the error of a specific operation of a JSON patch
+type JSONPatchOperationError struct {
+	JSONPatchOperation
+	Err error
+}
+
+func (e *JSONPatchOperationError) Error() string {
+	return fmt.Sprintf("%s: %v", e.JSONPatchOperation, e.Err)
+}
+
+func (e *JSONPatchOperationError) Unwrap() error {
+	return e.Err
+}
+
+// GetJSONPatchAnnotationNoOps renders the operand CR that the jsonpatch annotation of the HyperConverged CR patches,
+// without the annotation, and applies the annotation to it. It returns the operations that do not modify the rendered
+// CR, or nil if the annotation is not set.
+func GetJSONPatchAnnotationNoOps(hc *hcov1beta1.HyperConverged, annotationName string) ([]JSONPatchOperation, error) {
+	annotation, found := hc.Annotations[annotationName]
+	if !found {
+		return nil, nil
+	}
+
+	render, ok := jsonPatchAnnotationRenderers[annotationName]
+	if !ok {
+		return nil, fmt.Errorf("unknown jsonpatch annotation %s", annotationName)
+	}
+
+	unpatched := hc.DeepCopy()
+	delete(unpatched.Annotations, annotationName)
+
+	obj, err := render(unpatched)
+	if err != nil {
+		return nil, err
+	}
+
+	patches, err := jsonpatch.DecodePatch([]byte(annotation))
+	if err != nil {
+		return nil, fmt.Errorf("invalid jsonPatch in the %s annotation: %w", annotationName, err)
+	}
+
+	noOps, err := applyJSONPatchOperations(obj, patches)
+	if err != nil {
+		return nil, fmt.Errorf("invalid jsonPatch in the %s annotation: %w", annotationName, err)
+	}
+
+	return noOps, nil
+}
@@ -8,7 +8,6 @@ import (
 	"maps"
 	"os"
 	"path"
-	"reflect"
 	"strconv"
 	"strings"
 
@@ -32,9 +31,11 @@ import (
 
 // env vars
 const (
-	kvmEmulationEnvName     = "KVM_EMULATION"
-	smbiosEnvName           = "SMBIOS"
-	machineTypeEnvName      = "MACHINETYPE"
+	kvmEmulationEnvName = "KVM_EMULATION"
+	smbiosEnvName       = "SMBIOS"
+	// MachineTypeEnvName is the legacy name of the amd64 machine type env var. It is deprecated, but if set, it
+	// overrides AMD64_MACHINETYPE.
+	MachineTypeEnvName      = "MACHINETYPE"
 	amd64MachineTypeEnvName = "AMD64_MACHINETYPE"
 	arm64MachineTypeEnvName = "ARM64_MACHINETYPE"
 )
@@ -246,34 +247,13 @@ func (h *kubevirtHooks) reset() {
 	h.cache = nil
 }
 
-func (*kubevirtHooks) updateCr(req *common.HcoRequest, Client client.Client, exists runtime.Object, required runtime.Object) (bool, bool, error) {
-	virt, ok1 := required.(*kubevirtcorev1.KubeVirt)
-	found, ok2 := exists.(*kubevirtcorev1.KubeVirt)
-	if !ok1 || !ok2 {
-		return false, false, errors.New("can't convert to KubeVirt")
-	}
+func (*kubevirtHooks) justBeforeComplete(_ *common.HcoRequest) { /* no implementation */ }
 
-	if !reflect.DeepEqual(found.Spec, virt.Spec) ||
-		!hcoutil.CompareLabels(virt, found) ||
-		!isAnnotationStateMeetingRequirements(virt.Annotations, found.Annotations) {
-		if req.HCOTriggered {
-			req.Logger.Info("Updating existing KubeVirt's Spec to new opinionated values")
-		} else {
-			req.Logger.Info("Reconciling an externally updated KubeVirt's Spec to its opinionated values")
-		}
-		hcoutil.MergeLabels(&virt.ObjectMeta, &found.ObjectMeta)
-		setAnnotationsToReqState(req.Instance, found)
-		virt.Spec.DeepCopyInto(&found.Spec)
-		err := Client.Update(req.Ctx, found)
-		if err != nil {
-			return false, false, err
-		}
-		return true, !req.HCOTriggered, nil
-	}
-	return false, false, nil
+func (*kubevirtHooks) getOperandName() string { return hcov1beta1.OperandKubeVirt }
+func (*kubevirtHooks) getObservedVersion(cr runtime.Object) string {
+	return cr.(*kubevirtcorev1.KubeVirt).Status.ObservedKubeVirtVersion
 }
-
-func (*kubevirtHooks) justBeforeComplete(_ *common.HcoRequest) { /* no implementation */ }
+func (*kubevirtHooks) getVersionEnvName() string { return hcoutil.KubevirtVersionEnvV }
 
 func NewKubeVirt(hc *hcov1beta1.HyperConverged, opts ...string) (*kubevirtcorev1.KubeVirt, error) {
 	config, err := getKVConfig(hc)
@@ -311,14 +291,11 @@ func NewKubeVirt(hc *hcov1beta1.HyperConverged, opts ...string) (*kubevirtcorev1
 		return nil, err
 	}
 
-	return kv, nil
-}
-
-func isAnnotationStateMeetingRequirements(requiredAnnotations, actualAnnotations map[string]string) bool {
-	_, isRequired := requiredAnnotations[kubevirtcorev1.EmulatorThreadCompleteToEvenParity]
-	_, exists := actualAnnotations[kubevirtcorev1.EmulatorThreadCompleteToEvenParity]
+	if err := applyOperandOverride(hc, hcov1beta1.OperandKubeVirt, kv); err != nil {
+		return nil, err
+	}
 
-	return isRequired == exists
+	return kv, nil
 }
 
 func setAnnotationsToReqState(hc *hcov1beta1.HyperConverged, kv *kubevirtcorev1.KubeVirt) {
@@ -401,7 +378,10 @@ func hcWorkloadUpdateStrategyToKv(hcObject *hcov1beta1.HyperConvergedWorkloadUpd
 			*kvObject.BatchEvictionSize = *hcObject.BatchEvictionSize
 		}
 
-		if size := len(hcObject.WorkloadUpdateMethods); size > 0 {
+		// an empty list means no automated workload updates; only a missing list falls back to the default method
+		if hcObject.WorkloadUpdateMethods == nil {
+			kvObject.WorkloadUpdateMethods = []kubevirtcorev1.WorkloadUpdateMethod{hcoutil.DefaultWorkloadUpdateMethod}
+		} else if size := len(hcObject.WorkloadUpdateMethods); size > 0 {
 			kvObject.WorkloadUpdateMethods = make([]kubevirtcorev1.WorkloadUpdateMethod, size)
 			for i, updateMethod := range hcObject.WorkloadUpdateMethods {
 				kvObject.WorkloadUpdateMethods[i] = kubevirtcorev1.WorkloadUpdateMethod(updateMethod)
@@ -464,7 +444,7 @@ func getKVConfig(hc *hcov1beta1.HyperConverged) (*kubevirtcorev1.KubeVirtConfigu
 	}
 
 	amd64MachineType := cmp.Or(
-		strings.TrimSpace(os.Getenv(machineTypeEnvName)),
+		strings.TrimSpace(os.Getenv(MachineTypeEnvName)),
 		strings.TrimSpace(os.Getenv(amd64MachineTypeEnvName)),
 	)
 
@@ -681,11 +661,16 @@ func hcLiveMigrationToKv(lm hcov1beta1.LiveMigrationConfigurations) (*kubevirtco
 		bandwidthPerMigration = &bandwidthPerMigrationObject
 	}
 
+	parallelMigrationsPerCluster := lm.ParallelMigrationsPerCluster
+	if parallelMigrationsPerCluster == nil {
+		parallelMigrationsPerCluster = ptr.To(hcoutil.DefaultParallelMigrationsPerCluster)
+	}
+
 	return &kubevirtcorev1.MigrationConfiguration{
 		BandwidthPerMigration:             bandwidthPerMigration,
 		CompletionTimeoutPerGiB:           lm.CompletionTimeoutPerGiB,
 		ParallelOutboundMigrationsPerNode: lm.ParallelOutboundMigrationsPerNode,
-		ParallelMigrationsPerCluster:      lm.ParallelMigrationsPerCluster,
+		ParallelMigrationsPerCluster:      parallelMigrationsPerCluster,
 		ProgressTimeout:                   lm.ProgressTimeout,
 		Network:                           lm.Network,
 		AllowAutoConverge:                 lm.AllowAutoConverge,
@@ -755,6 +740,7 @@ func getKVDevConfig(hc *hcov1beta1.HyperConverged) *kubevirtcorev1.DeveloperConf
 	if lv := hc.Spec.LogVerbosityConfig; lv != nil && lv.Kubevirt != nil {
 		devConf.LogVerbosity = lv.Kubevirt.DeepCopy()
 	}
+	devConf.CPUAllocationRatio = hcoutil.DefaultVMICPUAllocationRatio
 	if hc.Spec.ResourceRequirements != nil && hc.Spec.ResourceRequirements.VmiCPUAllocationRatio != nil {
 		devConf.CPUAllocationRatio = *hc.Spec.ResourceRequirements.VmiCPUAllocationRatio
 	}
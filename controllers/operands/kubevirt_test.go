@@ -188,7 +188,7 @@ Version: 1.2.3`)
 
 			DeferCleanup(func() {
 				os.Unsetenv(smbiosEnvName)
-				os.Unsetenv(machineTypeEnvName)
+				os.Unsetenv(MachineTypeEnvName)
 				os.Unsetenv(amd64MachineTypeEnvName)
 				os.Unsetenv(arm64MachineTypeEnvName)
 				os.Unsetenv(kvmEmulationEnvName)
@@ -477,7 +477,7 @@ Version: 1.2.3`)
 		})
 
 		It("should use legacy MACHINETYPE env if provided", func() {
-			os.Setenv(machineTypeEnvName, "legacy")
+			os.Setenv(MachineTypeEnvName, "legacy")
 			os.Setenv(amd64MachineTypeEnvName, "q35")
 			os.Unsetenv(arm64MachineTypeEnvName)
 
@@ -491,7 +491,7 @@ Version: 1.2.3`)
 		})
 
 		It("should not use legacy MACHINETYPE env if empty", func() {
-			os.Setenv(machineTypeEnvName, "")
+			os.Setenv(MachineTypeEnvName, "")
 			os.Setenv(amd64MachineTypeEnvName, "q35")
 			os.Unsetenv(arm64MachineTypeEnvName)
 
@@ -2990,6 +2990,29 @@ Version: 1.2.3`)
 				Expect(foundKv.Spec.WorkloadUpdateStrategy.BatchEvictionSize).To(HaveValue(Equal(modifiedBatchEvictionSize)))
 			})
 
+			It("should not set any workload update method if the list in the HCO CR is empty", func() {
+				existingKv, err := NewKubeVirt(hco)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(existingKv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods).To(ConsistOf(kubevirtcorev1.WorkloadUpdateMethodLiveMigrate))
+
+				hco.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []string{}
+
+				cl := commontestutils.InitClient([]client.Object{hco, existingKv})
+				handler := (*genericOperand)(newKubevirtHandler(cl, commontestutils.GetScheme()))
+				res := handler.ensure(req)
+				Expect(res.Updated).To(BeTrue())
+				Expect(res.Err).ToNot(HaveOccurred())
+
+				foundKv := &kubevirtcorev1.KubeVirt{}
+				Expect(
+					cl.Get(context.TODO(),
+						types.NamespacedName{Name: existingKv.Name, Namespace: existingKv.Namespace},
+						foundKv),
+				).ToNot(HaveOccurred())
+
+				Expect(foundKv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods).To(BeEmpty())
+			})
+
 			It("should overwrite Workload Update Strategy if directly set on KV CR", func() {
 				const (
 					hcoModifiedBatchEvictionSize = 5
@@ -3519,7 +3542,7 @@ Version: 1.2.3`)
 				Expect(foundResource.Spec.Configuration.DeveloperConfiguration.CPUAllocationRatio).To(Equal(expectedCPUAllocationRatio))
 			})
 
-			It("should remove CPUAllocationRatio if missing in HCO CR", func() {
+			It("should set CPUAllocationRatio to the default if missing in HCO CR", func() {
 				const initialCPUAllocationRatio = 16
 
 				hcoResourceRequirements := commontestutils.NewHco()
@@ -3549,7 +3572,7 @@ Version: 1.2.3`)
 				).ToNot(HaveOccurred())
 
 				Expect(foundResource.Spec.Configuration.DeveloperConfiguration).ToNot(BeNil())
-				Expect(foundResource.Spec.Configuration.DeveloperConfiguration.CPUAllocationRatio).To(Equal(0))
+				Expect(foundResource.Spec.Configuration.DeveloperConfiguration.CPUAllocationRatio).To(Equal(hcoutil.DefaultVMICPUAllocationRatio))
 			})
 
 			It("should modify CPUAllocationRatio according to HCO CR", func() {
@@ -4262,14 +4285,14 @@ Version: 1.2.3`)
 			Expect(mc.AllowPostCopy).To(HaveValue(BeTrue()))
 		})
 
-		It("should create valid empty KV LM config from a valid empty HC LM config", func() {
+		It("should create valid default KV LM config from a valid empty HC LM config", func() {
 			lmc := hcov1beta1.LiveMigrationConfigurations{}
 			mc, err := hcLiveMigrationToKv(lmc)
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(mc.BandwidthPerMigration).To(BeNil())
 			Expect(mc.CompletionTimeoutPerGiB).To(BeNil())
-			Expect(mc.ParallelMigrationsPerCluster).To(BeNil())
+			Expect(mc.ParallelMigrationsPerCluster).To(HaveValue(Equal(hcoutil.DefaultParallelMigrationsPerCluster)))
 			Expect(mc.ParallelOutboundMigrationsPerNode).To(BeNil())
 			Expect(mc.ProgressTimeout).To(BeNil())
 			Expect(mc.Network).To(BeNil())
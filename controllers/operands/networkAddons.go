@@ -86,6 +86,12 @@ func (h *cnaHooks) updateCr(req *common.HcoRequest, Client client.Client, exists
 
 func (*cnaHooks) justBeforeComplete(_ *common.HcoRequest) { /* no implementation */ }
 
+func (*cnaHooks) getOperandName() string { return hcov1beta1.OperandNetworkAddonsConfig }
+func (*cnaHooks) getObservedVersion(cr runtime.Object) string {
+	return cr.(*networkaddonsv1.NetworkAddonsConfig).Status.ObservedVersion
+}
+func (*cnaHooks) getVersionEnvName() string { return hcoutil.CnaoVersionEnvV }
+
 func (*cnaHooks) updateCnaCr(req *common.HcoRequest, Client client.Client, found *networkaddonsv1.NetworkAddonsConfig) (bool, bool, error) {
 	err := Client.Update(req.Ctx, found)
 	if err != nil {
@@ -182,6 +188,10 @@ func NewNetworkAddons(hc *hcov1beta1.HyperConverged, opts ...string) (*networkad
 		return nil, err
 	}
 
+	if err := applyOperandOverride(hc, hcov1beta1.OperandNetworkAddonsConfig, cna); err != nil {
+		return nil, err
+	}
+
 	return cna, nil
 }
 
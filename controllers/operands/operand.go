@@ -18,6 +18,7 @@ import (
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/metrics"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -46,12 +47,17 @@ type hcoResourceHooks interface {
 	// Generate an empty resource, to be used as the input of the client.Get method. After calling this method, it will
 	// contain the actual values in K8s.
 	getEmptyCr() client.Object
-	// check if there is a change between the required resource and the resource read from K8s, and update K8s accordingly.
-	updateCr(*common.HcoRequest, client.Client, runtime.Object, runtime.Object) (bool, bool, error)
 	// last hook before completing the operand handling
 	justBeforeComplete(req *common.HcoRequest)
 }
 
+// Set of hooks for resources that are updated by the handler itself. Resources with hooks that do not implement this
+// interface, are reconciled with server-side apply.
+type updateHooks interface {
+	// check if there is a change between the required resource and the resource read from K8s, and update K8s accordingly.
+	updateCr(*common.HcoRequest, client.Client, runtime.Object, runtime.Object) (bool, bool, error)
+}
+
 // Set of operand handler hooks, to be implement in each handler
 type hcoOperandHooks interface {
 	hcoResourceHooks
@@ -66,15 +72,24 @@ type reseter interface {
 	reset()
 }
 
-func (h *genericOperand) ensure(req *common.HcoRequest) *EnsureResult {
+func (h *genericOperand) ensure(req *common.HcoRequest) (res *EnsureResult) {
+	if csh, ok := h.hooks.(componentStatusHooks); ok {
+		defer func() {
+			h.setComponentReconcileError(req, csh, res.Err)
+		}()
+	}
+
 	cr, err := h.hooks.getFullCr(req.Instance)
+	if oh, ok := h.hooks.(overridableHooks); ok {
+		setOperandOverrideStatus(req, oh.getOperandName(), err)
+	}
 	if err != nil {
 		return &EnsureResult{
 			Err: err,
 		}
 	}
 
-	res := NewEnsureResult(cr)
+	res = NewEnsureResult(cr)
 
 	if err := h.doSetControllerReference(req, cr); err != nil {
 		return res.Error(err)
@@ -111,7 +126,7 @@ func (h *genericOperand) ensure(req *common.HcoRequest) *EnsureResult {
 func (h *genericOperand) handleExistingCr(req *common.HcoRequest, key client.ObjectKey, found client.Object, cr client.Object, res *EnsureResult) *EnsureResult {
 	req.Logger.Info(h.crType+" already exists", h.crType+".Namespace", key.Namespace, h.crType+".Name", key.Name)
 
-	updated, overwritten, err := h.hooks.updateCr(req, h.Client, found, cr)
+	updated, overwritten, overwrittenFields, err := h.updateCr(req, found, cr)
 	if err != nil {
 		return res.Error(err)
 	}
@@ -130,7 +145,7 @@ func (h *genericOperand) handleExistingCr(req *common.HcoRequest, key client.Obj
 
 	if updated {
 		req.StatusDirty = true
-		return res.SetUpdated().SetOverwritten(overwritten)
+		return res.SetUpdated().SetOverwritten(overwritten).SetOverwrittenFields(overwrittenFields)
 	}
 
 	if opr, ok := h.hooks.(hcoOperandHooks); ok { // for operands, perform some more checks
@@ -140,6 +155,16 @@ func (h *genericOperand) handleExistingCr(req *common.HcoRequest, key client.Obj
 	return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
 }
 
+func (h *genericOperand) updateCr(req *common.HcoRequest, found client.Object, cr client.Object) (bool, bool, []string, error) {
+	if uh, ok := h.hooks.(updateHooks); ok {
+		updated, overwritten, err := uh.updateCr(req, h.Client, found, cr)
+		return updated, overwritten, nil, err
+	}
+
+	updated, overwrittenFields, err := h.applyExistingCr(req, found, cr)
+	return updated, len(overwrittenFields) > 0, overwrittenFields, err
+}
+
 func (h *genericOperand) handleExistingCrSkipCache(req *common.HcoRequest, key client.ObjectKey, found client.Object, cr client.Object, res *EnsureResult) *EnsureResult {
 	cfg, configerr := config.GetConfig()
 	if configerr != nil {
@@ -176,9 +201,16 @@ func (h *genericOperand) handleExistingCrSkipCache(req *common.HcoRequest, key c
 
 func (h *genericOperand) completeEnsureOperands(req *common.HcoRequest, opr hcoOperandHooks, found client.Object, res *EnsureResult) *EnsureResult {
 	// Handle KubeVirt resource conditions
-	isReady := handleComponentConditions(req, h.crType, opr.getConditions(found))
+	conditions := opr.getConditions(found)
+	isReady := handleComponentConditions(req, h.crType, conditions)
+	if csh, ok := opr.(componentStatusHooks); ok {
+		h.setComponentConditions(req, csh, found, conditions)
+	}
 
 	versionUpdated := opr.checkComponentVersion(found)
+	if req.UpgradeMode {
+		metrics.SetUpgradeComponentCompleted(h.crType, versionUpdated)
+	}
 	if isReady && !versionUpdated {
 		req.Logger.Info(fmt.Sprintf("could not complete the upgrade process. %s is not with the expected version. Check %s observed version in the status field of its CR", h.crType, h.crType))
 	}
@@ -218,7 +250,7 @@ func (h *genericOperand) createNewCr(req *common.HcoRequest, cr client.Object, r
 	if cr.GetResourceVersion() != "" {
 		cr.SetResourceVersion("")
 	}
-	err := h.Client.Create(req.Ctx, cr)
+	err := h.Client.Create(req.Ctx, cr, client.FieldOwner(hcoutil.FieldManager))
 	if err != nil {
 		req.Logger.Error(err, "Failed to create object for "+h.crType)
 		return res.Error(err)
@@ -237,6 +269,7 @@ func (h *genericOperand) reset() {
 func handleComponentConditions(req *common.HcoRequest, component string, componentConds []metav1.Condition) bool {
 	if len(componentConds) == 0 {
 		getConditionsForNewCr(req, component)
+		metrics.SetComponentCondition(component, hcov1beta1.ConditionAvailable, false)
 		return false
 	}
 
@@ -249,6 +282,11 @@ func setConditionsByOperandConditions(req *common.HcoRequest, component string,
 	foundProgressingCond := false
 	foundDegradedCond := false
 	for _, condition := range componentConds {
+		switch condition.Type {
+		case hcov1beta1.ConditionAvailable, hcov1beta1.ConditionProgressing, hcov1beta1.ConditionDegraded, hcov1beta1.ConditionUpgradeable:
+			metrics.SetComponentCondition(component, condition.Type, condition.Status == metav1.ConditionTrue)
+		}
+
 		switch condition.Type {
 		case hcov1beta1.ConditionAvailable:
 			foundAvailableCond = true
@@ -269,6 +307,7 @@ func setConditionsByOperandConditions(req *common.HcoRequest, component string,
 
 	if !foundAvailableCond {
 		componentNotAvailable(req, component, `missing "Available" condition`)
+		metrics.SetComponentCondition(component, hcov1beta1.ConditionAvailable, false)
 	}
 
 	return isReady && foundAvailableCond && foundProgressingCond && foundDegradedCond
@@ -402,32 +441,54 @@ func applyAnnotationPatch(obj runtime.Object, annotation string) error {
 		return err
 	}
 
-	for _, patch := range patches {
-		path, err := patch.Path()
+	return applyJSONPatch(obj, patches)
+}
+
+func applyJSONPatch(obj runtime.Object, patches jsonpatch.Patch) error {
+	_, err := applyJSONPatchOperations(obj, patches)
+	return err
+}
+
+// applyJSONPatchOperations applies the operations of the patch to obj, one by one, and returns the operations that did
+// not modify it. If an operation fails, obj is not modified, and the error is a *JSONPatchOperationError.
+func applyJSONPatchOperations(obj runtime.Object, patches jsonpatch.Patch) ([]JSONPatchOperation, error) {
+	doc, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var noOps []JSONPatchOperation
+	for i, op := range patches {
+		operation := JSONPatchOperation{Index: i, Op: op.Kind()}
+
+		path, err := op.Path()
 		if err != nil {
-			return err
+			return nil, &JSONPatchOperationError{JSONPatchOperation: operation, Err: err}
 		}
+		operation.Path = path
 
 		if !strings.HasPrefix(path, "/spec/") {
-			return errors.New("can only modify spec fields")
+			return nil, &JSONPatchOperationError{JSONPatchOperation: operation, Err: errors.New("can only modify spec fields")}
 		}
-	}
 
-	specBytes, err := json.Marshal(obj)
-	if err != nil {
-		return err
-	}
-	patchedBytes, err := patches.Apply(specBytes)
-	if err != nil {
-		return err
+		patched, err := jsonpatch.Patch{op}.Apply(doc)
+		if err != nil {
+			return nil, &JSONPatchOperationError{JSONPatchOperation: operation, Err: err}
+		}
+
+		if operation.Op != "test" && jsonpatch.Equal(doc, patched) {
+			noOps = append(noOps, operation)
+		}
+		doc = patched
 	}
-	return json.Unmarshal(patchedBytes, obj)
+
+	return noOps, json.Unmarshal(doc, obj)
 }
 
 func applyPatchToSpec(hc *hcov1beta1.HyperConverged, annotationName string, obj runtime.Object) error {
 	if jsonpathAnnotation, ok := hc.Annotations[annotationName]; ok {
 		if err := applyAnnotationPatch(obj, jsonpathAnnotation); err != nil {
-			return fmt.Errorf("invalid jsonPatch in the %s annotation: %v", annotationName, err)
+			return fmt.Errorf("invalid jsonPatch in the %s annotation: %w", annotationName, err)
 		}
 	}
 
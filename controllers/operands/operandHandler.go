@@ -2,12 +2,16 @@ package operands
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	log "github.com/go-logr/logr"
 	"golang.org/x/sync/errgroup"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,6 +35,13 @@ const (
 	ErrHCOUninstall       = "ErrHCOUninstall"
 	uninstallHCOErrorMsg  = "The uninstall request failed on dependent components, please check their logs."
 	deleteTimeOut         = 30 * time.Second
+
+	// the maximal number of operands that are reconciled at the same time
+	defaultMaxConcurrentOperands = 4
+
+	// the reasons of the operand errors metric, that are not API error reasons
+	operandErrorReasonDependencyFailed = "DependencyFailed"
+	operandErrorReasonUnknown          = "Unknown"
 )
 
 // common constants
@@ -45,27 +56,48 @@ var (
 type OperandHandler struct {
 	client   client.Client
 	operands []Operand
+	// the operands that must be successfully reconciled, before reconciling an operand
+	dependencies map[Operand][]Operand
+	// the maximal number of operands that are reconciled at the same time
+	maxConcurrentOperands int
+	// the operand label of the metrics of each operand, once the name of the object it reconciles is known
+	metricNames map[Operand]string
 	// save for deletions
 	objects      []client.Object
 	eventEmitter hcoutil.EventEmitter
 }
 
 func NewOperandHandler(client client.Client, scheme *runtime.Scheme, ci hcoutil.ClusterInfo, eventEmitter hcoutil.EventEmitter) *OperandHandler {
+	kvPriorityClassHandler := (*genericOperand)(newKvPriorityClassHandler(client, scheme))
+	kvHandler := (*genericOperand)(newKubevirtHandler(client, scheme))
+
 	operands := []Operand{
-		(*genericOperand)(newKvPriorityClassHandler(client, scheme)),
-		(*genericOperand)(newKubevirtHandler(client, scheme)),
+		kvPriorityClassHandler,
+		kvHandler,
 		(*genericOperand)(newCdiHandler(client, scheme)),
 		(*genericOperand)(newCnaHandler(client, scheme)),
 		newAAQHandler(client, scheme),
+		newHppHandler(client, scheme),
+	}
+
+	dependencies := map[Operand][]Operand{
+		// virt-operator uses the priority class for the KubeVirt components
+		kvHandler: {kvPriorityClassHandler},
 	}
 
 	if ci.IsOpenshift() {
+		cliDownloadsServiceHandler := (*genericOperand)(newServiceHandler(client, scheme, NewCliDownloadsService))
+		cliDownloadsRouteHandler := (*genericOperand)(newCliDownloadsRouteHandler(client, scheme))
+
 		operands = append(operands, []Operand{
 			(*genericOperand)(newSspHandler(client, scheme)),
 			(*genericOperand)(newCliDownloadHandler(client, scheme)),
-			(*genericOperand)(newCliDownloadsRouteHandler(client, scheme)),
-			(*genericOperand)(newServiceHandler(client, scheme, NewCliDownloadsService)),
+			cliDownloadsRouteHandler,
+			cliDownloadsServiceHandler,
 		}...)
+
+		// the route exposes the service
+		dependencies[cliDownloadsRouteHandler] = []Operand{cliDownloadsServiceHandler}
 	}
 
 	if ci.IsOpenshift() && ci.IsConsolePluginImageProvided() {
@@ -79,9 +111,12 @@ func NewOperandHandler(client client.Client, scheme *runtime.Scheme, ci hcoutil.
 	}
 
 	return &OperandHandler{
-		client:       client,
-		operands:     operands,
-		eventEmitter: eventEmitter,
+		client:                client,
+		operands:              operands,
+		dependencies:          dependencies,
+		maxConcurrentOperands: defaultMaxConcurrentOperands,
+		metricNames:           make(map[Operand]string),
+		eventEmitter:          eventEmitter,
 	}
 }
 
@@ -92,19 +127,69 @@ func (h *OperandHandler) FirstUseInitiation(scheme *runtime.Scheme, ci hcoutil.C
 	h.objects = make([]client.Object, 0)
 	if ci.IsOpenshift() {
 		h.addOperands(scheme, hc, getQuickStartHandlers)
-		h.addOperands(scheme, hc, getDashboardHandlers)
-		h.addOperands(scheme, hc, getImageStreamHandlers)
-		h.addOperands(scheme, hc, newVirtioWinCmHandler)
-		h.addOperands(scheme, hc, newVirtioWinCmReaderRoleHandler)
-		h.addOperands(scheme, hc, newVirtioWinCmReaderRoleBindingHandler)
+	}
+
+	for _, fuh := range getFirstUseHandlers(ci) {
+		h.addOperands(scheme, hc, fuh.get)
+	}
+}
+
+// firstUseHandler is a handler getter of FirstUseInitiation
+type firstUseHandler struct {
+	// the kind of the objects, for reporting the objects that can't be rendered
+	kind string
+	get  GetHandler
+	// the directory of the manifest files the objects are read from, if any. If the directory is missing, the getter
+	// returns no handlers.
+	manifestDir func() string
+}
+
+// getFirstUseHandlers returns the handler getters of FirstUseInitiation that do not need to access the cluster
+func getFirstUseHandlers(ci hcoutil.ClusterInfo) []firstUseHandler {
+	var getHandlers []firstUseHandler
+	if ci.IsOpenshift() {
+		getHandlers = append(getHandlers,
+			firstUseHandler{kind: "dashboard ConfigMap", get: getDashboardHandlers, manifestDir: getDashboardFileLocation},
+			firstUseHandler{kind: "ImageStream", get: getImageStreamHandlers, manifestDir: getImageStreamFileLocation},
+			firstUseHandler{kind: "virtio-win ConfigMap", get: newVirtioWinCmHandler},
+			firstUseHandler{kind: "virtio-win Role", get: newVirtioWinCmReaderRoleHandler},
+			firstUseHandler{kind: "virtio-win RoleBinding", get: newVirtioWinCmReaderRoleBindingHandler},
+		)
 	}
 
 	if ci.IsOpenshift() && ci.IsConsolePluginImageProvided() {
-		h.addOperands(scheme, hc, newKvUIPluginDeploymentHandler)
-		h.addOperands(scheme, hc, newKvUIProxyDeploymentHandler)
-		h.addOperands(scheme, hc, newKvUINginxCMHandler)
-		h.addOperands(scheme, hc, newKvUIPluginCRHandler)
+		getHandlers = append(getHandlers,
+			firstUseHandler{kind: "console plugin Deployment", get: newKvUIPluginDeploymentHandler},
+			firstUseHandler{kind: "console proxy Deployment", get: newKvUIProxyDeploymentHandler},
+			firstUseHandler{kind: "console nginx ConfigMap", get: newKvUINginxCMHandler},
+			firstUseHandler{kind: "ConsolePlugin", get: newKvUIPluginCRHandler},
+		)
 	}
+
+	return getHandlers
+}
+
+// GetUpgradeComponents returns the names of the components that must reach the target version of an upgrade, i.e.
+// the operand CRs that HCO deploys for the given HyperConverged CR
+func (h *OperandHandler) GetUpgradeComponents(hc *hcov1beta1.HyperConverged) []string {
+	var components []string
+	for _, operand := range h.operands {
+		var op *genericOperand
+		switch o := operand.(type) {
+		case *genericOperand:
+			op = o
+		case *conditionalHandler:
+			op = o.operand
+		default:
+			continue
+		}
+
+		if _, ok := op.hooks.(hcoOperandHooks); ok && isDeployed(operand, hc) {
+			components = append(components, op.crType)
+		}
+	}
+
+	return components
 }
 
 func (h *OperandHandler) GetQuickStartNames() []string {
@@ -143,42 +228,220 @@ func (h *OperandHandler) addOperands(scheme *runtime.Scheme, hc *hcov1beta1.Hype
 	}
 }
 
+// Ensure reconciles all the operands. Operands are reconciled concurrently, but an operand is only reconciled after
+// the operands it depends on were successfully reconciled. A failure of an operand does not stop the reconciliation of
+// the operands that do not depend on it; all the failures are reported in the ReconcileComplete condition.
 func (h *OperandHandler) Ensure(req *common.HcoRequest) error {
-	for _, handler := range h.operands {
-		res := handler.ensure(req)
+	runs := h.ensureOperands(req)
+
+	var errs []error
+	for _, run := range runs {
+		if run.err != nil {
+			req.Logger.Error(run.err, "skipped an operand")
+			metrics.IncOperandErrors(run.metricName, operandErrorReasonDependencyFailed)
+			errs = append(errs, run.err)
+			continue
+		}
+
+		metrics.ObserveOperandEnsure(run.metricName, run.duration)
+
+		res := run.res
 		if res.Err != nil {
 			req.Logger.Error(res.Err, "failed to ensure an operand")
-
-			req.ComponentUpgradeInProgress = false
-			req.Conditions.SetStatusCondition(metav1.Condition{
-				Type:               hcov1beta1.ConditionReconcileComplete,
-				Status:             metav1.ConditionFalse,
-				Reason:             reconcileFailed,
-				Message:            fmt.Sprintf("Error while reconciling: %v", res.Err),
-				ObservedGeneration: req.Instance.Generation,
-			})
-			return res.Err
+			metrics.IncOperandErrors(run.metricName, getOperandErrorReason(res.Err))
+			errs = append(errs, res.Err)
+			continue
 		}
 
 		if res.Created {
 			h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Created", fmt.Sprintf("Created %s %s", res.Type, res.Name))
+			metrics.IncOperandOperations(run.metricName, metrics.OperandOperationCreate)
 		} else if res.Updated {
 			h.handleUpdatedOperand(req, res)
+			metrics.IncOperandOperations(run.metricName, metrics.OperandOperationUpdate)
 		} else if res.Deleted {
 			h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Killing", fmt.Sprintf("Removed %s %s", res.Type, res.Name))
+			metrics.IncOperandOperations(run.metricName, metrics.OperandOperationDelete)
 		}
 
 		req.ComponentUpgradeInProgress = req.ComponentUpgradeInProgress && res.UpgradeDone
 	}
-	return nil
 
+	if len(errs) == 0 {
+		return nil
+	}
+
+	msgs := make([]string, 0, len(errs))
+	for _, err := range errs {
+		msgs = append(msgs, err.Error())
+	}
+
+	req.ComponentUpgradeInProgress = false
+	req.Conditions.SetStatusCondition(metav1.Condition{
+		Type:               hcov1beta1.ConditionReconcileComplete,
+		Status:             metav1.ConditionFalse,
+		Reason:             reconcileFailed,
+		Message:            fmt.Sprintf("Error while reconciling: %s", strings.Join(msgs, "; ")),
+		ObservedGeneration: req.Instance.Generation,
+	})
+
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return errors.Join(errs...)
+}
+
+// operandRun is the reconciliation of a single operand, with its own fork of the request
+type operandRun struct {
+	req *common.HcoRequest
+	res *EnsureResult
+	// the type of the resource the operand reconciles, for the error messages
+	operandType string
+	// the operand label of the metrics
+	metricName string
+	// the duration of the reconciliation; it's not set if the operand was not reconciled
+	duration time.Duration
+	// set if the operand was not reconciled, because one of its dependencies failed
+	err error
+}
+
+func (run *operandRun) failed() bool {
+	return run.err != nil || run.res.Err != nil
+}
+
+// ensureOperands reconciles the operands concurrently, and merges the changes they made in their forks of the request,
+// in the order of the operands.
+func (h *OperandHandler) ensureOperands(req *common.HcoRequest) []*operandRun {
+	base := newRequestBase(req)
+
+	runs := make(map[Operand]*operandRun, len(h.operands))
+	done := make(map[Operand]chan struct{}, len(h.operands))
+	for _, operand := range h.operands {
+		runs[operand] = &operandRun{req: forkRequest(req), operandType: getOperandType(operand), metricName: h.getMetricName(operand, req.Instance)}
+		done[operand] = make(chan struct{})
+	}
+
+	sem := make(chan struct{}, max(h.maxConcurrentOperands, 1))
+	wg := sync.WaitGroup{}
+	for _, operand := range h.operands {
+		wg.Add(1)
+		go func(operand Operand, run *operandRun) {
+			defer wg.Done()
+			defer close(done[operand])
+
+			for _, dependency := range h.dependencies[operand] {
+				depDone, ok := done[dependency]
+				if !ok {
+					continue
+				}
+				<-depDone
+
+				if runs[dependency].failed() {
+					run.err = fmt.Errorf("%s was not reconciled, because %s failed", getOperandType(operand), getOperandType(dependency))
+					return
+				}
+			}
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			start := time.Now()
+			run.res = h.ensureOperand(run.req, operand)
+			run.duration = time.Since(start)
+		}(operand, runs[operand])
+	}
+	wg.Wait()
+
+	ordered := make([]*operandRun, 0, len(h.operands))
+	for _, operand := range h.operands {
+		run := runs[operand]
+		if err := mergeRequest(req, base, run.req); err != nil && run.err == nil {
+			run.err = fmt.Errorf("failed to merge the changes of %s: %w", run.operandType, err)
+		}
+		ordered = append(ordered, run)
+	}
+
+	return ordered
+}
+
+// getMetricName returns the operand label of the metrics of an operand. Several operands may reconcile resources of
+// the same type, e.g. ConfigMaps, so the label is the type of the resource, followed by its name. The label is kept once
+// the name is known, so the series of an operand does not change if its object can't be rendered later.
+func (h *OperandHandler) getMetricName(operand Operand, hc *hcov1beta1.HyperConverged) string {
+	if name, ok := h.metricNames[operand]; ok {
+		return name
+	}
+
+	operandType := getOperandType(operand)
+	objName := getOperandObjectName(operand, hc)
+	if objName == "" {
+		return operandType
+	}
+
+	name := operandType + "/" + objName
+	h.metricNames[operand] = name
+	return name
+}
+
+// getOperandObjectName returns the name of the object an operand reconciles, or an empty string if it's not known
+func getOperandObjectName(operand Operand, hc *hcov1beta1.HyperConverged) string {
+	var (
+		obj client.Object
+		err error
+	)
+
+	switch op := operand.(type) {
+	case *genericOperand:
+		obj, err = op.hooks.getFullCr(hc)
+	case *conditionalHandler:
+		obj = op.getCRWithName(hc)
+	case *imageStreamOperand:
+		obj, err = op.operand.hooks.getFullCr(hc)
+	}
+
+	if err != nil || obj == nil {
+		return ""
+	}
+	return obj.GetName()
+}
+
+// getOperandType returns the type of the resource an operand reconciles, for error messages
+func getOperandType(operand Operand) string {
+	switch op := operand.(type) {
+	case *genericOperand:
+		return op.crType
+	case *conditionalHandler:
+		return op.operand.crType
+	case *imageStreamOperand:
+		return op.operand.crType
+	default:
+		return fmt.Sprintf("%T", operand)
+	}
+}
+
+// getOperandErrorReason returns the reason of the failure of an operand, for the metrics. It's the reason of the API
+// error if there is one.
+func getOperandErrorReason(err error) string {
+	if reason := apierrors.ReasonForError(err); reason != metav1.StatusReasonUnknown {
+		return string(reason)
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return string(metav1.StatusReasonTimeout)
+	}
+
+	return operandErrorReasonUnknown
 }
 
 func (h *OperandHandler) handleUpdatedOperand(req *common.HcoRequest, res *EnsureResult) {
 	if !res.Overwritten {
 		h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Updated", fmt.Sprintf("Updated %s %s", res.Type, res.Name))
 	} else {
-		h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, "Overwritten", fmt.Sprintf("Overwritten %s %s", res.Type, res.Name))
+		msg := fmt.Sprintf("Overwritten %s %s", res.Type, res.Name)
+		if len(res.OverwrittenFields) > 0 {
+			msg = fmt.Sprintf("%s; fields modified by another manager: %s", msg, strings.Join(res.OverwrittenFields, ", "))
+		}
+		h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, "Overwritten", msg)
 		if !req.UpgradeMode {
 			metrics.IncOverwrittenModifications(res.Type, res.Name)
 		}
@@ -197,6 +460,7 @@ func (h *OperandHandler) EnsureDeleted(req *common.HcoRequest) error {
 		NewSSPWithNameOnly(req.Instance),
 		NewConsoleCLIDownload(req.Instance),
 		NewAAQWithNameOnly(req.Instance),
+		NewHostPathProvisionerWithNameOnly(req.Instance),
 	}
 
 	resources = append(resources, h.objects...)
@@ -10,13 +10,17 @@ import (
 	. "github.com/onsi/gomega"
 	consolev1 "github.com/openshift/api/console/v1"
 	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/metrics"
 	kubevirtcorev1 "kubevirt.io/api/core/v1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
@@ -135,10 +139,10 @@ var _ = Describe("Test operandHandler", func() {
 
 		It("should handle errors on ensure loop", func() {
 			hco := commontestutils.NewHco()
-			cli := commontestutils.InitClient([]client.Object{hcoNamespace, qsCrd, hco})
+			ci := commontestutils.ClusterInfoMock{}
+			cli := commontestutils.InitClient([]client.Object{hcoNamespace, qsCrd, hco, ci.GetCSV()})
 
 			eventEmitter := commontestutils.NewEventEmitterMock()
-			ci := commontestutils.ClusterInfoMock{}
 
 			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
@@ -173,6 +177,206 @@ var _ = Describe("Test operandHandler", func() {
 			})
 		})
 
+		It("should keep reconciling the operands that do not depend on a failed operand", func() {
+			hco := commontestutils.NewHco()
+			ci := commontestutils.ClusterInfoMock{}
+			cli := commontestutils.InitClient([]client.Object{hcoNamespace, qsCrd, hco, ci.GetCSV()})
+
+			eventEmitter := commontestutils.NewEventEmitterMock()
+
+			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
+
+			req := commontestutils.NewReq(hco)
+
+			priorityClassError := fmt.Errorf("fake create PriorityClass error")
+			cdiError := fmt.Errorf("fake create CDI error")
+			cli.InitiateCreateErrors(func(obj client.Object) error {
+				switch obj.(type) {
+				case *schedulingv1.PriorityClass:
+					return priorityClassError
+				case *cdiv1beta1.CDI:
+					return cdiError
+				}
+				return nil
+			})
+
+			err := handler.Ensure(req)
+			Expect(err).To(MatchError(priorityClassError))
+			Expect(err).To(MatchError(cdiError))
+			Expect(err).To(MatchError(ContainSubstring("KubeVirt was not reconciled, because KubeVirtPriorityClass failed")))
+
+			Expect(req.ComponentUpgradeInProgress).To(BeFalse())
+			cond := req.Conditions[hcov1beta1.ConditionReconcileComplete]
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(reconcileFailed))
+			Expect(cond.Message).To(Equal("Error while reconciling: fake create PriorityClass error; " +
+				"KubeVirt was not reconciled, because KubeVirtPriorityClass failed; fake create CDI error"))
+
+			By("make sure the KubeVirt CR was not created, because the PriorityClass is missing")
+			kvList := kubevirtcorev1.KubeVirtList{}
+			Expect(cli.List(req.Ctx, &kvList)).To(Succeed())
+			Expect(kvList.Items).To(BeEmpty())
+
+			By("make sure the independent operands were reconciled")
+			cna := NewNetworkAddonsWithNameOnly(hco)
+			Expect(cli.Get(req.Ctx, client.ObjectKeyFromObject(cna), cna)).To(Succeed())
+			Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{
+				{
+					EventType: corev1.EventTypeNormal,
+					Reason:    "Created",
+					Msg:       "Created NetworkAddonsConfig cluster",
+				},
+			})).To(BeTrue())
+
+		})
+
+		It("should report the operand metrics", func() {
+			hco := commontestutils.NewHco()
+			ci := commontestutils.ClusterInfoMock{}
+			cli := commontestutils.InitClient([]client.Object{hcoNamespace, qsCrd, hco, ci.GetCSV()})
+
+			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, commontestutils.NewEventEmitterMock())
+			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
+
+			req := commontestutils.NewReq(hco)
+
+			cli.InitiateCreateErrors(func(obj client.Object) error {
+				if _, ok := obj.(*schedulingv1.PriorityClass); ok {
+					return apierrors.NewForbidden(schedulingv1.Resource("priorityclasses"), kvPriorityClass, fmt.Errorf("fake error"))
+				}
+				return nil
+			})
+
+			ensureCountBefore, err := metrics.GetOperandEnsureCount("NetworkAddonsConfig/cluster")
+			Expect(err).ToNot(HaveOccurred())
+			createdBefore, err := metrics.GetOperandOperationsCount("NetworkAddonsConfig/cluster", metrics.OperandOperationCreate)
+			Expect(err).ToNot(HaveOccurred())
+			forbiddenBefore, err := metrics.GetOperandErrorsCount("KubeVirtPriorityClass/"+kvPriorityClass, string(metav1.StatusReasonForbidden))
+			Expect(err).ToNot(HaveOccurred())
+			skippedBefore, err := metrics.GetOperandErrorsCount("KubeVirt/kubevirt-kubevirt-hyperconverged", operandErrorReasonDependencyFailed)
+			Expect(err).ToNot(HaveOccurred())
+			kvEnsureCountBefore, err := metrics.GetOperandEnsureCount("KubeVirt/kubevirt-kubevirt-hyperconverged")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(handler.Ensure(req)).ToNot(Succeed())
+
+			Expect(metrics.GetOperandEnsureCount("NetworkAddonsConfig/cluster")).To(Equal(ensureCountBefore + 1))
+			Expect(metrics.GetOperandOperationsCount("NetworkAddonsConfig/cluster", metrics.OperandOperationCreate)).To(Equal(createdBefore + 1))
+			Expect(metrics.GetOperandErrorsCount("KubeVirtPriorityClass/"+kvPriorityClass, string(metav1.StatusReasonForbidden))).To(Equal(forbiddenBefore + 1))
+			Expect(metrics.GetOperandErrorsCount("KubeVirt/kubevirt-kubevirt-hyperconverged", operandErrorReasonDependencyFailed)).To(Equal(skippedBefore + 1))
+
+			By("make sure the duration of a skipped operand is not recorded")
+			Expect(metrics.GetOperandEnsureCount("KubeVirt/kubevirt-kubevirt-hyperconverged")).To(Equal(kvEnsureCountBefore))
+
+			By("make sure an operand that was not modified is not counted as updated")
+			cli.InitiateCreateErrors(nil)
+			updatedBefore, err := metrics.GetOperandOperationsCount("NetworkAddonsConfig/cluster", metrics.OperandOperationUpdate)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(handler.Ensure(commontestutils.NewReq(hco))).To(Succeed())
+
+			Expect(metrics.GetOperandEnsureCount("NetworkAddonsConfig/cluster")).To(Equal(ensureCountBefore + 2))
+			Expect(metrics.GetOperandOperationsCount("NetworkAddonsConfig/cluster", metrics.OperandOperationCreate)).To(Equal(createdBefore + 1))
+			Expect(metrics.GetOperandOperationsCount("NetworkAddonsConfig/cluster", metrics.OperandOperationUpdate)).To(Equal(updatedBefore))
+		})
+
+		It("should report the metrics of operands of the same type in separate series", func() {
+			hco := commontestutils.NewHco()
+			ci := commontestutils.ClusterInfoMock{}
+			cli := commontestutils.InitClient([]client.Object{hcoNamespace, hco})
+
+			newCm := func(name string) *corev1.ConfigMap {
+				return &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: hco.Namespace,
+					},
+					Data: map[string]string{"key": name},
+				}
+			}
+
+			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, commontestutils.NewEventEmitterMock())
+			handler.operands = []Operand{
+				newCmHandler(cli, commontestutils.GetScheme(), newCm("first-cm")),
+				newCmHandler(cli, commontestutils.GetScheme(), newCm("second-cm")),
+			}
+
+			firstBefore, err := metrics.GetOperandOperationsCount("ConfigMap/first-cm", metrics.OperandOperationCreate)
+			Expect(err).ToNot(HaveOccurred())
+			secondBefore, err := metrics.GetOperandOperationsCount("ConfigMap/second-cm", metrics.OperandOperationCreate)
+			Expect(err).ToNot(HaveOccurred())
+			sharedBefore, err := metrics.GetOperandEnsureCount("ConfigMap")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(handler.Ensure(commontestutils.NewReq(hco))).To(Succeed())
+
+			Expect(metrics.GetOperandOperationsCount("ConfigMap/first-cm", metrics.OperandOperationCreate)).To(Equal(firstBefore + 1))
+			Expect(metrics.GetOperandOperationsCount("ConfigMap/second-cm", metrics.OperandOperationCreate)).To(Equal(secondBefore + 1))
+			Expect(metrics.GetOperandEnsureCount("ConfigMap")).To(Equal(sharedBefore))
+		})
+
+		It("should keep the metrics label of an operand, if its object can't be rendered", func() {
+			hco := commontestutils.NewHco()
+			ci := commontestutils.ClusterInfoMock{}
+			cli := commontestutils.InitClient([]client.Object{hcoNamespace, hco})
+
+			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, commontestutils.NewEventEmitterMock())
+			cnaHandler := (*genericOperand)(newCnaHandler(cli, commontestutils.GetScheme()))
+			Expect(handler.getMetricName(cnaHandler, hco)).To(Equal("NetworkAddonsConfig/cluster"))
+
+			hco.Spec.OperandOverrides = &hcov1beta1.OperandOverrides{
+				NetworkAddonsConfig: &hcov1beta1.OperandOverride{
+					Patches: []hcov1beta1.OperandPatch{
+						{Op: "remove", Path: "/spec/notExistingField"},
+					},
+				},
+			}
+			cnaHandler.reset()
+			Expect(getOperandObjectName(cnaHandler, hco)).To(BeEmpty())
+			Expect(handler.getMetricName(cnaHandler, hco)).To(Equal("NetworkAddonsConfig/cluster"))
+		})
+
+		It("should return the components of the upgrade", func() {
+			hco := commontestutils.NewHco()
+			ci := commontestutils.ClusterInfoMock{}
+			handler := NewOperandHandler(commontestutils.InitClient(nil), commontestutils.GetScheme(), ci, nil)
+
+			Expect(handler.GetUpgradeComponents(hco)).To(ConsistOf("KubeVirt", "CDI", "NetworkAddonsConfig", "SSP"))
+
+			hco.Spec.FeatureGates.EnableApplicationAwareQuota = ptr.To(true)
+			Expect(handler.GetUpgradeComponents(hco)).To(ConsistOf("KubeVirt", "CDI", "NetworkAddonsConfig", "SSP", "AAQ"))
+		})
+
+		It("should reconcile an operand after its dependencies", func() {
+			hco := commontestutils.NewHco()
+			ci := commontestutils.ClusterInfoMock{}
+			cli := commontestutils.InitClient([]client.Object{hcoNamespace, qsCrd, hco, ci.GetCSV()})
+
+			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, commontestutils.NewEventEmitterMock())
+			handler.maxConcurrentOperands = len(handler.operands)
+
+			req := commontestutils.NewReq(hco)
+
+			cli.InitiateCreateErrors(func(obj client.Object) error {
+				if kv, ok := obj.(*kubevirtcorev1.KubeVirt); ok {
+					pc := &schedulingv1.PriorityClass{}
+					if err := cli.Get(context.TODO(), client.ObjectKey{Name: kvPriorityClass}, pc); err != nil {
+						return fmt.Errorf("%s was created before the PriorityClass: %w", kv.Name, err)
+					}
+				}
+				return nil
+			})
+
+			for range 10 {
+				Expect(handler.Ensure(req)).To(Succeed())
+				kv := NewKubeVirtWithNameOnly(hco)
+				Expect(cli.Delete(context.TODO(), kv)).To(Succeed())
+				pc := &schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: kvPriorityClass}}
+				Expect(cli.Delete(context.TODO(), pc)).To(Succeed())
+			}
+		})
+
 		It("make sure the all objects are deleted", func() {
 			hco := commontestutils.NewHco()
 			ci := commontestutils.ClusterInfoMock{}
@@ -261,6 +465,41 @@ var _ = Describe("Test operandHandler", func() {
 			})
 		})
 
+		It("make sure the HostPathProvisioner CR is deleted", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.FeatureGates.EnableHostPathProvisioner = ptr.To(true)
+			hco.Spec.HostPathProvisioner = &hcov1beta1.HostPathProvisionerConfig{
+				StoragePools: []hcov1beta1.HostPathStoragePool{{Name: "local", Path: "/var/hpvolumes"}},
+			}
+			ci := commontestutils.ClusterInfoMock{}
+			cli := commontestutils.InitClient([]client.Object{hcoNamespace, qsCrd, hco, ci.GetCSV()})
+
+			eventEmitter := commontestutils.NewEventEmitterMock()
+
+			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
+
+			req := commontestutils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(Succeed())
+
+			hpp := NewHostPathProvisionerWithNameOnly(hco)
+			Expect(cli.Get(req.Ctx, client.ObjectKeyFromObject(hpp), hpp)).To(Succeed())
+
+			eventEmitter.Reset()
+			Expect(handler.EnsureDeleted(req)).To(Succeed())
+
+			Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{
+				{
+					EventType: corev1.EventTypeNormal,
+					Reason:    "Killing",
+					Msg:       "Removed HostPathProvisioner hostpath-provisioner-kubevirt-hyperconverged",
+				},
+			})).To(BeTrue())
+
+			err := cli.Get(req.Ctx, client.ObjectKeyFromObject(hpp), NewEmptyHostPathProvisioner())
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
 		It("delete KV error handling", func() {
 			hco := commontestutils.NewHco()
 			ci := commontestutils.ClusterInfoMock{}
@@ -0,0 +1,238 @@
+package operands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+// OperandOverrideNames is the list of the operands that can be overridden using the spec.operandOverrides field
+var OperandOverrideNames = []string{
+	hcov1beta1.OperandKubeVirt,
+	hcov1beta1.OperandCDI,
+	hcov1beta1.OperandNetworkAddonsConfig,
+	hcov1beta1.OperandSSP,
+	hcov1beta1.OperandAAQ,
+	hcov1beta1.OperandHostPathProvisioner,
+}
+
+// render the operand CR that an operand override patches
+var operandOverrideRenderers = map[string]func(hc *hcov1beta1.HyperConverged) (runtime.Object, error){
+	hcov1beta1.OperandKubeVirt: func(hc *hcov1beta1.HyperConverged) (runtime.Object, error) {
+		return NewKubeVirt(hc)
+	},
+	hcov1beta1.OperandCDI: func(hc *hcov1beta1.HyperConverged) (runtime.Object, error) {
+		return NewCDI(hc)
+	},
+	hcov1beta1.OperandNetworkAddonsConfig: func(hc *hcov1beta1.HyperConverged) (runtime.Object, error) {
+		return NewNetworkAddons(hc)
+	},
+	hcov1beta1.OperandSSP: func(hc *hcov1beta1.HyperConverged) (runtime.Object, error) {
+		ssp, _, err := NewSSP(hc)
+		return ssp, err
+	},
+	hcov1beta1.OperandAAQ: func(hc *hcov1beta1.HyperConverged) (runtime.Object, error) {
+		return NewAAQ(hc)
+	},
+	hcov1beta1.OperandHostPathProvisioner: func(hc *hcov1beta1.HyperConverged) (runtime.Object, error) {
+		return NewHostPathProvisioner(hc)
+	},
+}
+
+// OperandOverrideError is returned when the spec.operandOverrides of an operand can't be applied on its CR
+type OperandOverrideError struct {
+	Operand string
+	Err     error
+}
+
+func (e *OperandOverrideError) Error() string {
+	return fmt.Sprintf("failed to apply spec.operandOverrides.%s: %v", e.Operand, e.Err)
+}
+
+func (e *OperandOverrideError) Unwrap() error {
+	return e.Err
+}
+
+// operand hooks of operands that support the spec.operandOverrides field
+type overridableHooks interface {
+	// the operand name, as used in the spec.operandOverrides field
+	getOperandName() string
+}
+
+// GetOperandOverride returns the override of a specific operand, or nil if not set
+func GetOperandOverride(hc *hcov1beta1.HyperConverged, operand string) *hcov1beta1.OperandOverride {
+	overrides := hc.Spec.OperandOverrides
+	if overrides == nil {
+		return nil
+	}
+
+	switch operand {
+	case hcov1beta1.OperandKubeVirt:
+		return overrides.KubeVirt
+	case hcov1beta1.OperandCDI:
+		return overrides.CDI
+	case hcov1beta1.OperandNetworkAddonsConfig:
+		return overrides.NetworkAddonsConfig
+	case hcov1beta1.OperandSSP:
+		return overrides.SSP
+	case hcov1beta1.OperandAAQ:
+		return overrides.AAQ
+	case hcov1beta1.OperandHostPathProvisioner:
+		return overrides.HostPathProvisioner
+	}
+
+	return nil
+}
+
+// OperandOverrideToJSONPatch converts a typed operand override to a JSON patch
+func OperandOverrideToJSONPatch(override *hcov1beta1.OperandOverride) (jsonpatch.Patch, error) {
+	patchBytes, err := json.Marshal(override.Patches)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonpatch.DecodePatch(patchBytes)
+}
+
+func applyOperandOverride(hc *hcov1beta1.HyperConverged, operand string, obj runtime.Object) error {
+	override := GetOperandOverride(hc, operand)
+	if override == nil {
+		return nil
+	}
+
+	patches, err := OperandOverrideToJSONPatch(override)
+	if err == nil {
+		err = applyJSONPatch(obj, patches)
+	}
+
+	if err != nil {
+		return &OperandOverrideError{Operand: operand, Err: err}
+	}
+
+	return nil
+}
+
+// GetOperandOverrideChangedPaths renders the operand CR without its override, and applies the override to it. It
+// returns the paths that the override actually changes in the rendered CR, or nil if the override is not set.
+func GetOperandOverrideChangedPaths(hc *hcov1beta1.HyperConverged, operand string) ([]string, error) {
+	override := GetOperandOverride(hc, operand)
+	if override == nil {
+		return nil, nil
+	}
+
+	render, ok := operandOverrideRenderers[operand]
+	if !ok {
+		return nil, fmt.Errorf("unknown operand %s", operand)
+	}
+
+	unpatched := hc.DeepCopy()
+	unpatched.Spec.OperandOverrides = nil
+
+	obj, err := render(unpatched)
+	if err != nil {
+		return nil, err
+	}
+
+	patches, err := OperandOverrideToJSONPatch(override)
+	if err != nil {
+		return nil, &OperandOverrideError{Operand: operand, Err: err}
+	}
+
+	noOps, err := applyJSONPatchOperations(obj, patches)
+	if err != nil {
+		return nil, &OperandOverrideError{Operand: operand, Err: err}
+	}
+
+	var changedPaths []string
+	addPath := func(path string) {
+		if !slices.Contains(changedPaths, path) {
+			changedPaths = append(changedPaths, path)
+		}
+	}
+
+	for i, op := range patches {
+		if op.Kind() == "test" || slices.ContainsFunc(noOps, func(noOp JSONPatchOperation) bool { return noOp.Index == i }) {
+			continue
+		}
+
+		if op.Kind() == "move" {
+			if from, err := op.From(); err == nil {
+				addPath(from)
+			}
+		}
+
+		if path, err := op.Path(); err == nil {
+			addPath(path)
+		}
+	}
+
+	return changedPaths, nil
+}
+
+// setOperandOverrideStatus updates the status.operandOverrides entry of the operand, according to the result of
+// rendering the operand CR. If the operand CR could not be rendered for any other reason than its override, the
+// result of the override is unknown, and the status is kept as is.
+func setOperandOverrideStatus(req *common.HcoRequest, operand string, renderErr error) {
+	override := GetOperandOverride(req.Instance, operand)
+	if override == nil {
+		removeOperandOverrideStatus(req, operand)
+		return
+	}
+
+	var overrideErr *OperandOverrideError
+	if renderErr != nil && !errors.As(renderErr, &overrideErr) {
+		return
+	}
+
+	newStatus := hcov1beta1.OperandOverrideStatus{
+		Operand:    operand,
+		Applied:    overrideErr == nil,
+		Operations: int32(len(override.Patches)),
+	}
+
+	if overrideErr != nil {
+		newStatus.Message = overrideErr.Error()
+	} else {
+		changedPaths, err := GetOperandOverrideChangedPaths(req.Instance, operand)
+		if err != nil {
+			req.Logger.Error(err, "failed to compute the paths changed by the operand override", "operand", operand)
+		}
+		newStatus.ChangedPaths = changedPaths
+	}
+
+	statuses := req.Instance.Status.OperandOverrides
+	for i, st := range statuses {
+		if st.Operand == operand {
+			if !reflect.DeepEqual(st, newStatus) {
+				statuses[i] = newStatus
+				req.StatusDirty = true
+			}
+			return
+		}
+	}
+
+	req.Instance.Status.OperandOverrides = append(statuses, newStatus)
+	req.StatusDirty = true
+}
+
+func removeOperandOverrideStatus(req *common.HcoRequest, operand string) {
+	statuses := req.Instance.Status.OperandOverrides
+	for i, st := range statuses {
+		if st.Operand == operand {
+			req.Instance.Status.OperandOverrides = append(statuses[:i], statuses[i+1:]...)
+			if len(req.Instance.Status.OperandOverrides) == 0 {
+				req.Instance.Status.OperandOverrides = nil
+			}
+			req.StatusDirty = true
+			return
+		}
+	}
+}
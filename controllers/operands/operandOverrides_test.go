@@ -0,0 +1,228 @@
+package operands
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("Operand Overrides", func() {
+	var (
+		hco *hcov1beta1.HyperConverged
+		req *common.HcoRequest
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		req = commontestutils.NewReq(hco)
+	})
+
+	Context("GetOperandOverride", func() {
+		It("should return nil if spec.operandOverrides is not set", func() {
+			for _, operand := range OperandOverrideNames {
+				Expect(GetOperandOverride(hco, operand)).To(BeNil())
+			}
+		})
+
+		It("should return the override of the requested operand", func() {
+			kvOverride := &hcov1beta1.OperandOverride{}
+			aaqOverride := &hcov1beta1.OperandOverride{}
+			hco.Spec.OperandOverrides = &hcov1beta1.OperandOverrides{
+				KubeVirt: kvOverride,
+				AAQ:      aaqOverride,
+			}
+
+			Expect(GetOperandOverride(hco, hcov1beta1.OperandKubeVirt)).To(BeIdenticalTo(kvOverride))
+			Expect(GetOperandOverride(hco, hcov1beta1.OperandAAQ)).To(BeIdenticalTo(aaqOverride))
+			Expect(GetOperandOverride(hco, hcov1beta1.OperandCDI)).To(BeNil())
+			Expect(GetOperandOverride(hco, "unknown")).To(BeNil())
+		})
+	})
+
+	Context("render operands", func() {
+		It("should apply the override after the jsonpatch annotation", func() {
+			hco.Annotations = map[string]string{
+				common.JSONPatchSSPAnnotationName: `[{"op": "replace", "path": "/spec/templateValidator/replicas", "value": 5}]`,
+			}
+			hco.Spec.OperandOverrides = &hcov1beta1.OperandOverrides{
+				SSP: &hcov1beta1.OperandOverride{
+					Patches: []hcov1beta1.OperandPatch{
+						{Op: "test", Path: "/spec/templateValidator/replicas", Value: &apiextensionsv1.JSON{Raw: []byte(`5`)}},
+						{Op: "replace", Path: "/spec/templateValidator/replicas", Value: &apiextensionsv1.JSON{Raw: []byte(`3`)}},
+					},
+				},
+			}
+
+			ssp, _, err := NewSSP(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ssp.Spec.TemplateValidator.Replicas).To(HaveValue(Equal(int32(3))))
+		})
+
+		It("should apply the AAQ override", func() {
+			hco.Spec.OperandOverrides = &hcov1beta1.OperandOverrides{
+				AAQ: &hcov1beta1.OperandOverride{
+					Patches: []hcov1beta1.OperandPatch{
+						{Op: "replace", Path: "/spec/imagePullPolicy", Value: &apiextensionsv1.JSON{Raw: []byte(`"Always"`)}},
+					},
+				},
+			}
+
+			aaq, err := NewAAQ(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(aaq.Spec.ImagePullPolicy).To(BeEquivalentTo("Always"))
+		})
+
+		It("should fail if the override can't be applied", func() {
+			hco.Spec.OperandOverrides = &hcov1beta1.OperandOverrides{
+				CDI: &hcov1beta1.OperandOverride{
+					Patches: []hcov1beta1.OperandPatch{
+						{Op: "remove", Path: "/spec/notExistingField"},
+					},
+				},
+			}
+
+			_, err := NewCDI(hco)
+			Expect(err).To(MatchError(ContainSubstring("failed to apply spec.operandOverrides.cdi")))
+
+			var overrideErr *OperandOverrideError
+			Expect(errors.As(err, &overrideErr)).To(BeTrue())
+			Expect(overrideErr.Operand).To(Equal(hcov1beta1.OperandCDI))
+		})
+	})
+
+	Context("status", func() {
+		It("should set the status of an applied override", func() {
+			hco.Spec.OperandOverrides = &hcov1beta1.OperandOverrides{
+				KubeVirt: &hcov1beta1.OperandOverride{
+					Patches: []hcov1beta1.OperandPatch{
+						{Op: "add", Path: "/spec/configuration/cpuRequest", Value: &apiextensionsv1.JSON{Raw: []byte(`"12m"`)}},
+					},
+				},
+			}
+
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := (*genericOperand)(newKubevirtHandler(cl, commontestutils.GetScheme()))
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(hco.Status.OperandOverrides).To(ConsistOf(hcov1beta1.OperandOverrideStatus{
+				Operand:      hcov1beta1.OperandKubeVirt,
+				Applied:      true,
+				Operations:   1,
+				ChangedPaths: []string{"/spec/configuration/cpuRequest"},
+			}))
+		})
+
+		It("should only report the paths that the override actually changed", func() {
+			hco.Spec.OperandOverrides = &hcov1beta1.OperandOverrides{
+				SSP: &hcov1beta1.OperandOverride{
+					Patches: []hcov1beta1.OperandPatch{
+						{Op: "test", Path: "/spec/templateValidator/replicas", Value: &apiextensionsv1.JSON{Raw: []byte(`2`)}},
+						{Op: "replace", Path: "/spec/templateValidator/replicas", Value: &apiextensionsv1.JSON{Raw: []byte(`2`)}},
+						{Op: "add", Path: "/spec/commonTemplates/namespace", Value: &apiextensionsv1.JSON{Raw: []byte(`"custom-ns"`)}},
+					},
+				},
+			}
+
+			Expect(GetOperandOverrideChangedPaths(hco, hcov1beta1.OperandSSP)).To(Equal([]string{"/spec/commonTemplates/namespace"}))
+		})
+
+		It("should not report the override as failing, if the CR can't be rendered for another reason", func() {
+			hco.Annotations = map[string]string{
+				common.JSONPatchKVAnnotationName: `[{"op": "remove", "path": "/spec/notExistingField"}]`,
+			}
+			hco.Spec.OperandOverrides = &hcov1beta1.OperandOverrides{
+				KubeVirt: &hcov1beta1.OperandOverride{
+					Patches: []hcov1beta1.OperandPatch{
+						{Op: "add", Path: "/spec/configuration/cpuRequest", Value: &apiextensionsv1.JSON{Raw: []byte(`"12m"`)}},
+					},
+				},
+			}
+			origStatus := hcov1beta1.OperandOverrideStatus{
+				Operand:      hcov1beta1.OperandKubeVirt,
+				Applied:      true,
+				Operations:   1,
+				ChangedPaths: []string{"/spec/configuration/cpuRequest"},
+			}
+			hco.Status.OperandOverrides = []hcov1beta1.OperandOverrideStatus{origStatus}
+
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := (*genericOperand)(newKubevirtHandler(cl, commontestutils.GetScheme()))
+			res := handler.ensure(req)
+			Expect(res.Err).To(HaveOccurred())
+
+			Expect(hco.Status.OperandOverrides).To(ConsistOf(origStatus))
+		})
+
+		It("should set the status of a failing override", func() {
+			hco.Spec.OperandOverrides = &hcov1beta1.OperandOverrides{
+				NetworkAddonsConfig: &hcov1beta1.OperandOverride{
+					Patches: []hcov1beta1.OperandPatch{
+						{Op: "remove", Path: "/spec/notExistingField"},
+					},
+				},
+			}
+
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := (*genericOperand)(newCnaHandler(cl, commontestutils.GetScheme()))
+			res := handler.ensure(req)
+			Expect(res.Err).To(HaveOccurred())
+
+			Expect(hco.Status.OperandOverrides).To(HaveLen(1))
+			Expect(hco.Status.OperandOverrides[0].Operand).To(Equal(hcov1beta1.OperandNetworkAddonsConfig))
+			Expect(hco.Status.OperandOverrides[0].Applied).To(BeFalse())
+			Expect(hco.Status.OperandOverrides[0].ChangedPaths).To(BeEmpty())
+			Expect(hco.Status.OperandOverrides[0].Message).To(ContainSubstring("failed to apply spec.operandOverrides.networkAddonsConfig"))
+		})
+
+		It("should remove the status when the override is removed", func() {
+			hco.Status.OperandOverrides = []hcov1beta1.OperandOverrideStatus{
+				{Operand: hcov1beta1.OperandCDI, Applied: true, Operations: 1},
+				{Operand: hcov1beta1.OperandKubeVirt, Applied: true, Operations: 1},
+			}
+
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := (*genericOperand)(newCdiHandler(cl, commontestutils.GetScheme()))
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(hco.Status.OperandOverrides).To(ConsistOf(hcov1beta1.OperandOverrideStatus{
+				Operand:    hcov1beta1.OperandKubeVirt,
+				Applied:    true,
+				Operations: 1,
+			}))
+		})
+
+		It("should remove the AAQ status when AAQ is not deployed", func() {
+			hco.Spec.FeatureGates.EnableApplicationAwareQuota = ptr.To(false)
+			hco.Spec.OperandOverrides = &hcov1beta1.OperandOverrides{
+				AAQ: &hcov1beta1.OperandOverride{
+					Patches: []hcov1beta1.OperandPatch{
+						{Op: "replace", Path: "/spec/imagePullPolicy", Value: &apiextensionsv1.JSON{Raw: []byte(`"Always"`)}},
+					},
+				},
+			}
+			hco.Status.OperandOverrides = []hcov1beta1.OperandOverrideStatus{
+				{Operand: hcov1beta1.OperandAAQ, Applied: true, Operations: 1},
+			}
+
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := newAAQHandler(cl, commontestutils.GetScheme())
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+
+			Expect(hco.Status.OperandOverrides).To(BeEmpty())
+		})
+	})
+})
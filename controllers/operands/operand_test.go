@@ -2,8 +2,11 @@ package operands
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	jsonpatch "github.com/evanphx/json-patch/v5"
+
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -11,6 +14,7 @@ import (
 	"k8s.io/client-go/tools/reference"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -94,6 +98,86 @@ var _ = Describe("Test operator.go", func() {
 		})
 	})
 
+	Context("Test applyJSONPatchOperations", func() {
+		It("Should return the index and the path of the failed operation", func() {
+			obj := &cdiv1beta1.CDI{}
+
+			patches, err := jsonpatch.DecodePatch([]byte(`[
+				{"op": "add", "path": "/spec/config", "value": {}},
+				{"op": "remove", "path": "/spec/config/filesystemOverhead"}
+			]`))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = applyJSONPatchOperations(obj, patches)
+
+			var opErr *JSONPatchOperationError
+			Expect(errors.As(err, &opErr)).To(BeTrue())
+			Expect(opErr.JSONPatchOperation).To(Equal(JSONPatchOperation{Index: 1, Op: "remove", Path: "/spec/config/filesystemOverhead"}))
+			Expect(err.Error()).To(HavePrefix("operation 1 (remove /spec/config/filesystemOverhead): "))
+			Expect(obj.Spec.Config).To(BeNil())
+		})
+
+		It("Should reject the paths out of the spec", func() {
+			patches, err := jsonpatch.DecodePatch([]byte(`[{"op": "add", "path": "/metadata/labels", "value": {}}]`))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = applyJSONPatchOperations(&cdiv1beta1.CDI{}, patches)
+			Expect(err).To(MatchError("operation 0 (add /metadata/labels): can only modify spec fields"))
+		})
+
+		It("Should return the operations that do not modify the object", func() {
+			obj := &cdiv1beta1.CDI{
+				Spec: cdiv1beta1.CDISpec{
+					Config: &cdiv1beta1.CDIConfigSpec{
+						FilesystemOverhead: &cdiv1beta1.FilesystemOverhead{Global: "55"},
+					},
+				},
+			}
+
+			patches, err := jsonpatch.DecodePatch([]byte(`[
+				{"op": "replace", "path": "/spec/config/filesystemOverhead/global", "value": "55"},
+				{"op": "test", "path": "/spec/config/filesystemOverhead/global", "value": "55"},
+				{"op": "add", "path": "/spec/config/featureGates", "value": ["fg1"]}
+			]`))
+			Expect(err).ToNot(HaveOccurred())
+
+			noOps, err := applyJSONPatchOperations(obj, patches)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(noOps).To(Equal([]JSONPatchOperation{{Index: 0, Op: "replace", Path: "/spec/config/filesystemOverhead/global"}}))
+			Expect(obj.Spec.Config.FeatureGates).To(Equal([]string{"fg1"}))
+		})
+	})
+
+	Context("Test GetJSONPatchAnnotationNoOps", func() {
+		It("Should return nil if the annotation is not set", func() {
+			Expect(GetJSONPatchAnnotationNoOps(commontestutils.NewHco(), common.JSONPatchKVAnnotationName)).To(BeNil())
+		})
+
+		It("Should return the operations that do not modify the rendered operand", func() {
+			hco := commontestutils.NewHco()
+			hco.Annotations = map[string]string{
+				common.JSONPatchKVAnnotationName: `[
+					{"op": "add", "path": "/spec/configuration/developerConfiguration/featureGates/-", "value": "fg1"},
+					{"op": "replace", "path": "/spec/workloadUpdateStrategy/workloadUpdateMethods", "value": ["LiveMigrate"]}
+				]`,
+			}
+
+			Expect(GetJSONPatchAnnotationNoOps(hco, common.JSONPatchKVAnnotationName)).To(Equal([]JSONPatchOperation{
+				{Index: 1, Op: "replace", Path: "/spec/workloadUpdateStrategy/workloadUpdateMethods"},
+			}))
+		})
+
+		It("Should return the failed operation", func() {
+			hco := commontestutils.NewHco()
+			hco.Annotations = map[string]string{
+				common.JSONPatchCDIAnnotationName: `[{"op": "remove", "path": "/spec/config/unknownField"}]`,
+			}
+
+			_, err := GetJSONPatchAnnotationNoOps(hco, common.JSONPatchCDIAnnotationName)
+			Expect(err).To(MatchError(ContainSubstring("invalid jsonPatch in the containerizeddataimporter.kubevirt.io/jsonpatch annotation: operation 0 (remove /spec/config/unknownField): ")))
+		})
+	})
+
 	Context("Test addCrToTheRelatedObjectList", func() {
 		It("Should return error when apiVersion, kind and name missing", func() {
 			hco := commontestutils.NewHco()
@@ -0,0 +1,185 @@
+package operands
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+// OperandKinds maps the kind of each operand CR, to the operand name, as used in the spec.reconcilePolicy and in the
+// spec.operandOverrides fields.
+var OperandKinds = map[string]string{
+	"KubeVirt":            hcov1beta1.OperandKubeVirt,
+	"CDI":                 hcov1beta1.OperandCDI,
+	"NetworkAddonsConfig": hcov1beta1.OperandNetworkAddonsConfig,
+	"SSP":                 hcov1beta1.OperandSSP,
+	"AAQ":                 hcov1beta1.OperandAAQ,
+	"HostPathProvisioner": hcov1beta1.OperandHostPathProvisioner,
+}
+
+// controllingFields maps the spec fields of the operand CRs, to the HyperConverged field that HCO renders them from.
+// A field that is not listed, is controlled by its closest listed parent. Fields with no listed parent can only be
+// modified using the spec.operandOverrides field.
+var controllingFields = map[string]map[string]string{
+	hcov1beta1.OperandKubeVirt: {
+		"spec.infra":                     "spec.infra",
+		"spec.workloads":                 "spec.workloads",
+		"spec.certificateRotateStrategy": "spec.certConfig",
+		"spec.workloadUpdateStrategy":    "spec.workloadUpdateStrategy",
+		"spec.uninstallStrategy":         "spec.uninstallStrategy",
+		"spec.configuration.developerConfiguration.featureGates":       "spec.featureGates",
+		"spec.configuration.developerConfiguration.memoryOvercommit":   "spec.higherWorkloadDensity",
+		"spec.configuration.developerConfiguration.logVerbosity":       "spec.logVerbosityConfig",
+		"spec.configuration.developerConfiguration.cpuAllocationRatio": "spec.resourceRequirements",
+		"spec.configuration.autoCPULimitNamespaceLabelSelector":        "spec.resourceRequirements",
+		"spec.configuration.migrations":                                "spec.liveMigrationConfig",
+		"spec.configuration.permittedHostDevices":                      "spec.permittedHostDevices",
+		"spec.configuration.mediatedDevicesConfiguration":              "spec.mediatedDevicesConfiguration",
+		"spec.configuration.obsoleteCPUModels":                         "spec.obsoleteCPUs",
+		"spec.configuration.minCPUModel":                               "spec.obsoleteCPUs",
+		"spec.configuration.cpuModel":                                  "spec.defaultCPUModel",
+		"spec.configuration.tlsConfiguration":                          "spec.tlsSecurityProfile",
+		"spec.configuration.evictionStrategy":                          "spec.evictionStrategy",
+		"spec.configuration.ksmConfiguration":                          "spec.ksmConfiguration",
+		"spec.configuration.defaultRuntimeClass":                       "spec.defaultRuntimeClass",
+		"spec.configuration.vmStateStorageClass":                       "spec.vmStateStorageClass",
+		"spec.configuration.virtualMachineOptions":                     "spec.virtualMachineOptions",
+		"spec.configuration.network.binding":                           "spec.networkBinding",
+		"spec.configuration.apiConfiguration":                          "spec.tuningPolicy",
+		"spec.configuration.controllerConfiguration":                   "spec.tuningPolicy",
+		"spec.configuration.handlerConfiguration":                      "spec.tuningPolicy",
+		"spec.configuration.webhookConfiguration":                      "spec.tuningPolicy",
+	},
+	hcov1beta1.OperandCDI: {
+		"spec.infra":                           "spec.infra",
+		"spec.workload":                        "spec.workloads",
+		"spec.certConfig":                      "spec.certConfig",
+		"spec.uninstallStrategy":               "spec.uninstallStrategy",
+		"spec.config.scratchSpaceStorageClass": "spec.scratchSpaceStorageClass",
+		"spec.config.filesystemOverhead":       "spec.filesystemOverhead",
+		"spec.config.podResourceRequirements":  "spec.resourceRequirements.storageWorkloads",
+		"spec.config.insecureRegistries":       "spec.storageImport",
+		"spec.config.tlsSecurityProfile":       "spec.tlsSecurityProfile",
+		"spec.config.logVerbosity":             "spec.logVerbosityConfig",
+	},
+	hcov1beta1.OperandNetworkAddonsConfig: {
+		"spec.placementConfiguration.infra":     "spec.infra",
+		"spec.placementConfiguration.workloads": "spec.workloads",
+		"spec.selfSignConfiguration":            "spec.certConfig",
+		"spec.tlsSecurityProfile":               "spec.tlsSecurityProfile",
+		"spec.kubeSecondaryDNS":                 "spec.featureGates.deployKubeSecondaryDNS",
+		"spec.kubevirtIpamController":           "spec.featureGates.deployKubevirtIpamController",
+	},
+	hcov1beta1.OperandSSP: {
+		"spec.templateValidator.placement":             "spec.infra",
+		"spec.commonTemplates.namespace":               "spec.commonTemplatesNamespace",
+		"spec.commonTemplates.dataImportCronTemplates": "spec.dataImportCronTemplates",
+		"spec.tlsSecurityProfile":                      "spec.tlsSecurityProfile",
+		"spec.featureGates.deployVmConsoleProxy":       "spec.featureGates.deployVmConsoleProxy",
+	},
+	hcov1beta1.OperandAAQ: {
+		"spec.infra":             "spec.infra",
+		"spec.workload":          "spec.workloads",
+		"spec.certConfig":        "spec.certConfig",
+		"spec.namespaceSelector": "spec.applicationAwareConfig.namespaceSelector",
+		"spec.configuration":     "spec.applicationAwareConfig",
+	},
+	hcov1beta1.OperandHostPathProvisioner: {
+		"spec.storagePools":    "spec.hostPathProvisioner.storagePools",
+		"spec.workload":        "spec.workloads",
+		"spec.imagePullPolicy": "spec.hostPathProvisioner",
+	},
+}
+
+// OutOfBandChange is a modification of a field in the spec of an operand CR, that HCO reverts on its next
+// reconciliation
+type OutOfBandChange struct {
+	// Field is the path of the modified field in the operand CR
+	Field string
+	// ControlledBy is the path of the HyperConverged field that controls the modified field
+	ControlledBy string
+}
+
+// GetOutOfBandChanges returns the modifications between two versions of an operand CR, that HCO reverts. HCO replaces
+// the whole spec of the NetworkAddonsConfig CR, so any modification of it is reverted. The other operand CRs are
+// applied with server-side apply, so only the modifications of the fields that HCO owns are reverted.
+func GetOutOfBandChanges(operand string, oldObj, newObj *unstructured.Unstructured) ([]OutOfBandChange, error) {
+	fields, ok := controllingFields[operand]
+	if !ok {
+		return nil, nil
+	}
+
+	isReverted := func(fieldPath) bool { return true }
+	if operand != hcov1beta1.OperandNetworkAddonsConfig {
+		own, legacy, _, err := getManagedFieldSets(oldObj)
+		if err != nil {
+			return nil, err
+		}
+		if own != nil {
+			legacy = legacy.Union(own)
+		}
+		isReverted = func(p fieldPath) bool {
+			return touches(legacy, p)
+		}
+	}
+
+	oldSpec, _ := getFieldValue(oldObj.Object, fieldPath{"spec"})
+	newSpec, _ := getFieldValue(newObj.Object, fieldPath{"spec"})
+
+	var changes []OutOfBandChange
+	for _, p := range changedFieldPaths(fieldPath{"spec"}, oldSpec, newSpec) {
+		if !isReverted(p) {
+			continue
+		}
+		changes = append(changes, OutOfBandChange{
+			Field:        strings.Join(p, "."),
+			ControlledBy: getControllingField(operand, fields, p),
+		})
+	}
+
+	return changes, nil
+}
+
+func getControllingField(operand string, fields map[string]string, p fieldPath) string {
+	for i := len(p); i > 0; i-- {
+		if hcField, ok := fields[strings.Join(p[:i], ".")]; ok {
+			return hcField
+		}
+	}
+	return "spec.operandOverrides." + operand
+}
+
+// changedFieldPaths returns the paths of the fields that were modified, added or removed. An added or removed object
+// is returned as a whole. As in the managed fields, lists are atomic, so a path never goes into a list item.
+func changedFieldPaths(parent fieldPath, oldValue, newValue any) []fieldPath {
+	if reflect.DeepEqual(oldValue, newValue) {
+		return nil
+	}
+
+	oldMap, oldIsMap := oldValue.(map[string]any)
+	newMap, newIsMap := newValue.(map[string]any)
+	if !oldIsMap || !newIsMap {
+		return []fieldPath{parent}
+	}
+
+	var keys []string
+	for key := range oldMap {
+		keys = append(keys, key)
+	}
+	for key := range newMap {
+		if _, ok := oldMap[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	var paths []fieldPath
+	for _, key := range keys {
+		paths = append(paths, changedFieldPaths(append(slices.Clone(parent), key), oldMap[key], newMap[key])...)
+	}
+	return paths
+}
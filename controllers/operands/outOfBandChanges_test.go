@@ -0,0 +1,126 @@
+package operands
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("GetOutOfBandChanges", func() {
+	const hcoOwnedFields = `{"f:spec":{"f:configuration":{"f:migrations":{"f:parallelMigrationsPerCluster":{}},"f:developerConfiguration":{"f:featureGates":{}},"f:smbios":{"f:family":{}}}}}`
+
+	newObj := func(spec map[string]any, managers ...metav1.ManagedFieldsEntry) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+		obj.SetManagedFields(managers)
+		return obj
+	}
+
+	hcoEntry := func(operation metav1.ManagedFieldsOperationType) metav1.ManagedFieldsEntry {
+		return metav1.ManagedFieldsEntry{
+			Manager:   hcoutil.FieldManager,
+			Operation: operation,
+			FieldsV1:  &metav1.FieldsV1{Raw: []byte(hcoOwnedFields)},
+		}
+	}
+
+	kvSpec := func(parallelMigrations int64, featureGates []any, family string) map[string]any {
+		return map[string]any{
+			"configuration": map[string]any{
+				"migrations":             map[string]any{"parallelMigrationsPerCluster": parallelMigrations},
+				"developerConfiguration": map[string]any{"featureGates": featureGates},
+				"smbios":                 map[string]any{"family": family},
+			},
+		}
+	}
+
+	It("should return nothing if the spec was not modified", func() {
+		oldObj := newObj(kvSpec(5, []any{"a"}, "KubeVirt"), hcoEntry(metav1.ManagedFieldsOperationApply))
+		newObj := newObj(kvSpec(5, []any{"a"}, "KubeVirt"))
+
+		Expect(GetOutOfBandChanges(hcov1beta1.OperandKubeVirt, oldObj, newObj)).To(BeEmpty())
+	})
+
+	DescribeTable("should return the modified fields that HCO owns, with their HyperConverged field",
+		func(operation metav1.ManagedFieldsOperationType) {
+			oldObj := newObj(kvSpec(5, []any{"a"}, "KubeVirt"), hcoEntry(operation))
+			newObj := newObj(kvSpec(10, []any{"a", "b"}, "other"))
+
+			Expect(GetOutOfBandChanges(hcov1beta1.OperandKubeVirt, oldObj, newObj)).To(Equal([]OutOfBandChange{
+				{Field: "spec.configuration.developerConfiguration.featureGates", ControlledBy: "spec.featureGates"},
+				{Field: "spec.configuration.migrations.parallelMigrationsPerCluster", ControlledBy: "spec.liveMigrationConfig"},
+				{Field: "spec.configuration.smbios.family", ControlledBy: "spec.operandOverrides.kubevirt"},
+			}))
+		},
+		Entry("applied fields", metav1.ManagedFieldsOperationApply),
+		Entry("fields set with a full update", metav1.ManagedFieldsOperationUpdate),
+	)
+
+	It("should ignore the fields that HCO does not own", func() {
+		oldObj := newObj(kvSpec(5, []any{"a"}, "KubeVirt"), hcoEntry(metav1.ManagedFieldsOperationApply))
+		newSpec := kvSpec(5, []any{"a"}, "KubeVirt")
+		newSpec["imagePullPolicy"] = "Always"
+		newSpec["configuration"].(map[string]any)["migrations"].(map[string]any)["bandwidthPerMigration"] = "64Mi"
+
+		Expect(GetOutOfBandChanges(hcov1beta1.OperandKubeVirt, oldObj, newObj(newSpec))).To(BeEmpty())
+	})
+
+	It("should return the removed objects", func() {
+		oldObj := newObj(kvSpec(5, []any{"a"}, "KubeVirt"), hcoEntry(metav1.ManagedFieldsOperationApply))
+		newSpec := kvSpec(5, []any{"a"}, "KubeVirt")
+		delete(newSpec["configuration"].(map[string]any), "migrations")
+
+		Expect(GetOutOfBandChanges(hcov1beta1.OperandKubeVirt, oldObj, newObj(newSpec))).To(Equal([]OutOfBandChange{
+			{Field: "spec.configuration.migrations", ControlledBy: "spec.liveMigrationConfig"},
+		}))
+	})
+
+	It("should return any modified field of the NetworkAddonsConfig CR", func() {
+		oldObj := newObj(map[string]any{
+			"selfSignConfiguration": map[string]any{"caRotateInterval": "48h"},
+			"multus":                map[string]any{},
+		})
+		newObj := newObj(map[string]any{
+			"selfSignConfiguration": map[string]any{"caRotateInterval": "24h"},
+			"multus":                map[string]any{},
+			"macvtap":               map[string]any{},
+		})
+
+		Expect(GetOutOfBandChanges(hcov1beta1.OperandNetworkAddonsConfig, oldObj, newObj)).To(Equal([]OutOfBandChange{
+			{Field: "spec.macvtap", ControlledBy: "spec.operandOverrides.networkAddonsConfig"},
+			{Field: "spec.selfSignConfiguration.caRotateInterval", ControlledBy: "spec.certConfig"},
+		}))
+	})
+
+	It("should return the modified fields of the HostPathProvisioner CR, with their HyperConverged field", func() {
+		hppEntry := metav1.ManagedFieldsEntry{
+			Manager:   hcoutil.FieldManager,
+			Operation: metav1.ManagedFieldsOperationApply,
+			FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:imagePullPolicy":{},"f:storagePools":{},"f:workload":{"f:nodeSelector":{}}}}`)},
+		}
+		oldObj := newObj(map[string]any{
+			"imagePullPolicy": "IfNotPresent",
+			"storagePools":    []any{map[string]any{"name": "local", "path": "/var/hpp"}},
+			"workload":        map[string]any{"nodeSelector": map[string]any{"a": "b"}},
+		}, hppEntry)
+		newObj := newObj(map[string]any{
+			"imagePullPolicy": "Always",
+			"storagePools":    []any{map[string]any{"name": "local", "path": "/var/other"}},
+			"workload":        map[string]any{"nodeSelector": map[string]any{"a": "c"}},
+		})
+
+		Expect(GetOutOfBandChanges(hcov1beta1.OperandHostPathProvisioner, oldObj, newObj)).To(Equal([]OutOfBandChange{
+			{Field: "spec.imagePullPolicy", ControlledBy: "spec.hostPathProvisioner"},
+			{Field: "spec.storagePools", ControlledBy: "spec.hostPathProvisioner.storagePools"},
+			{Field: "spec.workload.nodeSelector.a", ControlledBy: "spec.workloads"},
+		}))
+	})
+
+	It("should return nothing for an unknown operand", func() {
+		Expect(GetOutOfBandChanges("unknown", newObj(nil), newObj(map[string]any{"a": "b"}))).To(BeEmpty())
+	})
+})
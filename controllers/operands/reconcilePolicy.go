@@ -0,0 +1,143 @@
+package operands
+
+import (
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+// unmanageableOperand is an operand that HCO can stop reconciling, according to the spec.reconcilePolicy field.
+// When not reconciled, HCO only reads the operand CR, to aggregate its conditions.
+type unmanageableOperand interface {
+	Operand
+	// the name of the operand, as used in the spec.reconcilePolicy.operands field; empty if not an operand CR
+	getOperandName() string
+	// read the operand CR and process its conditions, without modifying it
+	observe(req *common.HcoRequest) *EnsureResult
+}
+
+// IsReconcilePaused returns true if the reconciliation of all the operands is paused
+func IsReconcilePaused(hc *hcov1beta1.HyperConverged) bool {
+	return hc.Spec.ReconcilePolicy != nil && hc.Spec.ReconcilePolicy.Paused
+}
+
+// IsOperandUnmanaged returns true if HCO should not reconcile the operand, either because of a global pause, or
+// because the operand is Unmanaged
+func IsOperandUnmanaged(hc *hcov1beta1.HyperConverged, operand string) bool {
+	if IsReconcilePaused(hc) {
+		return true
+	}
+
+	if hc.Spec.ReconcilePolicy == nil || hc.Spec.ReconcilePolicy.Operands == nil {
+		return false
+	}
+
+	states := hc.Spec.ReconcilePolicy.Operands
+	var state hcov1beta1.ManagementState
+	switch operand {
+	case hcov1beta1.OperandKubeVirt:
+		state = states.KubeVirt
+	case hcov1beta1.OperandCDI:
+		state = states.CDI
+	case hcov1beta1.OperandNetworkAddonsConfig:
+		state = states.NetworkAddonsConfig
+	case hcov1beta1.OperandSSP:
+		state = states.SSP
+	case hcov1beta1.OperandAAQ:
+		state = states.AAQ
+	case hcov1beta1.OperandHostPathProvisioner:
+		state = states.HostPathProvisioner
+	}
+
+	return state == hcov1beta1.ManagementStateUnmanaged
+}
+
+// GetUnmanagedOperands returns the names of the operands that HCO does not reconcile
+func GetUnmanagedOperands(hc *hcov1beta1.HyperConverged) []string {
+	var unmanaged []string
+	for _, operand := range OperandOverrideNames {
+		if IsOperandUnmanaged(hc, operand) {
+			unmanaged = append(unmanaged, operand)
+		}
+	}
+
+	return unmanaged
+}
+
+func (h *OperandHandler) ensureOperand(req *common.HcoRequest, handler Operand) *EnsureResult {
+	if uo, ok := handler.(unmanageableOperand); ok {
+		if name := uo.getOperandName(); name != "" && IsOperandUnmanaged(req.Instance, name) {
+			req.Logger.Info(fmt.Sprintf("%s is not reconciled, according to spec.reconcilePolicy", name))
+			return uo.observe(req)
+		}
+	}
+
+	if IsReconcilePaused(req.Instance) {
+		// not an operand CR, so there are no conditions to aggregate
+		return &EnsureResult{UpgradeDone: req.ComponentUpgradeInProgress}
+	}
+
+	return handler.ensure(req)
+}
+
+func (h *genericOperand) getOperandName() string {
+	if oh, ok := h.hooks.(overridableHooks); ok {
+		return oh.getOperandName()
+	}
+	return ""
+}
+
+func (h *genericOperand) observe(req *common.HcoRequest) (res *EnsureResult) {
+	if csh, ok := h.hooks.(componentStatusHooks); ok {
+		defer func() {
+			h.setComponentReconcileError(req, csh, res.Err)
+		}()
+	}
+
+	cr, err := h.hooks.getFullCr(req.Instance)
+	if err != nil {
+		return &EnsureResult{
+			Err: err,
+		}
+	}
+
+	res = NewEnsureResult(cr)
+
+	key := client.ObjectKeyFromObject(cr)
+	res.SetName(key.Name)
+	found := h.hooks.getEmptyCr()
+	if err = h.Client.Get(req.Ctx, key, found); err != nil {
+		if apierrors.IsNotFound(err) {
+			req.Logger.Info(h.crType+" does not exist, and it is not created, according to spec.reconcilePolicy", h.crType+".Namespace", key.Namespace, h.crType+".Name", key.Name)
+			return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
+		}
+		return res.Error(err)
+	}
+
+	if err = h.addCrToTheRelatedObjectList(req, found); err != nil {
+		return res.Error(err)
+	}
+
+	if opr, ok := h.hooks.(hcoOperandHooks); ok {
+		return h.completeEnsureOperands(req, opr, found, res)
+	}
+
+	return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
+}
+
+func (ch *conditionalHandler) getOperandName() string {
+	return ch.operand.getOperandName()
+}
+
+// observe reads the operand CR, if it should be deployed. If not, the CR is not deleted as long as the operand is not
+// reconciled.
+func (ch *conditionalHandler) observe(req *common.HcoRequest) *EnsureResult {
+	if ch.shouldDeploy(req.Instance) {
+		return ch.operand.observe(req)
+	}
+	return &EnsureResult{UpgradeDone: req.ComponentUpgradeInProgress}
+}
@@ -0,0 +1,189 @@
+package operands
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("Reconcile Policy", func() {
+	var (
+		hco          *hcov1beta1.HyperConverged
+		hcoNamespace *corev1.Namespace
+	)
+
+	BeforeEach(func() {
+		testFileLocation := getTestFilesLocation()
+		_ = os.Setenv(quickStartManifestLocationVarName, testFileLocation+"/quickstarts")
+		_ = os.Setenv(dashboardManifestLocationVarName, testFileLocation+"/dashboards")
+		_ = os.Setenv("VIRTIOWIN_CONTAINER", "just-a-value:version")
+
+		hco = commontestutils.NewHco()
+		hcoNamespace = commontestutils.NewHcoNamespace()
+	})
+
+	DescribeTable("IsOperandUnmanaged",
+		func(policy *hcov1beta1.ReconcilePolicy, operand string, expected bool) {
+			hco.Spec.ReconcilePolicy = policy
+			Expect(IsOperandUnmanaged(hco, operand)).To(Equal(expected))
+		},
+		Entry("no policy", nil, hcov1beta1.OperandKubeVirt, false),
+		Entry("empty policy", &hcov1beta1.ReconcilePolicy{}, hcov1beta1.OperandKubeVirt, false),
+		Entry("global pause", &hcov1beta1.ReconcilePolicy{Paused: true}, hcov1beta1.OperandSSP, true),
+		Entry("Unmanaged operand",
+			&hcov1beta1.ReconcilePolicy{Operands: &hcov1beta1.OperandManagementStates{CDI: hcov1beta1.ManagementStateUnmanaged}},
+			hcov1beta1.OperandCDI, true),
+		Entry("another operand is Unmanaged",
+			&hcov1beta1.ReconcilePolicy{Operands: &hcov1beta1.OperandManagementStates{CDI: hcov1beta1.ManagementStateUnmanaged}},
+			hcov1beta1.OperandKubeVirt, false),
+		Entry("Managed operand",
+			&hcov1beta1.ReconcilePolicy{Operands: &hcov1beta1.OperandManagementStates{AAQ: hcov1beta1.ManagementStateManaged}},
+			hcov1beta1.OperandAAQ, false),
+	)
+
+	It("should list the unmanaged operands", func() {
+		hco.Spec.ReconcilePolicy = &hcov1beta1.ReconcilePolicy{
+			Operands: &hcov1beta1.OperandManagementStates{
+				KubeVirt: hcov1beta1.ManagementStateUnmanaged,
+				SSP:      hcov1beta1.ManagementStateUnmanaged,
+				CDI:      hcov1beta1.ManagementStateManaged,
+			},
+		}
+		Expect(GetUnmanagedOperands(hco)).To(Equal([]string{hcov1beta1.OperandKubeVirt, hcov1beta1.OperandSSP}))
+
+		hco.Spec.ReconcilePolicy.Paused = true
+		Expect(GetUnmanagedOperands(hco)).To(Equal(OperandOverrideNames))
+	})
+
+	Context("OperandHandler.Ensure", func() {
+		var (
+			kv  *kubevirtcorev1.KubeVirt
+			cdi *cdiv1beta1.CDI
+			ci  commontestutils.ClusterInfoMock
+		)
+
+		BeforeEach(func() {
+			var err error
+			kv, err = NewKubeVirt(hco)
+			Expect(err).ToNot(HaveOccurred())
+			// out-of-band modification
+			kv.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{"outOfBand"}
+			kv.Status.Conditions = []kubevirtcorev1.KubeVirtCondition{
+				{Type: kubevirtcorev1.KubeVirtConditionAvailable, Status: corev1.ConditionFalse, Reason: "Foo", Message: "Bar"},
+			}
+
+			cdi, err = NewCDI(hco)
+			Expect(err).ToNot(HaveOccurred())
+			// out-of-band modification
+			cdi.Spec.Config.FeatureGates = []string{"outOfBand"}
+
+			ci = commontestutils.ClusterInfoMock{}
+		})
+
+		getKV := func(cli client.Client) *kubevirtcorev1.KubeVirt {
+			found := &kubevirtcorev1.KubeVirt{}
+			Expect(cli.Get(context.TODO(), client.ObjectKeyFromObject(kv), found)).To(Succeed())
+			return found
+		}
+
+		getCDI := func(cli client.Client) *cdiv1beta1.CDI {
+			found := &cdiv1beta1.CDI{}
+			Expect(cli.Get(context.TODO(), client.ObjectKeyFromObject(cdi), found)).To(Succeed())
+			return found
+		}
+
+		It("should not modify any operand when paused, but still aggregate the conditions", func() {
+			hco.Spec.ReconcilePolicy = &hcov1beta1.ReconcilePolicy{Paused: true}
+			cli := commontestutils.InitClient([]client.Object{hcoNamespace, qsCrd, hco, kv, ci.GetCSV()})
+
+			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, commontestutils.NewEventEmitterMock())
+			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
+
+			req := commontestutils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(Succeed())
+
+			Expect(getKV(cli).Spec.Configuration.DeveloperConfiguration.FeatureGates).To(Equal([]string{"outOfBand"}))
+
+			cdiList := &cdiv1beta1.CDIList{}
+			Expect(cli.List(context.TODO(), cdiList)).To(Succeed())
+			Expect(cdiList.Items).To(BeEmpty())
+
+			cmList := &corev1.ConfigMapList{}
+			Expect(cli.List(context.TODO(), cmList, client.InNamespace("openshift-config-managed"))).To(Succeed())
+			Expect(cmList.Items).To(BeEmpty())
+
+			Expect(req.Conditions[hcov1beta1.ConditionAvailable]).To(commontestutils.RepresentCondition(metav1.Condition{
+				Type:    hcov1beta1.ConditionAvailable,
+				Status:  metav1.ConditionFalse,
+				Reason:  "KubeVirtNotAvailable",
+				Message: "KubeVirt is not available: Bar",
+			}))
+
+			By("re-converge when the pause is lifted")
+			hco.Spec.ReconcilePolicy = nil
+			req = commontestutils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(Succeed())
+
+			Expect(getKV(cli).Spec.Configuration.DeveloperConfiguration.FeatureGates).ToNot(ContainElement("outOfBand"))
+			Expect(cli.List(context.TODO(), cdiList)).To(Succeed())
+			Expect(cdiList.Items).To(HaveLen(1))
+		})
+
+		It("should only skip the Unmanaged operands", func() {
+			hco.Spec.ReconcilePolicy = &hcov1beta1.ReconcilePolicy{
+				Operands: &hcov1beta1.OperandManagementStates{
+					CDI: hcov1beta1.ManagementStateUnmanaged,
+				},
+			}
+			cli := commontestutils.InitClient([]client.Object{hcoNamespace, qsCrd, hco, kv, cdi, ci.GetCSV()})
+
+			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, commontestutils.NewEventEmitterMock())
+			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
+
+			req := commontestutils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(Succeed())
+
+			Expect(getCDI(cli).Spec.Config.FeatureGates).To(Equal([]string{"outOfBand"}))
+			Expect(getKV(cli).Spec.Configuration.DeveloperConfiguration.FeatureGates).ToNot(ContainElement("outOfBand"))
+			Expect(hco.Status.Components).To(ContainElement(HaveField("Name", hcov1beta1.OperandCDI)))
+
+			By("re-converge when the operand is Managed again")
+			hco.Spec.ReconcilePolicy.Operands.CDI = hcov1beta1.ManagementStateManaged
+			req = commontestutils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(Succeed())
+
+			Expect(getCDI(cli).Spec.Config.FeatureGates).ToNot(ContainElement("outOfBand"))
+		})
+
+		It("should not delete an Unmanaged AAQ, even if the feature gate is disabled", func() {
+			aaq, err := NewAAQ(hco)
+			Expect(err).ToNot(HaveOccurred())
+
+			hco.Spec.FeatureGates.EnableApplicationAwareQuota = ptr.To(false)
+			hco.Spec.ReconcilePolicy = &hcov1beta1.ReconcilePolicy{
+				Operands: &hcov1beta1.OperandManagementStates{
+					AAQ: hcov1beta1.ManagementStateUnmanaged,
+				},
+			}
+			cli := commontestutils.InitClient([]client.Object{hcoNamespace, qsCrd, hco, aaq, ci.GetCSV()})
+
+			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, commontestutils.NewEventEmitterMock())
+			req := commontestutils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(Succeed())
+
+			Expect(cli.Get(context.TODO(), client.ObjectKeyFromObject(aaq), aaq)).To(Succeed())
+		})
+	})
+})
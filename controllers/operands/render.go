@@ -0,0 +1,216 @@
+package operands
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// RenderedObject is an object that HCO creates and reconciles for a HyperConverged CR
+type RenderedObject struct {
+	*unstructured.Unstructured
+
+	// ServerSideApply is true if HCO reconciles the object with server-side apply. In this case, HCO also removes
+	// the fields it set in the past, but does not render anymore.
+	ServerSideApply bool
+}
+
+// Render returns the objects that HCO creates and reconciles for the given HyperConverged CR, in the order HCO
+// reconciles them, without accessing the cluster.
+//
+// The set of the rendered objects is determined by ci, but the operands read the cluster facts from
+// hcoutil.GetClusterInfo, so the caller should make it return ci as well.
+//
+// The ConsoleQuickStarts are not rendered, because HCO reads them from the cluster. The dashboards and the image
+// streams are only rendered if their manifest directories are available. The owner references are not rendered,
+// because they require the UID of the HyperConverged CR.
+func Render(hc *hcov1beta1.HyperConverged, scheme *runtime.Scheme, ci hcoutil.ClusterInfo) ([]RenderedObject, error) {
+	var objects []RenderedObject
+	operands, _ := getAllOperands(nil, scheme, ci, hc)
+	for _, operand := range operands {
+		h, cr, err := renderOperand(operand, hc)
+		if err != nil {
+			return nil, err
+		}
+		if cr == nil {
+			continue
+		}
+
+		obj, err := toRenderedObject(cr, scheme)
+		if err != nil {
+			return nil, err
+		}
+
+		_, updatedByHandler := h.hooks.(updateHooks)
+		objects = append(objects, RenderedObject{Unstructured: obj, ServerSideApply: !updatedByHandler})
+	}
+
+	return objects, nil
+}
+
+// getAllOperands returns the handlers of all the objects that HCO creates and reconciles for the given HyperConverged
+// CR, including the handlers that are added on the first reconciliation. It also returns the kinds of the objects
+// that can't be rendered in this process, because their manifest directories are missing, or their handlers fail.
+func getAllOperands(cli client.Client, scheme *runtime.Scheme, ci hcoutil.ClusterInfo, hc *hcov1beta1.HyperConverged) ([]Operand, []string) {
+	handler := NewOperandHandler(cli, scheme, ci, nil)
+
+	var skipped []string
+	for _, fuh := range getFirstUseHandlers(ci) {
+		if fuh.manifestDir != nil {
+			if err := hcoutil.ValidateManifestDir(fuh.manifestDir()); err != nil {
+				skipped = append(skipped, fuh.kind)
+				continue
+			}
+		}
+
+		handlers, err := fuh.get(logger, cli, scheme, hc)
+		if err != nil {
+			logger.Error(err, "can't render objects", "kind", fuh.kind)
+			skipped = append(skipped, fuh.kind)
+			continue
+		}
+		handler.operands = append(handler.operands, handlers...)
+	}
+
+	return handler.operands, skipped
+}
+
+// renderOperand returns the object of an operand, or nil if HCO should not deploy it. The handlers that only modify
+// objects that HCO does not create, are not rendered.
+func renderOperand(operand Operand, hc *hcov1beta1.HyperConverged) (*genericOperand, client.Object, error) {
+	if !isDeployed(operand, hc) {
+		return nil, nil, nil
+	}
+
+	var h *genericOperand
+	switch op := operand.(type) {
+	case *genericOperand:
+		h = op
+	case *conditionalHandler:
+		h = op.operand
+	case *imageStreamOperand:
+		h = op.operand
+	default:
+		return nil, nil, nil
+	}
+
+	cr, err := h.hooks.getFullCr(hc)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to render %s: %w", h.crType, err)
+	}
+	return h, cr, nil
+}
+
+// isDeployed returns true if HCO deploys the object of the operand for the given HyperConverged CR
+func isDeployed(operand Operand, hc *hcov1beta1.HyperConverged) bool {
+	switch op := operand.(type) {
+	case *conditionalHandler:
+		return op.shouldDeploy(hc)
+	case *imageStreamOperand:
+		return ptr.Deref(hc.Spec.FeatureGates.EnableCommonBootImageImport, false)
+	default:
+		return true
+	}
+}
+
+// toRenderedObject converts the object to an unstructured object, without the status and the fields that are set by
+// the API server
+func toRenderedObject(cr client.Object, scheme *runtime.Scheme) (*unstructured.Unstructured, error) {
+	gvk, err := apiutil.GVKForObject(cr, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cr)
+	if err != nil {
+		return nil, err
+	}
+	delete(content, "status")
+	pruneNulls(content)
+
+	obj := &unstructured.Unstructured{Object: content}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetOwnerReferences(nil)
+	obj.SetManagedFields(nil)
+	obj.SetResourceVersion("")
+	obj.SetUID("")
+
+	return obj, nil
+}
+
+// FieldChange is a change that HCO would make in a field of a live object. Live is nil if the field is not set in the
+// live object, and Rendered is nil if HCO would remove the field.
+type FieldChange struct {
+	Path     string
+	Live     any
+	Rendered any
+}
+
+// Diff compares the rendered object with its live version, and returns the changes that HCO would make in the live
+// object. Fields that HCO does not render are ignored, unless HCO would remove them because it set them in the past.
+func (ro RenderedObject) Diff(live *unstructured.Unstructured) ([]FieldChange, error) {
+	liveContent := pruneNulls(runtime.DeepCopyJSON(live.Object)).(map[string]any)
+	rendered := renderedFieldPaths(ro.Unstructured)
+
+	var changes []FieldChange
+	for _, p := range rendered {
+		if slices.Equal(p, ownerReferencesPath) {
+			continue
+		}
+		required, _ := getFieldValue(ro.Object, p)
+		actual, _ := getFieldValue(liveContent, p)
+		if !reflect.DeepEqual(required, actual) {
+			changes = append(changes, FieldChange{Path: p.String(), Live: actual, Rendered: required})
+		}
+	}
+
+	if !ro.ServerSideApply {
+		return changes, nil
+	}
+
+	own, legacy, _, err := getManagedFieldSets(live)
+	if err != nil {
+		return nil, err
+	}
+	if own != nil {
+		legacy = legacy.Union(own)
+	}
+
+	legacy.Leaves().Iterate(func(mp fieldpath.Path) {
+		if slices.ContainsFunc(rendered, func(p fieldPath) bool {
+			return p.isPrefixOf(mp) || p.isUnder(mp)
+		}) {
+			return
+		}
+
+		p := make(fieldPath, 0, len(mp))
+		for _, pe := range mp {
+			if pe.FieldName == nil {
+				// lists are atomic; the whole list is removed
+				break
+			}
+			p = append(p, *pe.FieldName)
+		}
+		if slices.Equal(p, ownerReferencesPath) || slices.ContainsFunc(changes, func(change FieldChange) bool {
+			return change.Path == p.String()
+		}) {
+			return
+		}
+
+		if actual, exists := getFieldValue(liveContent, p); exists {
+			changes = append(changes, FieldChange{Path: p.String(), Live: actual})
+		}
+	})
+
+	return changes, nil
+}
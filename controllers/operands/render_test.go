@@ -0,0 +1,139 @@
+package operands
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Render", func() {
+	var hco *hcov1beta1.HyperConverged
+
+	getClusterInfo := hcoutil.GetClusterInfo
+
+	BeforeEach(func() {
+		_ = os.Setenv("VIRTIOWIN_CONTAINER", "just-a-value:version")
+		hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+			return &commontestutils.ClusterInfoMock{}
+		}
+		hco = commontestutils.NewHco()
+	})
+
+	AfterEach(func() {
+		hcoutil.GetClusterInfo = getClusterInfo
+		_ = os.Unsetenv("VIRTIOWIN_CONTAINER")
+	})
+
+	findObject := func(objects []RenderedObject, kind, name string) *RenderedObject {
+		for i, obj := range objects {
+			if obj.GetKind() == kind && obj.GetName() == name {
+				return &objects[i]
+			}
+		}
+		return nil
+	}
+
+	render := func() []RenderedObject {
+		objects, err := Render(hco, commontestutils.GetScheme(), commontestutils.ClusterInfoMock{})
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		return objects
+	}
+
+	It("should render the operand CRs, ConfigMaps and the PriorityClass", func() {
+		objects := render()
+
+		kv := findObject(objects, "KubeVirt", "kubevirt-"+hco.Name)
+		Expect(kv).ToNot(BeNil())
+		Expect(kv.GetAPIVersion()).To(Equal("kubevirt.io/v1"))
+		Expect(kv.GetNamespace()).To(Equal(hco.Namespace))
+		Expect(kv.ServerSideApply).To(BeTrue())
+		Expect(kv.Object).ToNot(HaveKey("status"))
+		Expect(kv.GetOwnerReferences()).To(BeEmpty())
+
+		Expect(findObject(objects, "PriorityClass", kvPriorityClass)).ToNot(BeNil())
+		Expect(findObject(objects, "CDI", "cdi-"+hco.Name)).ToNot(BeNil())
+		Expect(findObject(objects, "NetworkAddonsConfig", "cluster")).ToNot(BeNil())
+		Expect(findObject(objects, "SSP", "ssp-"+hco.Name)).ToNot(BeNil())
+
+		cm := findObject(objects, "ConfigMap", virtioWinCmName)
+		Expect(cm).ToNot(BeNil())
+		Expect(cm.ServerSideApply).To(BeFalse())
+		Expect(cm.Object).To(HaveKeyWithValue("data", HaveKeyWithValue("virtio-win-image", "just-a-value:version")))
+	})
+
+	It("should only render conditional operands if they should be deployed", func() {
+		Expect(findObject(render(), "AAQ", "aaq-"+hco.Name)).To(BeNil())
+
+		hco.Spec.FeatureGates.EnableApplicationAwareQuota = ptr.To(true)
+		Expect(findObject(render(), "AAQ", "aaq-"+hco.Name)).ToNot(BeNil())
+	})
+
+	It("should use the values of the HyperConverged CR", func() {
+		hco.Spec.ScratchSpaceStorageClass = ptr.To("scratch-sc")
+
+		cdi := findObject(render(), "CDI", "cdi-"+hco.Name)
+		Expect(cdi).ToNot(BeNil())
+		Expect(cdi.Object).To(HaveKeyWithValue("spec", HaveKeyWithValue("config", HaveKeyWithValue("scratchSpaceStorageClass", "scratch-sc"))))
+	})
+
+	Context("Diff", func() {
+		var (
+			cdi  *RenderedObject
+			live *unstructured.Unstructured
+		)
+
+		BeforeEach(func() {
+			hco.Spec.ScratchSpaceStorageClass = ptr.To("scratch-sc")
+			cdi = findObject(render(), "CDI", "cdi-"+hco.Name)
+			Expect(cdi).ToNot(BeNil())
+			live = cdi.DeepCopy()
+		})
+
+		It("should not report changes if the live object is up to date", func() {
+			Expect(cdi.Diff(live)).To(BeEmpty())
+		})
+
+		It("should report changed and missing fields, and ignore the fields HCO does not render", func() {
+			Expect(unstructured.SetNestedField(live.Object, "another-sc", "spec", "config", "scratchSpaceStorageClass")).To(Succeed())
+			Expect(unstructured.SetNestedField(live.Object, true, "spec", "config", "preallocation")).To(Succeed())
+			unstructured.RemoveNestedField(live.Object, "spec", "uninstallStrategy")
+
+			Expect(cdi.Diff(live)).To(ConsistOf(
+				FieldChange{Path: ".spec.config.scratchSpaceStorageClass", Live: "another-sc", Rendered: "scratch-sc"},
+				FieldChange{Path: ".spec.uninstallStrategy", Live: nil, Rendered: "BlockUninstallIfWorkloadsExist"},
+			))
+		})
+
+		It("should report the fields that HCO would remove", func() {
+			Expect(unstructured.SetNestedField(live.Object, true, "spec", "config", "preallocation")).To(Succeed())
+			Expect(unstructured.SetNestedField(live.Object, "foreign", "spec", "config", "podResourceRequirements", "limits", "cpu")).To(Succeed())
+			live.SetManagedFields([]metav1.ManagedFieldsEntry{
+				{
+					Manager:    hcoutil.FieldManager,
+					Operation:  metav1.ManagedFieldsOperationApply,
+					FieldsType: "FieldsV1",
+					FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:config":{"f:preallocation":{}}}}`)},
+				},
+				{
+					Manager:    "other-controller",
+					Operation:  metav1.ManagedFieldsOperationUpdate,
+					FieldsType: "FieldsV1",
+					FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:config":{"f:podResourceRequirements":{}}}}`)},
+				},
+			})
+
+			Expect(cdi.Diff(live)).To(ConsistOf(
+				FieldChange{Path: ".spec.config.preallocation", Live: true, Rendered: nil},
+			))
+		})
+	})
+})
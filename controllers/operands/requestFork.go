@@ -0,0 +1,190 @@
+package operands
+
+import (
+	"encoding/json"
+	"maps"
+	"reflect"
+	"slices"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	corev1 "k8s.io/api/core/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+// Operands that are reconciled concurrently, must not modify the same request. Each one of them gets a fork of the
+// request, with its own copy of the HyperConverged CR and of the conditions. When all the operands are done, the
+// changes they made in their forks are merged back into the request, in the order of the operands, so the result
+// does not depend on the order the operands were completed.
+
+// requestBase is the state of the request before the operands were reconciled
+type requestBase struct {
+	instance   *hcov1beta1.HyperConverged
+	conditions common.HcoConditions
+}
+
+func newRequestBase(req *common.HcoRequest) *requestBase {
+	return &requestBase{
+		instance:   req.Instance.DeepCopy(),
+		conditions: cloneConditions(req.Conditions),
+	}
+}
+
+func forkRequest(req *common.HcoRequest) *common.HcoRequest {
+	forked := *req
+	forked.Instance = req.Instance.DeepCopy()
+	forked.Conditions = cloneConditions(req.Conditions)
+	forked.Dirty = false
+	forked.StatusDirty = false
+	forked.Upgradeable = true
+
+	return &forked
+}
+
+func cloneConditions(conditions common.HcoConditions) common.HcoConditions {
+	cloned := common.NewHcoConditions()
+	maps.Copy(cloned, conditions)
+	return cloned
+}
+
+// The fields of the request that the operands may modify in their forks, and that are merged back into the request.
+// All the other fields of the request are read-only for the operands. A new field of common.HcoRequest must be added to
+// one of these lists, or the request fork unit tests fail.
+var (
+	mergedRequestFields   = []string{"Conditions", "Instance", "Dirty", "StatusDirty", "Upgradeable"}
+	readOnlyRequestFields = []string{"Request", "Logger", "Ctx", "UpgradeMode", "ComponentUpgradeInProgress", "HCOTriggered"}
+)
+
+// mergeRequest applies the changes that an operand made in its fork of the request, to the request
+func mergeRequest(req *common.HcoRequest, base *requestBase, forked *common.HcoRequest) error {
+	for condType, cond := range forked.Conditions {
+		if baseCond, exists := base.conditions[condType]; !exists || !reflect.DeepEqual(baseCond, cond) {
+			req.Conditions.SetStatusCondition(cond)
+		}
+	}
+
+	req.Upgradeable = req.Upgradeable && forked.Upgradeable
+
+	if forked.Dirty {
+		if err := mergeChanges(&req.Instance.ObjectMeta, base.instance.ObjectMeta, forked.Instance.ObjectMeta); err != nil {
+			return err
+		}
+		if err := mergeChanges(&req.Instance.Spec, base.instance.Spec, forked.Instance.Spec); err != nil {
+			return err
+		}
+		req.Dirty = true
+	}
+
+	if forked.StatusDirty {
+		if err := mergeStatus(&req.Instance.Status, &base.instance.Status, &forked.Instance.Status); err != nil {
+			return err
+		}
+		req.StatusDirty = true
+	}
+
+	return nil
+}
+
+// mergeStatus merges the lists that several operands update, item by item, and all the other fields of the status
+// with a JSON merge patch
+func mergeStatus(status, baseStatus, forkedStatus *hcov1beta1.HyperConvergedStatus) error {
+	mergeListChanges(&status.RelatedObjects, baseStatus.RelatedObjects, forkedStatus.RelatedObjects, func(ref corev1.ObjectReference) string {
+		return ref.APIVersion + "/" + ref.Kind + "/" + ref.Namespace + "/" + ref.Name
+	})
+	mergeListChanges(&status.Components, baseStatus.Components, forkedStatus.Components, func(cs hcov1beta1.ComponentStatus) string {
+		return cs.Name
+	})
+	mergeListChanges(&status.OperandOverrides, baseStatus.OperandOverrides, forkedStatus.OperandOverrides, func(st hcov1beta1.OperandOverrideStatus) string {
+		return st.Operand
+	})
+
+	withoutLists := func(st *hcov1beta1.HyperConvergedStatus) hcov1beta1.HyperConvergedStatus {
+		cp := *st
+		cp.RelatedObjects, cp.Components, cp.OperandOverrides = nil, nil, nil
+		return cp
+	}
+
+	merged := withoutLists(status)
+	if err := mergeChanges(&merged, withoutLists(baseStatus), withoutLists(forkedStatus)); err != nil {
+		return err
+	}
+	merged.RelatedObjects, merged.Components, merged.OperandOverrides = status.RelatedObjects, status.Components, status.OperandOverrides
+	*status = merged
+
+	return nil
+}
+
+// mergeChanges applies the changes from base to forked, to target, as a JSON merge patch. The lists are replaced as a
+// whole.
+func mergeChanges[T any](target *T, base, forked T) error {
+	if reflect.DeepEqual(base, forked) {
+		return nil
+	}
+
+	baseJSON, err := json.Marshal(base)
+	if err != nil {
+		return err
+	}
+	forkedJSON, err := json.Marshal(forked)
+	if err != nil {
+		return err
+	}
+	patch, err := jsonpatch.CreateMergePatch(baseJSON, forkedJSON)
+	if err != nil {
+		return err
+	}
+
+	targetJSON, err := json.Marshal(target)
+	if err != nil {
+		return err
+	}
+	mergedJSON, err := jsonpatch.MergePatch(targetJSON, patch)
+	if err != nil {
+		return err
+	}
+
+	var merged T
+	if err = json.Unmarshal(mergedJSON, &merged); err != nil {
+		return err
+	}
+	*target = merged
+
+	return nil
+}
+
+// mergeListChanges applies the items that were added, modified or removed in the forked list, compared to the base
+// list, to the list
+func mergeListChanges[T any](list *[]T, base, forked []T, key func(T) string) {
+	baseItems := make(map[string]T, len(base))
+	for _, item := range base {
+		baseItems[key(item)] = item
+	}
+
+	forkedKeys := make(map[string]bool, len(forked))
+	for _, item := range forked {
+		k := key(item)
+		forkedKeys[k] = true
+		if baseItem, exists := baseItems[k]; exists && reflect.DeepEqual(baseItem, item) {
+			continue
+		}
+
+		if idx := slices.IndexFunc(*list, func(i T) bool { return key(i) == k }); idx >= 0 {
+			(*list)[idx] = item
+		} else {
+			*list = append(*list, item)
+		}
+	}
+
+	for _, item := range base {
+		k := key(item)
+		if forkedKeys[k] {
+			continue
+		}
+
+		*list = slices.DeleteFunc(*list, func(i T) bool { return key(i) == k })
+		if len(*list) == 0 {
+			*list = nil
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package operands
+
+import (
+	"reflect"
+	"slices"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("Request fork", func() {
+	ref := func(kind, name, resourceVersion string) corev1.ObjectReference {
+		return corev1.ObjectReference{APIVersion: "v1", Kind: kind, Namespace: "ns", Name: name, ResourceVersion: resourceVersion}
+	}
+
+	It("should merge the changes of the forks in order", func() {
+		hco := commontestutils.NewHco()
+		hco.Status.RelatedObjects = []corev1.ObjectReference{ref("ConfigMap", "a", "1"), ref("ConfigMap", "b", "1")}
+		req := commontestutils.NewReq(hco)
+		req.Upgradeable = true
+
+		base := newRequestBase(req)
+		first, second := forkRequest(req), forkRequest(req)
+
+		// the first fork updates "a", and adds "c"
+		first.Instance.Status.RelatedObjects[0] = ref("ConfigMap", "a", "2")
+		first.Instance.Status.RelatedObjects = append(first.Instance.Status.RelatedObjects, ref("ConfigMap", "c", "1"))
+		first.Conditions.SetStatusCondition(metav1.Condition{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionFalse, Reason: "FirstNotAvailable"})
+		first.StatusDirty = true
+
+		// the second fork removes "b", and adds "d"
+		second.Instance.Status.RelatedObjects = []corev1.ObjectReference{ref("ConfigMap", "a", "1"), ref("ConfigMap", "d", "1")}
+		second.Conditions.SetStatusCondition(metav1.Condition{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionFalse, Reason: "SecondNotAvailable"})
+		second.Upgradeable = false
+		second.StatusDirty = true
+
+		// forks do not modify the request
+		Expect(req.Instance.Status.RelatedObjects).To(Equal([]corev1.ObjectReference{ref("ConfigMap", "a", "1"), ref("ConfigMap", "b", "1")}))
+		Expect(req.Conditions).To(BeEmpty())
+
+		Expect(mergeRequest(req, base, first)).To(Succeed())
+		Expect(mergeRequest(req, base, second)).To(Succeed())
+
+		Expect(req.Instance.Status.RelatedObjects).To(Equal([]corev1.ObjectReference{
+			ref("ConfigMap", "a", "2"),
+			ref("ConfigMap", "c", "1"),
+			ref("ConfigMap", "d", "1"),
+		}))
+		Expect(req.Conditions[hcov1beta1.ConditionAvailable].Reason).To(Equal("SecondNotAvailable"))
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(req.Upgradeable).To(BeFalse())
+	})
+
+	It("should not modify the request if the fork was not changed", func() {
+		hco := commontestutils.NewHco()
+		hco.Status.RelatedObjects = []corev1.ObjectReference{ref("ConfigMap", "a", "1")}
+		req := commontestutils.NewReq(hco)
+		req.Upgradeable = true
+
+		base := newRequestBase(req)
+		Expect(mergeRequest(req, base, forkRequest(req))).To(Succeed())
+
+		Expect(req.Instance.Status.RelatedObjects).To(Equal([]corev1.ObjectReference{ref("ConfigMap", "a", "1")}))
+		Expect(req.Conditions).To(BeEmpty())
+		Expect(req.StatusDirty).To(BeFalse())
+		Expect(req.Dirty).To(BeFalse())
+		Expect(req.Upgradeable).To(BeTrue())
+	})
+
+	It("should merge the changes of the fields that are not merged item by item", func() {
+		hco := commontestutils.NewHco()
+		hco.Annotations = map[string]string{"a": "1", "b": "1"}
+		req := commontestutils.NewReq(hco)
+
+		base := newRequestBase(req)
+		first, second := forkRequest(req), forkRequest(req)
+
+		// the first fork updates an annotation, and a status field
+		first.Instance.Annotations["a"] = "2"
+		first.Dirty = true
+		first.Instance.Status.ObservedGeneration = 42
+		first.StatusDirty = true
+
+		// the second fork removes an annotation, and sets the data import schedule
+		delete(second.Instance.Annotations, "b")
+		second.Dirty = true
+		second.Instance.Status.DataImportSchedule = "1 2 * * *"
+		second.StatusDirty = true
+
+		Expect(mergeRequest(req, base, first)).To(Succeed())
+		Expect(mergeRequest(req, base, second)).To(Succeed())
+
+		Expect(req.Instance.Annotations).To(Equal(map[string]string{"a": "2"}))
+		Expect(req.Instance.Status.ObservedGeneration).To(Equal(int64(42)))
+		Expect(req.Instance.Status.DataImportSchedule).To(Equal("1 2 * * *"))
+		Expect(req.Dirty).To(BeTrue())
+		Expect(req.StatusDirty).To(BeTrue())
+	})
+
+	It("should not merge the changes of the instance, if the fork is not dirty", func() {
+		req := commontestutils.NewReq(commontestutils.NewHco())
+
+		base := newRequestBase(req)
+		forked := forkRequest(req)
+		forked.Instance.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{{}}
+		forked.Instance.Status.DataImportSchedule = "1 2 * * *"
+
+		Expect(mergeRequest(req, base, forked)).To(Succeed())
+
+		Expect(req.Instance.Spec.DataImportCronTemplates).To(BeEmpty())
+		Expect(req.Instance.Status.DataImportSchedule).To(BeEmpty())
+	})
+
+	It("should either merge or ignore every field of the request", func() {
+		known := append(slices.Clone(mergedRequestFields), readOnlyRequestFields...)
+
+		reqType := reflect.TypeOf(common.HcoRequest{})
+		for i := range reqType.NumField() {
+			Expect(known).To(ContainElement(reqType.Field(i).Name),
+				"the %s field of the request must be added to mergedRequestFields, or to readOnlyRequestFields", reqType.Field(i).Name)
+		}
+	})
+})
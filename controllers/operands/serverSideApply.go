@@ -0,0 +1,404 @@
+package operands
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// Resources with hooks that do not implement the updateHooks interface, are reconciled with server-side apply, using
+// the HCO field manager. HCO only owns the fields it renders, so fields that were set by other managers, like other
+// controllers or defaulting webhooks, are kept.
+//
+// Out-of-band modifications are detected using the managed fields of the resource: a field that HCO renders, and
+// that was changed by another manager.
+
+// fieldPath is the path of a rendered field. Lists are atomic, so a path never goes into a list item.
+type fieldPath []string
+
+func (p fieldPath) String() string {
+	return "." + strings.Join(p, ".")
+}
+
+// isPrefixOf returns true if p is the managed field path mp, or one of its parents
+func (p fieldPath) isPrefixOf(mp fieldpath.Path) bool {
+	if len(p) > len(mp) {
+		return false
+	}
+	for i, name := range p {
+		if mp[i].FieldName == nil || *mp[i].FieldName != name {
+			return false
+		}
+	}
+	return true
+}
+
+// isUnder returns true if the managed field path mp is one of the parents of p
+func (p fieldPath) isUnder(mp fieldpath.Path) bool {
+	if len(mp) >= len(p) {
+		return false
+	}
+	for i, pe := range mp {
+		if pe.FieldName == nil || *pe.FieldName != p[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// owns returns true if the field, or any of its sub-fields, is a member of the set
+func owns(set *fieldpath.Set, p fieldPath) bool {
+	found := false
+	set.Iterate(func(mp fieldpath.Path) {
+		found = found || p.isPrefixOf(mp)
+	})
+	return found
+}
+
+// touches returns true if the field, one of its sub-fields, or one of its parents is a member of the set
+func touches(set *fieldpath.Set, p fieldPath) bool {
+	found := false
+	set.Iterate(func(mp fieldpath.Path) {
+		found = found || p.isPrefixOf(mp) || p.isUnder(mp)
+	})
+	return found
+}
+
+var ownerReferencesPath = fieldPath{"metadata", "ownerReferences"}
+
+type managedFieldSet struct {
+	manager string
+	set     *fieldpath.Set
+}
+
+func isHcoApplyEntry(entry metav1.ManagedFieldsEntry) bool {
+	return entry.Manager == hcoutil.FieldManager && entry.Operation == metav1.ManagedFieldsOperationApply
+}
+
+// isLegacyHcoEntry returns true for the fields HCO set with a full Update, before it used server-side apply, and for
+// the fields HCO set when it created the resource.
+func isLegacyHcoEntry(entry metav1.ManagedFieldsEntry) bool {
+	return (entry.Manager == hcoutil.LegacyFieldManager || entry.Manager == hcoutil.FieldManager) &&
+		entry.Operation == metav1.ManagedFieldsOperationUpdate && entry.Subresource == ""
+}
+
+func decodeFieldSet(entry metav1.ManagedFieldsEntry) (*fieldpath.Set, error) {
+	set := &fieldpath.Set{}
+	if entry.FieldsV1 == nil {
+		return set, nil
+	}
+	if err := set.FromJSON(bytes.NewReader(entry.FieldsV1.Raw)); err != nil {
+		return nil, fmt.Errorf("failed to read the managed fields of %s: %w", entry.Manager, err)
+	}
+	return set, nil
+}
+
+// getManagedFieldSets returns the set of the fields HCO applied (nil if HCO never applied the resource), the set of
+// the fields HCO set with Create or Update calls, and the field sets of all the other managers
+func getManagedFieldSets(obj client.Object) (*fieldpath.Set, *fieldpath.Set, []managedFieldSet, error) {
+	var own *fieldpath.Set
+	legacy := &fieldpath.Set{}
+	var others []managedFieldSet
+
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Subresource != "" {
+			continue
+		}
+
+		set, err := decodeFieldSet(entry)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		switch {
+		case isHcoApplyEntry(entry):
+			own = set
+		case isLegacyHcoEntry(entry):
+			legacy = legacy.Union(set)
+		default:
+			others = append(others, managedFieldSet{manager: entry.Manager, set: set})
+		}
+	}
+
+	return own, legacy, others, nil
+}
+
+// toApplyConfiguration converts the rendered resource to the object HCO applies: only the identity of the resource,
+// its labels, annotations and owner references, and its spec.
+func (h *genericOperand) toApplyConfiguration(cr client.Object) (*unstructured.Unstructured, error) {
+	gvk, err := apiutil.GVKForObject(cr, h.Scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cr)
+	if err != nil {
+		return nil, err
+	}
+
+	applyCfg := &unstructured.Unstructured{Object: map[string]any{}}
+	applyCfg.SetGroupVersionKind(gvk)
+	applyCfg.SetName(cr.GetName())
+	applyCfg.SetNamespace(cr.GetNamespace())
+	if labels := cr.GetLabels(); len(labels) > 0 {
+		applyCfg.SetLabels(labels)
+	}
+	if annotations := cr.GetAnnotations(); len(annotations) > 0 {
+		applyCfg.SetAnnotations(annotations)
+	}
+	if refs := cr.GetOwnerReferences(); len(refs) > 0 {
+		applyCfg.SetOwnerReferences(refs)
+	}
+	if spec, ok := content["spec"]; ok && spec != nil {
+		applyCfg.Object["spec"] = pruneNulls(spec)
+	}
+
+	return applyCfg, nil
+}
+
+func pruneNulls(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, val := range v {
+			if val == nil {
+				delete(v, key)
+			} else {
+				v[key] = pruneNulls(val)
+			}
+		}
+	case []any:
+		for i, val := range v {
+			v[i] = pruneNulls(val)
+		}
+	}
+	return value
+}
+
+// renderedFieldPaths returns the paths of all the fields in the applied object, except for the identity of the
+// resource
+func renderedFieldPaths(applyCfg *unstructured.Unstructured) []fieldPath {
+	var paths []fieldPath
+
+	var walk func(parent fieldPath, obj map[string]any)
+	walk = func(parent fieldPath, obj map[string]any) {
+		for key, value := range obj {
+			p := append(slices.Clone(parent), key)
+			if m, ok := value.(map[string]any); ok && len(m) > 0 {
+				walk(p, m)
+				continue
+			}
+			paths = append(paths, p)
+		}
+	}
+
+	for key, value := range applyCfg.Object {
+		switch key {
+		case "apiVersion", "kind":
+			continue
+		case "metadata":
+			metadata, _ := value.(map[string]any)
+			for _, field := range []string{"labels", "annotations", "ownerReferences"} {
+				if fieldValue, ok := metadata[field]; ok {
+					walk(fieldPath{"metadata"}, map[string]any{field: fieldValue})
+				}
+			}
+		default:
+			walk(nil, map[string]any{key: value})
+		}
+	}
+
+	slices.SortFunc(paths, func(a, b fieldPath) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	return paths
+}
+
+func getFieldValue(obj map[string]any, p fieldPath) (any, bool) {
+	var value any = obj
+	for _, name := range p {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = m[name]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// hasStaleFields returns true if HCO owns fields that it does not render anymore. Applying the resource will remove
+// these fields.
+func hasStaleFields(own *fieldpath.Set, rendered []fieldPath) bool {
+	stale := false
+	own.Iterate(func(mp fieldpath.Path) {
+		if stale {
+			return
+		}
+		stale = !slices.ContainsFunc(rendered, func(p fieldPath) bool {
+			return p.isPrefixOf(mp) || p.isUnder(mp)
+		})
+	})
+	return stale
+}
+
+// migrateLegacyFieldManager moves the ownership of the fields HCO set with Create or Update calls, to the HCO apply
+// entry. Without it, fields that HCO does not render anymore would never be removed. The migration is only done just
+// before applying a change, to avoid a redundant write.
+func (h *genericOperand) migrateLegacyFieldManager(req *common.HcoRequest, found client.Object) error {
+	entries := found.GetManagedFields()
+	if !slices.ContainsFunc(entries, isLegacyHcoEntry) {
+		return nil
+	}
+
+	req.Logger.Info("Migrating the managed fields of " + h.crType + " to the HCO field manager")
+
+	migrated := &fieldpath.Set{}
+	var newEntries []metav1.ManagedFieldsEntry
+	var apiVersion string
+	var updateTime *metav1.Time
+	for _, entry := range entries {
+		if !isLegacyHcoEntry(entry) && !isHcoApplyEntry(entry) {
+			newEntries = append(newEntries, entry)
+			continue
+		}
+
+		set, err := decodeFieldSet(entry)
+		if err != nil {
+			return err
+		}
+		migrated = migrated.Union(set)
+		apiVersion = entry.APIVersion
+		updateTime = entry.Time
+	}
+
+	fields, err := migrated.ToJSON()
+	if err != nil {
+		return err
+	}
+
+	newEntries = append(newEntries, metav1.ManagedFieldsEntry{
+		Manager:    hcoutil.FieldManager,
+		Operation:  metav1.ManagedFieldsOperationApply,
+		APIVersion: apiVersion,
+		Time:       updateTime,
+		FieldsType: "FieldsV1",
+		FieldsV1:   &metav1.FieldsV1{Raw: fields},
+	})
+	found.SetManagedFields(newEntries)
+
+	return h.Client.Update(req.Ctx, found)
+}
+
+// applyCr applies the rendered resource, forcing the ownership of all the rendered fields
+func (h *genericOperand) applyCr(req *common.HcoRequest, applyCfg *unstructured.Unstructured) error {
+	return h.Client.Patch(req.Ctx, applyCfg, client.Apply, client.FieldOwner(hcoutil.FieldManager), client.ForceOwnership)
+}
+
+// applyExistingCr applies the rendered resource if any of the rendered fields was changed, or if HCO owns fields that
+// it does not render anymore. It returns the fields that were changed by other managers.
+func (h *genericOperand) applyExistingCr(req *common.HcoRequest, found client.Object, cr client.Object) (bool, []string, error) {
+	applyCfg, err := h.toApplyConfiguration(cr)
+	if err != nil {
+		return false, nil, err
+	}
+
+	foundContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(found)
+	if err != nil {
+		return false, nil, err
+	}
+	pruneNulls(foundContent)
+
+	own, legacy, others, err := getManagedFieldSets(found)
+	if err != nil {
+		return false, nil, err
+	}
+
+	rendered := renderedFieldPaths(applyCfg)
+
+	var changed []fieldPath
+	for _, p := range rendered {
+		if slices.Equal(p, ownerReferencesPath) {
+			// the owner references are set when the resource is created, and are not reconciled by themselves
+			continue
+		}
+		required, _ := getFieldValue(applyCfg.Object, p)
+		actual, exists := getFieldValue(foundContent, p)
+		if !exists || !reflect.DeepEqual(required, actual) {
+			changed = append(changed, p)
+		}
+	}
+
+	if len(changed) == 0 && !hasStaleFields(legacy, rendered) && (own == nil || !hasStaleFields(own, rendered)) {
+		return false, nil, nil
+	}
+
+	var overwrittenFields []string
+	for _, p := range changed {
+		if isOutOfBand, managers := isOutOfBandChange(req, own, others, p); isOutOfBand {
+			field := p.String()
+			if len(managers) > 0 {
+				field = fmt.Sprintf("%s (by %s)", field, strings.Join(managers, ", "))
+			}
+			overwrittenFields = append(overwrittenFields, field)
+		}
+	}
+
+	if len(overwrittenFields) == 0 {
+		req.Logger.Info("Updating existing " + h.crType + "'s Spec to new opinionated values")
+	} else {
+		req.Logger.Info("Reconciling an externally updated "+h.crType+"'s Spec to its opinionated values", "fields", overwrittenFields)
+	}
+
+	if err = h.migrateLegacyFieldManager(req, found); err != nil {
+		return false, nil, err
+	}
+
+	if err = h.applyCr(req, applyCfg); err != nil {
+		return false, nil, err
+	}
+
+	return true, overwrittenFields, nil
+}
+
+// isOutOfBandChange checks if a changed field was modified by another manager. It returns the managers that own the
+// field, if known.
+func isOutOfBandChange(req *common.HcoRequest, own *fieldpath.Set, others []managedFieldSet, p fieldPath) (bool, []string) {
+	if own == nil {
+		// HCO never applied the resource. The fields it set with full updates are not reliable enough to know who
+		// changed the field.
+		return !req.HCOTriggered, nil
+	}
+
+	var managers []string
+	for _, other := range others {
+		if touches(other.set, p) && !slices.Contains(managers, other.manager) {
+			managers = append(managers, other.manager)
+		}
+	}
+
+	if len(managers) > 0 {
+		return true, managers
+	}
+
+	if owns(own, p) {
+		// HCO still owns the field, so the rendered value was changed
+		return false, nil
+	}
+
+	// no one owns the field. It was either removed by another manager, or HCO renders a new field
+	return !req.HCOTriggered, nil
+}
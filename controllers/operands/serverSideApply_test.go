@@ -0,0 +1,145 @@
+package operands
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Server-side apply", func() {
+	var (
+		hco     *hcov1beta1.HyperConverged
+		req     *common.HcoRequest
+		cl      *commontestutils.HcoTestClient
+		handler *genericOperand
+	)
+
+	getCDI := func() *cdiv1beta1.CDI {
+		cdi := NewCDIWithNameOnly(hco)
+		ExpectWithOffset(1, cl.Get(context.TODO(), client.ObjectKeyFromObject(cdi), cdi)).To(Succeed())
+		return cdi
+	}
+
+	ensure := func() *EnsureResult {
+		handler.reset()
+		res := handler.ensure(req)
+		ExpectWithOffset(1, res.Err).ToNot(HaveOccurred())
+		return res
+	}
+
+	hasManagedFieldsEntry := func(cdi *cdiv1beta1.CDI, manager string, operation metav1.ManagedFieldsOperationType) bool {
+		for _, entry := range cdi.GetManagedFields() {
+			if entry.Manager == manager && entry.Operation == operation {
+				return true
+			}
+		}
+		return false
+	}
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		req = commontestutils.NewReq(hco)
+		cl = commontestutils.InitClient([]client.Object{hco})
+		handler = (*genericOperand)(newCdiHandler(cl, commontestutils.GetScheme()))
+
+		By("create the CDI CR, and then apply a change, so HCO owns the CDI fields with an apply entry")
+		Expect(ensure().Created).To(BeTrue())
+		Expect(hasManagedFieldsEntry(getCDI(), hcoutil.FieldManager, metav1.ManagedFieldsOperationUpdate)).To(BeTrue())
+
+		hco.Spec.ScratchSpaceStorageClass = ptr.To("scratch-sc")
+		res := ensure()
+		Expect(res.Updated).To(BeTrue())
+		Expect(res.Overwritten).To(BeFalse())
+
+		cdi := getCDI()
+		Expect(cdi.Spec.Config.ScratchSpaceStorageClass).To(HaveValue(Equal("scratch-sc")))
+		Expect(hasManagedFieldsEntry(cdi, hcoutil.FieldManager, metav1.ManagedFieldsOperationApply)).To(BeTrue())
+		Expect(hasManagedFieldsEntry(cdi, hcoutil.FieldManager, metav1.ManagedFieldsOperationUpdate)).To(BeFalse())
+	})
+
+	It("should not update the CR if nothing was changed", func() {
+		res := ensure()
+		Expect(res.Updated).To(BeFalse())
+		Expect(res.Overwritten).To(BeFalse())
+	})
+
+	It("should keep fields that HCO does not render", func() {
+		cdi := getCDI()
+		cdi.Spec.Config.Preallocation = ptr.To(true)
+		Expect(cl.Update(context.TODO(), cdi, client.FieldOwner("other-controller"))).To(Succeed())
+
+		res := ensure()
+		Expect(res.Updated).To(BeFalse())
+		Expect(res.Overwritten).To(BeFalse())
+
+		Expect(getCDI().Spec.Config.Preallocation).To(HaveValue(BeTrue()))
+	})
+
+	It("should remove fields that HCO does not render anymore", func() {
+		hco.Spec.ScratchSpaceStorageClass = nil
+		res := ensure()
+		Expect(res.Updated).To(BeTrue())
+		Expect(res.Overwritten).To(BeFalse())
+
+		Expect(getCDI().Spec.Config.ScratchSpaceStorageClass).To(BeNil())
+	})
+
+	It("should report the fields that were modified by another manager", func() {
+		cdi := getCDI()
+		cdi.Spec.Config.ScratchSpaceStorageClass = ptr.To("another-sc")
+		cdi.Spec.Config.Preallocation = ptr.To(true)
+		Expect(cl.Update(context.TODO(), cdi, client.FieldOwner("kubectl-edit"))).To(Succeed())
+
+		// even if the reconciliation was triggered by a change in the HyperConverged CR
+		req.HCOTriggered = true
+		res := ensure()
+		Expect(res.Updated).To(BeTrue())
+		Expect(res.Overwritten).To(BeTrue())
+		Expect(res.OverwrittenFields).To(Equal([]string{".spec.config.scratchSpaceStorageClass (by kubectl-edit)"}))
+
+		cdi = getCDI()
+		Expect(cdi.Spec.Config.ScratchSpaceStorageClass).To(HaveValue(Equal("scratch-sc")))
+		Expect(cdi.Spec.Config.Preallocation).To(HaveValue(BeTrue()))
+	})
+
+	It("should not report a change of the HyperConverged CR as an out-of-band modification", func() {
+		hco.Spec.ScratchSpaceStorageClass = ptr.To("another-sc")
+
+		req.HCOTriggered = false
+		res := ensure()
+		Expect(res.Updated).To(BeTrue())
+		Expect(res.Overwritten).To(BeFalse())
+		Expect(res.OverwrittenFields).To(BeEmpty())
+	})
+
+	It("should emit an event with the overwritten fields", func() {
+		cdi := getCDI()
+		cdi.Spec.Config.ScratchSpaceStorageClass = ptr.To("another-sc")
+		Expect(cl.Update(context.TODO(), cdi, client.FieldOwner("kubectl-edit"))).To(Succeed())
+
+		eventEmitter := commontestutils.NewEventEmitterMock()
+		operandHandler := NewOperandHandler(cl, commontestutils.GetScheme(), commontestutils.ClusterInfoMock{}, eventEmitter)
+		operandHandler.handleUpdatedOperand(req, ensure())
+
+		Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{
+			{
+				EventType: corev1.EventTypeWarning,
+				Reason:    "Overwritten",
+				Msg:       "Overwritten CDI cdi-kubevirt-hyperconverged; fields modified by another manager: .spec.config.scratchSpaceStorageClass (by kubectl-edit)",
+			},
+		})).To(BeTrue())
+	})
+})
@@ -7,10 +7,11 @@ import (
 	"os"
 	"path"
 	"path/filepath"
-	"reflect"
 	"sort"
 	"strings"
+	"sync"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
@@ -42,6 +43,9 @@ var (
 	// dataImportCronTemplateHardCodedMap are set of data import cron template configurations. The handler reads a list
 	// of data import cron templates from a local file and updates SSP with the up-to-date list
 	dataImportCronTemplateHardCodedMap map[string]hcov1beta1.DataImportCronTemplate
+	// dataImportCronTemplateLock guards dataImportCronTemplateHardCodedMap, that the SSP handler updates with the
+	// schedule of the HyperConverged status while the other operands, or the webhook requests, are processed
+	dataImportCronTemplateLock sync.RWMutex
 )
 
 func init() {
@@ -58,11 +62,12 @@ func newSspHandler(Client client.Client, Scheme *runtime.Scheme) *sspHandler {
 		Scheme:                 Scheme,
 		crType:                 "SSP",
 		setControllerReference: false,
-		hooks:                  &sspHooks{},
+		hooks:                  &sspHooks{Client: Client},
 	}
 }
 
 type sspHooks struct {
+	Client       client.Client
 	cache        *sspv1beta2.SSP
 	dictStatuses []hcov1beta1.DataImportCronTemplateStatus
 }
@@ -92,37 +97,21 @@ func (h *sspHooks) reset() {
 	h.dictStatuses = nil
 }
 
-func (*sspHooks) updateCr(req *common.HcoRequest, client client.Client, exists runtime.Object, required runtime.Object) (bool, bool, error) {
-	ssp, ok1 := required.(*sspv1beta2.SSP)
-	found, ok2 := exists.(*sspv1beta2.SSP)
-	if !ok1 || !ok2 {
-		return false, false, errors.New("can't convert to SSP")
-	}
-	if !reflect.DeepEqual(ssp.Spec, found.Spec) ||
-		!util.CompareLabels(ssp, found) {
-		if req.HCOTriggered {
-			req.Logger.Info("Updating existing SSP's Spec to new opinionated values")
-		} else {
-			req.Logger.Info("Reconciling an externally updated SSP's Spec to its opinionated values")
-		}
-		util.MergeLabels(&ssp.ObjectMeta, &found.ObjectMeta)
-		ssp.Spec.DeepCopyInto(&found.Spec)
-		err := client.Update(req.Ctx, found)
-		if err != nil {
-			return false, false, err
-		}
-		return true, !req.HCOTriggered, nil
-	}
-	return false, false, nil
-}
-
 func (h *sspHooks) justBeforeComplete(req *common.HcoRequest) {
-	if !reflect.DeepEqual(h.dictStatuses, req.Instance.Status.DataImportCronTemplates) {
+	observeGoldenImages(req, h.Client, h.dictStatuses)
+
+	if !equality.Semantic.DeepEqual(h.dictStatuses, req.Instance.Status.DataImportCronTemplates) {
 		req.Instance.Status.DataImportCronTemplates = h.dictStatuses
 		req.StatusDirty = true
 	}
 }
 
+func (*sspHooks) getOperandName() string { return hcov1beta1.OperandSSP }
+func (*sspHooks) getObservedVersion(cr runtime.Object) string {
+	return cr.(*sspv1beta2.SSP).Status.ObservedVersion
+}
+func (*sspHooks) getVersionEnvName() string { return hcoutil.SspVersionEnvV }
+
 func NewSSP(hc *hcov1beta1.HyperConverged, opts ...string) (*sspv1beta2.SSP, []hcov1beta1.DataImportCronTemplateStatus, error) {
 	templatesNamespace := defaultCommonTemplatesNamespace
 
@@ -175,6 +164,10 @@ func NewSSP(hc *hcov1beta1.HyperConverged, opts ...string) (*sspv1beta2.SSP, []h
 		return nil, nil, err
 	}
 
+	if err := applyOperandOverride(hc, hcov1beta1.OperandSSP, ssp); err != nil {
+		return nil, nil, err
+	}
+
 	return ssp, dataImportCronStatuses, nil
 }
 
@@ -235,6 +228,9 @@ func getDataImportCronTemplates(hc *hcov1beta1.HyperConverged) ([]hcov1beta1.Dat
 		return nil, err
 	}
 
+	dataImportCronTemplateLock.RLock()
+	defer dataImportCronTemplateLock.RUnlock()
+
 	var dictList []hcov1beta1.DataImportCronTemplateStatus
 	if hc.Spec.FeatureGates.EnableCommonBootImageImport != nil && *hc.Spec.FeatureGates.EnableCommonBootImageImport {
 		dictList = getCommonDicts(dictList, crDicts, hc)
@@ -316,6 +312,9 @@ func applyDataImportSchedule(hc *hcov1beta1.HyperConverged) {
 }
 
 func overrideDataImportSchedule(schedule string) {
+	dataImportCronTemplateLock.Lock()
+	defer dataImportCronTemplateLock.Unlock()
+
 	for dictName := range dataImportCronTemplateHardCodedMap {
 		dict := dataImportCronTemplateHardCodedMap[dictName]
 		dict.Spec.Schedule = schedule
@@ -26,6 +26,7 @@ import (
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/metrics"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -1851,6 +1852,206 @@ var _ = Describe("SSP Operands", func() {
 				})
 			})
 
+			Context("test the state of the golden images in Status", func() {
+				const goldenImagesNS = "os-images"
+
+				newDataImportCron := func(name string, upToDate corev1.ConditionStatus, reason string) *cdiv1beta1.DataImportCron {
+					return &cdiv1beta1.DataImportCron{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:              name,
+							Namespace:         goldenImagesNS,
+							CreationTimestamp: metav1.NewTime(time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)),
+						},
+						Spec: cdiv1beta1.DataImportCronSpec{
+							Schedule:          "1 */12 * * *",
+							ManagedDataSource: name,
+						},
+						Status: cdiv1beta1.DataImportCronStatus{
+							Conditions: []cdiv1beta1.DataImportCronCondition{
+								{
+									Type: cdiv1beta1.DataImportCronUpToDate,
+									ConditionState: cdiv1beta1.ConditionState{
+										Status:             upToDate,
+										Reason:             reason,
+										Message:            "some message",
+										LastTransitionTime: metav1.NewTime(time.Date(2024, time.June, 2, 0, 0, 0, 0, time.UTC)),
+									},
+								},
+							},
+						},
+					}
+				}
+
+				newDataSource := func(name string, ready corev1.ConditionStatus, reason string) *cdiv1beta1.DataSource {
+					return &cdiv1beta1.DataSource{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      name,
+							Namespace: goldenImagesNS,
+						},
+						Status: cdiv1beta1.DataSourceStatus{
+							Conditions: []cdiv1beta1.DataSourceCondition{
+								{
+									Type: cdiv1beta1.DataSourceReady,
+									ConditionState: cdiv1beta1.ConditionState{
+										Status: ready,
+										Reason: reason,
+									},
+								},
+							},
+						},
+					}
+				}
+
+				getDictStatus := func(name string) hcov1beta1.DataImportCronStatus {
+					for _, dict := range hco.Status.DataImportCronTemplates {
+						if dict.Name == name {
+							return dict.Status
+						}
+					}
+					Fail("can't find the " + name + " DataImportCronTemplate in the status")
+					return hcov1beta1.DataImportCronStatus{}
+				}
+
+				BeforeEach(func() {
+					hco.Spec.FeatureGates.EnableCommonBootImageImport = ptr.To(false)
+					hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{image1, image2}
+				})
+
+				It("should report the state of the healthy golden images", func() {
+					lastImport := metav1.NewTime(time.Date(2024, time.June, 2, 0, 0, 12, 0, time.UTC))
+					cron := newDataImportCron(image1.Name, corev1.ConditionTrue, "UpToDate")
+					cron.Annotations = map[string]string{cdiSourceDesiredDigestAnnotation: "sha256:12345"}
+					cron.Status.LastImportTimestamp = &lastImport
+
+					cl := commontestutils.InitClient([]client.Object{cron, newDataSource(image1.Name, corev1.ConditionTrue, "Ready")})
+					handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+					res := handler.ensure(req)
+					Expect(res.Err).ToNot(HaveOccurred())
+					Expect(req.StatusDirty).To(BeTrue())
+
+					status := getDictStatus(image1.Name)
+					Expect(status.LastImportTimestamp).ToNot(BeNil())
+					Expect(status.LastImportTimestamp.Time).To(BeTemporally("==", lastImport.Time))
+					Expect(status.CurrentDigest).To(Equal("sha256:12345"))
+					Expect(status.FailingCondition).To(BeNil())
+
+					Expect(metrics.GetDataImportCronLastImportTimestamp(goldenImagesNS, image1.Name)).To(BeEquivalentTo(lastImport.Unix()))
+					Expect(metrics.GetDataImportCronDigest(goldenImagesNS, image1.Name, "sha256:12345")).To(BeEquivalentTo(1))
+					Expect(metrics.GetDataImportCronFailing(goldenImagesNS, image1.Name, "")).To(BeEquivalentTo(0))
+					Expect(metrics.GetDataImportCronSchedulePeriod(goldenImagesNS, image1.Name)).To(BeEquivalentTo((12 * time.Hour).Seconds()))
+				})
+
+				It("should report the failing condition of the DataImportCron", func() {
+					cron := newDataImportCron(image1.Name, corev1.ConditionFalse, "ImportFailed")
+
+					cl := commontestutils.InitClient([]client.Object{cron, newDataSource(image1.Name, corev1.ConditionTrue, "Ready")})
+					handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+					res := handler.ensure(req)
+					Expect(res.Err).ToNot(HaveOccurred())
+
+					status := getDictStatus(image1.Name)
+					Expect(status.LastImportTimestamp).To(BeNil())
+					Expect(status.CurrentDigest).To(BeEmpty())
+					Expect(status.FailingCondition).ToNot(BeNil())
+					Expect(status.FailingCondition.Type).To(Equal("UpToDate"))
+					Expect(status.FailingCondition.Status).To(Equal(metav1.ConditionFalse))
+					Expect(status.FailingCondition.Reason).To(Equal("ImportFailed"))
+					Expect(status.FailingCondition.Message).To(Equal("some message"))
+					Expect(status.FailingCondition.LastTransitionTime.Time).To(BeTemporally("==", cron.Status.Conditions[0].LastTransitionTime.Time))
+
+					Expect(metrics.GetDataImportCronLastImportTimestamp(goldenImagesNS, image1.Name)).To(BeEquivalentTo(cron.CreationTimestamp.Unix()))
+					Expect(metrics.GetDataImportCronFailing(goldenImagesNS, image1.Name, "ImportFailed")).To(BeEquivalentTo(1))
+				})
+
+				It("should report the failing condition of the DataSource", func() {
+					cron := newDataImportCron(image1.Name, corev1.ConditionTrue, "UpToDate")
+
+					cl := commontestutils.InitClient([]client.Object{cron, newDataSource(image1.Name, corev1.ConditionFalse, "not ready")})
+					handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+					res := handler.ensure(req)
+					Expect(res.Err).ToNot(HaveOccurred())
+
+					status := getDictStatus(image1.Name)
+					Expect(status.FailingCondition).ToNot(BeNil())
+					Expect(status.FailingCondition.Type).To(Equal("Ready"))
+					Expect(status.FailingCondition.Status).To(Equal(metav1.ConditionFalse))
+					Expect(status.FailingCondition.Reason).To(Equal(unknownReason))
+					Expect(status.FailingCondition.LastTransitionTime.IsZero()).To(BeFalse())
+
+					Expect(metrics.GetDataImportCronFailing(goldenImagesNS, image1.Name, unknownReason)).To(BeEquivalentTo(1))
+				})
+
+				It("should report the missing DataImportCrons and DataSources", func() {
+					cron := newDataImportCron(image1.Name, corev1.ConditionTrue, "UpToDate")
+
+					cl := commontestutils.InitClient([]client.Object{cron})
+					handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+					res := handler.ensure(req)
+					Expect(res.Err).ToNot(HaveOccurred())
+
+					status := getDictStatus(image1.Name)
+					Expect(status.FailingCondition).ToNot(BeNil())
+					Expect(status.FailingCondition.Type).To(Equal("Ready"))
+					Expect(status.FailingCondition.Reason).To(Equal(dataSourceNotFoundReason))
+
+					status = getDictStatus(image2.Name)
+					Expect(status.FailingCondition).ToNot(BeNil())
+					Expect(status.FailingCondition.Type).To(Equal("UpToDate"))
+					Expect(status.FailingCondition.Status).To(Equal(metav1.ConditionFalse))
+					Expect(status.FailingCondition.Reason).To(Equal(dataImportCronNotFoundReason))
+				})
+
+				It("should keep the transition time of a failing condition that did not change", func() {
+					transitionTime := metav1.NewTime(time.Date(2024, time.June, 3, 0, 0, 0, 0, time.UTC))
+					hco.Status.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplateStatus{
+						{
+							DataImportCronTemplate: image2,
+							Status: hcov1beta1.DataImportCronStatus{
+								FailingCondition: &metav1.Condition{
+									Type:               "UpToDate",
+									Status:             metav1.ConditionFalse,
+									Reason:             dataImportCronNotFoundReason,
+									LastTransitionTime: transitionTime,
+								},
+							},
+						},
+					}
+
+					cl := commontestutils.InitClient([]client.Object{})
+					handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+					res := handler.ensure(req)
+					Expect(res.Err).ToNot(HaveOccurred())
+
+					status := getDictStatus(image2.Name)
+					Expect(status.FailingCondition).ToNot(BeNil())
+					Expect(status.FailingCondition.LastTransitionTime.Time).To(BeTemporally("==", transitionTime.Time))
+
+					status = getDictStatus(image1.Name)
+					Expect(status.FailingCondition).ToNot(BeNil())
+					Expect(status.FailingCondition.LastTransitionTime.Time).ToNot(BeTemporally("==", transitionTime.Time))
+				})
+
+				It("should find the DataImportCron in the namespace of the template", func() {
+					otherCron := newDataImportCron(image1.Name, corev1.ConditionFalse, "ImportFailed")
+					cron := newDataImportCron(image1.Name, corev1.ConditionTrue, "UpToDate")
+					cron.Namespace = customNS
+					dataSource := newDataSource(image1.Name, corev1.ConditionTrue, "Ready")
+					dataSource.Namespace = customNS
+
+					customImage1 := image1.DeepCopy()
+					customImage1.Namespace = customNS
+					hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{*customImage1}
+
+					cl := commontestutils.InitClient([]client.Object{otherCron, cron, dataSource})
+					handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+					res := handler.ensure(req)
+					Expect(res.Err).ToNot(HaveOccurred())
+
+					Expect(getDictStatus(image1.Name).FailingCondition).To(BeNil())
+					Expect(metrics.GetDataImportCronFailing(customNS, image1.Name, "")).To(BeEquivalentTo(0))
+				})
+			})
+
 			Context("test isDataImportCronTemplateEnabled", func() {
 				var image *hcov1beta1.DataImportCronTemplate
 
@@ -23,10 +23,10 @@ ${KUBECTL_BINARY} get hco -n "${INSTALLED_NAMESPACE}" kubevirt-hyperconverged -o
 
 CERTCONFIGDEFAULTS='{"ca":{"duration":"48h0m0s","renewBefore":"24h0m0s"},"server":{"duration":"24h0m0s","renewBefore":"12h0m0s"}}'
 FGDEFAULTS='{"deployKubeSecondaryDNS":false,"deployKubevirtIpamController":false,"deployTektonTaskResources":false,"disableMDevConfiguration":false,"enableCommonBootImageImport":true,"persistentReservation":false,"nonRoot":true,"withHostPassthroughCPU":false}'
-LMDEFAULTS='{"allowAutoConverge":false,"allowPostCopy":false,"completionTimeoutPerGiB":800,"parallelMigrationsPerCluster":5,"parallelOutboundMigrationsPerNode":2,"progressTimeout":150}'
+LMDEFAULTS='{"allowAutoConverge":false,"allowPostCopy":false,"completionTimeoutPerGiB":800,"parallelOutboundMigrationsPerNode":2,"progressTimeout":150}'
 PERMITTED_HOST_DEVICES_DEFAULT1='{"pciDeviceSelector":"10DE:1DB6","resourceName":"nvidia.com/GV100GL_Tesla_V100"}'
 PERMITTED_HOST_DEVICES_DEFAULT2='{"pciDeviceSelector":"10DE:1EB8","resourceName":"nvidia.com/TU104GL_Tesla_T4"}'
-WORKLOAD_UPDATE_STRATEGY_DEFAULT='{"batchEvictionInterval":"1m0s","batchEvictionSize":10,"workloadUpdateMethods":["LiveMigrate"]}'
+WORKLOAD_UPDATE_STRATEGY_DEFAULT='{"batchEvictionInterval":"1m0s","batchEvictionSize":10}'
 UNINSTALL_STRATEGY_DEFAULT='BlockUninstallIfWorkloadsExist'
 
 CERTCONFIGPATHS=(
@@ -50,7 +50,6 @@ FGPATHS=(
 )
 
 LMPATHS=(
-    "/spec/liveMigrationConfig/parallelMigrationsPerCluster"
     "/spec/liveMigrationConfig/parallelOutboundMigrationsPerNode"
     "/spec/liveMigrationConfig/completionTimeoutPerGiB"
     "/spec/liveMigrationConfig/progressTimeout"
@@ -61,7 +60,6 @@ LMPATHS=(
 )
 
 WORKLOAD_UPDATE_STRATEGY_PATHS=(
-    "/spec/workloadUpdateStrategy/workloadUpdateMethods"
     "/spec/workloadUpdateStrategy/batchEvictionSize"
     "/spec/workloadUpdateStrategy/batchEvictionInterval"
     "/spec/workloadUpdateStrategy"
@@ -138,6 +138,9 @@ fi
 echo "Creating resources for webhooks"
 "${CMD}" apply $LABEL_SELECTOR_ARG -f _out/webhooks.yaml
 
+# let cert-manager issue the webhook certificates before the webhook starts, so it does not start with self-managed ones
+"${CMD}" wait certificate/hyperconverged-cluster-webhook-service-cert --for=condition=Ready --timeout="300s"
+
 if [ "${CI}" != "true" ]; then
 	"${CMD}" apply $LABEL_SELECTOR_ARG -f _out/operator.yaml
 else
@@ -2,6 +2,7 @@ package alertmanager
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -46,13 +47,13 @@ func NewAPI(httpClient http.Client, host string, token string) *Api {
 	}
 }
 
-func (api *Api) ListSilences() ([]Silence, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/v2/silences", api.host), nil)
+func (api *Api) ListSilences(ctx context.Context) ([]Silence, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/api/v2/silences", api.host), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Add("Authorization", "Bearer "+api.token)
+	api.addAuthHeader(req)
 
 	resp, err := api.httpClient.Do(req)
 	if err != nil {
@@ -74,46 +75,68 @@ func (api *Api) ListSilences() ([]Silence, error) {
 	return amSilences, nil
 }
 
-func (api *Api) CreateSilence(s Silence) error {
+// CreateSilence creates a silence, or updates it if its ID is set, and returns the ID of the silence. Alertmanager
+// may replace the updated silence with a new one, with a new ID.
+func (api *Api) CreateSilence(ctx context.Context, s Silence) (string, error) {
 	body, err := json.Marshal(s)
 	if err != nil {
-		return fmt.Errorf("failed to marshal silence: %w", err)
+		return "", fmt.Errorf("failed to marshal silence: %w", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/api/v2/silences", api.host), bytes.NewReader(body))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/v2/silences", api.host), bytes.NewReader(body))
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return "", fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Add("Authorization", "Bearer "+api.token)
+	api.addAuthHeader(req)
 	req.Header.Add("Content-Type", "application/json")
 
 	resp, err := api.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to create silence: %w", err)
+		return "", fmt.Errorf("failed to create silence: %w", err)
 	}
+	defer resp.Body.Close()
+
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("failed to create silence: %s", resp.Status)
+		return "", fmt.Errorf("failed to create silence: %s", resp.Status)
 	}
 
-	return nil
+	var created struct {
+		SilenceID string `json:"silenceID"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&created)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return created.SilenceID, nil
 }
 
-func (api *Api) DeleteSilence(id string) error {
-	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/api/v2/silence/%s", api.host, id), nil)
+func (api *Api) DeleteSilence(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/api/v2/silence/%s", api.host, id), nil)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Add("Authorization", "Bearer "+api.token)
+	api.addAuthHeader(req)
 
 	resp, err := api.httpClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to delete silence: %w", err)
 	}
+	defer resp.Body.Close()
+
 	if resp.StatusCode != http.StatusOK {
 		return fmt.Errorf("failed to delete silence: %s", resp.Status)
 	}
 
 	return nil
 }
+
+// addAuthHeader adds the bearer token to the request. Without a token, the request is either not authenticated, or
+// authenticated by the client certificate of the http client.
+func (api *Api) addAuthHeader(req *http.Request) {
+	if api.token != "" {
+		req.Header.Add("Authorization", "Bearer "+api.token)
+	}
+}
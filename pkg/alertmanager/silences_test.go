@@ -1,10 +1,12 @@
 package alertmanager_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -14,6 +16,8 @@ import (
 
 const listResp = `[{"id":"bb881d7f-3278-46fd-a638-d42c57f235b6","status":{"state":"active"},"updatedAt":"2024-07-16T11:46:30.653Z","comment":"test purposes","createdBy":"test_user","endsAt":"3000-01-01T00:00:00.000Z","matchers":[{"isEqual":true,"isRegex":false,"name":"alertname","value":"TestAlert"}],"startsAt":"2024-07-16T11:46:30.653Z"}]`
 
+const createResp = `{"silenceID":"9d9b7b6e-8c1f-4d4a-9a7e-0b3e2a1c5f60"}`
+
 var _ = Describe("Silences", func() {
 	var (
 		ts  *httptest.Server
@@ -27,11 +31,16 @@ var _ = Describe("Silences", func() {
 				return
 			}
 
+			if r.Header.Get("Authorization") != "Bearer token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
 			switch r.Method {
 			case http.MethodGet:
 				fmt.Fprintln(w, listResp)
 			case http.MethodPost:
-				w.WriteHeader(http.StatusOK)
+				fmt.Fprintln(w, createResp)
 			case http.MethodDelete:
 				w.WriteHeader(http.StatusOK)
 			}
@@ -45,7 +54,7 @@ var _ = Describe("Silences", func() {
 	})
 
 	It("should successfully GET /api/v2/silences", func() {
-		silences, err := api.ListSilences()
+		silences, err := api.ListSilences(context.Background())
 		Expect(err).ToNot(HaveOccurred())
 		Expect(silences).To(HaveLen(1))
 
@@ -58,12 +67,59 @@ var _ = Describe("Silences", func() {
 	})
 
 	It("should successfully POST /api/v2/silences", func() {
-		err := api.CreateSilence(alertmanager.Silence{})
+		id, err := api.CreateSilence(context.Background(), alertmanager.Silence{})
 		Expect(err).ToNot(HaveOccurred())
+		Expect(id).To(Equal("9d9b7b6e-8c1f-4d4a-9a7e-0b3e2a1c5f60"))
+	})
+
+	It("should not send the Authorization header without a token", func() {
+		api = alertmanager.NewAPI(http.Client{}, ts.URL, "")
+
+		_, err := api.ListSilences(context.Background())
+		Expect(err).To(MatchError(ContainSubstring("401 Unauthorized")))
 	})
 
 	It("should successfully DELETE /api/v2/silences/{id}", func() {
-		err := api.DeleteSilence("bb881d7f-3278-46fd-a638-d42c57f235b6")
+		err := api.DeleteSilence(context.Background(), "bb881d7f-3278-46fd-a638-d42c57f235b6")
 		Expect(err).ToNot(HaveOccurred())
 	})
+
+	Context("Alertmanager doesn't answer", func() {
+		var (
+			hangingServer *httptest.Server
+			done          chan struct{}
+		)
+
+		BeforeEach(func() {
+			done = make(chan struct{})
+			hangingServer = httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				select {
+				case <-r.Context().Done():
+				case <-done:
+				}
+			}))
+		})
+
+		AfterEach(func() {
+			close(done)
+			hangingServer.Close()
+		})
+
+		It("should stop waiting when the context is done", func(ctx context.Context) {
+			api = alertmanager.NewAPI(http.Client{}, hangingServer.URL, "token")
+
+			reqCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer cancel()
+
+			_, err := api.ListSilences(reqCtx)
+			Expect(err).To(MatchError(context.DeadlineExceeded))
+		}, NodeTimeout(10*time.Second))
+
+		It("should stop waiting after the timeout of the http client", func(ctx context.Context) {
+			api = alertmanager.NewAPI(http.Client{Timeout: 100 * time.Millisecond}, hangingServer.URL, "token")
+
+			err := api.DeleteSilence(ctx, "bb881d7f-3278-46fd-a638-d42c57f235b6")
+			Expect(err).To(MatchError(ContainSubstring("Client.Timeout exceeded")))
+		}, NodeTimeout(10*time.Second))
+	})
 })
@@ -50,6 +50,10 @@ const (
 	hcoWhDeploymentName = "hco-webhook"
 	certVolume          = "apiservice-cert"
 
+	// the namespace of the non-OLM installation (deploy/)
+	defaultOperatorNamespace      = "kubevirt-hyperconverged"
+	certManagerInjectCAAnnotation = "cert-manager.io/inject-ca-from"
+
 	cliDownloadsName = "hyperconverged-cluster-cli-download"
 
 	kubevirtProjectName = "KubeVirt project"
@@ -101,7 +105,7 @@ func GetDeploymentOperator(params *DeploymentOperatorParams) appsv1.Deployment {
 	}
 }
 
-func GetDeploymentWebhook(namespace, image, imagePullPolicy, hcoKvIoVersion string, env []corev1.EnvVar) appsv1.Deployment {
+func GetDeploymentWebhook(namespace, image, imagePullPolicy, hcoKvIoVersion, machineType string, env []corev1.EnvVar) appsv1.Deployment {
 	deploy := appsv1.Deployment{
 		TypeMeta: deploymentType,
 		ObjectMeta: metav1.ObjectMeta{
@@ -110,10 +114,17 @@ func GetDeploymentWebhook(namespace, image, imagePullPolicy, hcoKvIoVersion stri
 				"name": hcoNameWebhook,
 			},
 		},
-		Spec: GetDeploymentSpecWebhook(namespace, image, imagePullPolicy, hcoKvIoVersion, env),
+		Spec: GetDeploymentSpecWebhook(namespace, image, imagePullPolicy, hcoKvIoVersion, machineType, env),
 	}
 
 	InjectVolumesForWebHookCerts(&deploy)
+	// without cert-manager, the secret does not exist, and the webhook generates its own certificates
+	for _, vol := range deploy.Spec.Template.Spec.Volumes {
+		if vol.Name == certVolume {
+			vol.Secret.Optional = ptr.To(true)
+		}
+	}
+
 	return deploy
 }
 
@@ -375,7 +386,7 @@ func GetStdContainerSecurityContext() *v1.SecurityContext {
 // in the meanwhile a quick (but dirty!) solution is to expose the same hco binary on two distinct pods:
 // the first one will run only the controller and the second one (almost always ready) just the validating
 // webhook one.
-func GetDeploymentSpecWebhook(namespace, image, imagePullPolicy, hcoKvIoVersion string, env []corev1.EnvVar) appsv1.DeploymentSpec {
+func GetDeploymentSpecWebhook(namespace, image, imagePullPolicy, hcoKvIoVersion, machineType string, env []corev1.EnvVar) appsv1.DeploymentSpec {
 	return appsv1.DeploymentSpec{
 		Replicas: int32Ptr(1),
 		Selector: &metav1.LabelSelector{
@@ -431,6 +442,11 @@ func GetDeploymentSpecWebhook(namespace, image, imagePullPolicy, hcoKvIoVersion
 									},
 								},
 							},
+							{
+								// the webhook warns about the deprecated MACHINETYPE variable of the operator
+								Name:  "MACHINETYPE",
+								Value: machineType,
+							},
 						}, env...),
 						Resources: v1.ResourceRequirements{
 							Requests: map[v1.ResourceName]resource.Quantity{
@@ -480,11 +496,17 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 			Resources: stringListToSlice("hyperconvergeds/finalizers", "hyperconvergeds/status"),
 			Verbs:     stringListToSlice("get", "list", "create", "update", "watch"),
 		},
-		roleWithAllPermissions(kvapi.GroupName, stringListToSlice("kubevirts", "kubevirts/finalizers")),
-		roleWithAllPermissions(cdiapi.GroupName, stringListToSlice("cdis", "cdis/finalizers")),
-		roleWithAllPermissions(sspapi.GroupVersion.Group, stringListToSlice("ssps", "ssps/finalizers")),
+		roleWithApplyPermissions(kvapi.GroupName, stringListToSlice("kubevirts", "kubevirts/finalizers")),
+		roleWithApplyPermissions(cdiapi.GroupName, stringListToSlice("cdis", "cdis/finalizers")),
+		{
+			APIGroups: stringListToSlice(cdiapi.GroupName),
+			Resources: stringListToSlice("dataimportcrons", "datasources"),
+			Verbs:     stringListToSlice("get", "list", "watch"),
+		},
+		roleWithApplyPermissions(sspapi.GroupVersion.Group, stringListToSlice("ssps", "ssps/finalizers")),
 		roleWithAllPermissions(cnaoapi.GroupVersion.Group, stringListToSlice("networkaddonsconfigs", "networkaddonsconfigs/finalizers")),
-		roleWithAllPermissions(aaqapi.GroupName, stringListToSlice("aaqs", "aaqs/finalizers")),
+		roleWithApplyPermissions(aaqapi.GroupName, stringListToSlice("aaqs", "aaqs/finalizers")),
+		roleWithApplyPermissions("hostpathprovisioner.kubevirt.io", stringListToSlice("hostpathprovisioners", "hostpathprovisioners/finalizers")),
 		roleWithAllPermissions("", stringListToSlice("configmaps")),
 		{
 			APIGroups: emptyAPIGroup,
@@ -500,7 +522,27 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 		{
 			APIGroups: emptyAPIGroup,
 			Resources: stringListToSlice("nodes"),
-			Verbs:     stringListToSlice("get", "list"),
+			Verbs:     stringListToSlice("get", "list", "watch"),
+		},
+		{
+			APIGroups: stringListToSlice("storage.k8s.io"),
+			Resources: stringListToSlice("storageclasses"),
+			Verbs:     stringListToSlice("get", "list", "watch"),
+		},
+		{
+			APIGroups: stringListToSlice("node.k8s.io"),
+			Resources: stringListToSlice("runtimeclasses"),
+			Verbs:     stringListToSlice("get", "list", "watch"),
+		},
+		{
+			APIGroups: stringListToSlice("k8s.cni.cncf.io"),
+			Resources: stringListToSlice("network-attachment-definitions"),
+			Verbs:     stringListToSlice("get", "list", "watch"),
+		},
+		{
+			APIGroups: stringListToSlice("machineconfiguration.openshift.io"),
+			Resources: stringListToSlice("kubeletconfigs"),
+			Verbs:     stringListToSlice("list", "watch"),
 		},
 		{
 			APIGroups: emptyAPIGroup,
@@ -523,6 +565,12 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 			Resources: stringListToSlice("customresourcedefinitions"),
 			Verbs:     stringListToSlice("get", "list", "watch", "delete"),
 		},
+		{
+			APIGroups:     stringListToSlice("apiextensions.k8s.io"),
+			Resources:     stringListToSlice("customresourcedefinitions"),
+			ResourceNames: stringListToSlice(hcoutil.HyperConvergedCRDName),
+			Verbs:         stringListToSlice("update", "patch"),
+		},
 		{
 			APIGroups: stringListToSlice("apiextensions.k8s.io"),
 			Resources: stringListToSlice("customresourcedefinitions/status"),
@@ -542,7 +590,18 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 		{
 			APIGroups: stringListToSlice("admissionregistration.k8s.io"),
 			Resources: stringListToSlice("validatingwebhookconfigurations"),
-			Verbs:     stringListToSlice("list", "watch", "update", "patch"),
+			Verbs:     stringListToSlice("get", "list", "watch", "update", "patch"),
+		},
+		{
+			APIGroups: stringListToSlice("admissionregistration.k8s.io"),
+			Resources: stringListToSlice("mutatingwebhookconfigurations"),
+			Verbs:     stringListToSlice("list", "watch"),
+		},
+		{
+			APIGroups:     stringListToSlice("admissionregistration.k8s.io"),
+			Resources:     stringListToSlice("mutatingwebhookconfigurations"),
+			ResourceNames: stringListToSlice(hcoutil.MutatingWebhookConfigurationName),
+			Verbs:         stringListToSlice("get", "update", "patch"),
 		},
 		roleWithAllPermissions("console.openshift.io", stringListToSlice("consoleclidownloads", "consolequickstarts")),
 		{
@@ -590,6 +649,13 @@ func roleWithAllPermissions(apiGroup string, resources []string) rbacv1.PolicyRu
 	}
 }
 
+// roleWithApplyPermissions is for the operand CRs that HCO reconciles with server-side apply
+func roleWithApplyPermissions(apiGroup string, resources []string) rbacv1.PolicyRule {
+	rule := roleWithAllPermissions(apiGroup, resources)
+	rule.Verbs = append(rule.Verbs, "patch")
+	return rule
+}
+
 func GetServiceAccount(namespace string) v1.ServiceAccount {
 	return v1.ServiceAccount{
 		TypeMeta: metav1.TypeMeta{
@@ -694,9 +760,35 @@ func GetOperatorCRD(relPath string) *extv1.CustomResourceDefinition {
 			},
 		}
 	}
+	setConversionWebhook(&c)
 	return &c
 }
 
+// setConversionWebhook points the CRD to the HCO conversion webhook. OLM replaces this stanza with the one from the
+// ConversionWebhook of the CSV; without OLM, it is applied as is, and cert-manager (or the HCO webhook itself, when
+// it manages its own certificates) injects the CA bundle.
+func setConversionWebhook(crd *extv1.CustomResourceDefinition) {
+	if crd.Annotations == nil {
+		crd.Annotations = make(map[string]string)
+	}
+	crd.Annotations[certManagerInjectCAAnnotation] = fmt.Sprintf("%s/%s-cert", defaultOperatorNamespace, util.WebhookServiceName)
+
+	crd.Spec.Conversion = &extv1.CustomResourceConversion{
+		Strategy: extv1.WebhookConverter,
+		Webhook: &extv1.WebhookConversion{
+			ClientConfig: &extv1.WebhookClientConfig{
+				Service: &extv1.ServiceReference{
+					Namespace: defaultOperatorNamespace,
+					Name:      util.WebhookServiceName,
+					Path:      ptr.To(util.HCOConvertWebhookPath),
+					Port:      ptr.To[int32](util.WebhookPort),
+				},
+			},
+			ConversionReviewVersions: stringListToSlice("v1beta1", "v1"),
+		},
+	}
+}
+
 func GetOperatorCR() *hcov1beta1.HyperConverged {
 	defaultScheme := runtime.NewScheme()
 	_ = hcov1beta1.AddToScheme(defaultScheme)
@@ -725,7 +817,7 @@ func GetInstallStrategyBase(params *DeploymentOperatorParams) *csvv1alpha1.Strat
 			},
 			{
 				Name:  hcoWhDeploymentName,
-				Spec:  GetDeploymentSpecWebhook(params.Namespace, params.WebhookImage, params.ImagePullPolicy, params.HcoKvIoVersion, params.Env),
+				Spec:  GetDeploymentSpecWebhook(params.Namespace, params.WebhookImage, params.ImagePullPolicy, params.HcoKvIoVersion, params.Machinetype, params.Env),
 				Label: getLabels(hcoNameWebhook, params.HcoKvIoVersion),
 			},
 			{
@@ -805,6 +897,112 @@ func GetCSVBase(params *CSVBaseParams) *csvv1alpha1.ClusterServiceVersion {
 		WebhookPath: ptr.To(util.HCOWebhookPath),
 	}
 
+	// Only the operator updates the status of the HyperConverged CR. The status guard protects it from manual edits,
+	// but it should never block the status updates of the operator if the webhook is not available:
+	// failurePolicy = admissionregistrationv1.Ignore
+	statusValidatingWebhook := csvv1alpha1.WebhookDescription{
+		GenerateName:            util.HcoStatusValidatingWebhook,
+		Type:                    csvv1alpha1.ValidatingAdmissionWebhook,
+		DeploymentName:          hcoWhDeploymentName,
+		ContainerPort:           util.WebhookPort,
+		AdmissionReviewVersions: stringListToSlice("v1beta1", "v1"),
+		SideEffects:             ptr.To(admissionregistrationv1.SideEffectClassNone),
+		FailurePolicy:           ptr.To(admissionregistrationv1.Ignore),
+		TimeoutSeconds:          ptr.To[int32](10),
+		Rules: []admissionregistrationv1.RuleWithOperations{
+			{
+				Operations: []admissionregistrationv1.OperationType{
+					admissionregistrationv1.Update,
+				},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   stringListToSlice(util.APIVersionGroup),
+					APIVersions: stringListToSlice(util.APIVersionAlpha, util.APIVersionBeta),
+					Resources:   stringListToSlice("hyperconvergeds/status"),
+				},
+			},
+		},
+		WebhookPath: ptr.To(util.HCOWebhookPath),
+	}
+
+	// The operand guard is optional, and it is controlled by the HyperConverged CR, so it should never block the
+	// operand CRs if the webhook is not available: failurePolicy = admissionregistrationv1.Ignore
+	operandsValidatingWebhook := csvv1alpha1.WebhookDescription{
+		GenerateName:            util.HcoOperandsValidatingWebhook,
+		Type:                    csvv1alpha1.ValidatingAdmissionWebhook,
+		DeploymentName:          hcoWhDeploymentName,
+		ContainerPort:           util.WebhookPort,
+		AdmissionReviewVersions: stringListToSlice("v1beta1", "v1"),
+		SideEffects:             ptr.To(admissionregistrationv1.SideEffectClassNone),
+		FailurePolicy:           ptr.To(admissionregistrationv1.Ignore),
+		TimeoutSeconds:          ptr.To[int32](10),
+		ObjectSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{util.AppLabel: util.HyperConvergedName},
+		},
+		Rules: []admissionregistrationv1.RuleWithOperations{
+			{
+				Operations: []admissionregistrationv1.OperationType{
+					admissionregistrationv1.Update,
+				},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   stringListToSlice("kubevirt.io"),
+					APIVersions: stringListToSlice("v1"),
+					Resources:   stringListToSlice("kubevirts"),
+				},
+			},
+			{
+				Operations: []admissionregistrationv1.OperationType{
+					admissionregistrationv1.Update,
+				},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   stringListToSlice("cdi.kubevirt.io"),
+					APIVersions: stringListToSlice("v1beta1"),
+					Resources:   stringListToSlice("cdis"),
+				},
+			},
+			{
+				Operations: []admissionregistrationv1.OperationType{
+					admissionregistrationv1.Update,
+				},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   stringListToSlice("networkaddonsoperator.network.kubevirt.io"),
+					APIVersions: stringListToSlice("v1"),
+					Resources:   stringListToSlice("networkaddonsconfigs"),
+				},
+			},
+			{
+				Operations: []admissionregistrationv1.OperationType{
+					admissionregistrationv1.Update,
+				},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   stringListToSlice("ssp.kubevirt.io"),
+					APIVersions: stringListToSlice("v1beta2"),
+					Resources:   stringListToSlice("ssps"),
+				},
+			},
+			{
+				Operations: []admissionregistrationv1.OperationType{
+					admissionregistrationv1.Update,
+				},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   stringListToSlice("aaq.kubevirt.io"),
+					APIVersions: stringListToSlice("v1alpha1"),
+					Resources:   stringListToSlice("aaqs"),
+				},
+			},
+			{
+				Operations: []admissionregistrationv1.OperationType{
+					admissionregistrationv1.Update,
+				},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   stringListToSlice("hostpathprovisioner.kubevirt.io"),
+					APIVersions: stringListToSlice("v1beta1"),
+					Resources:   stringListToSlice("hostpathprovisioners"),
+				},
+			},
+		},
+		WebhookPath: ptr.To(util.HCOOperandsWebhookPath),
+	}
+
 	mutatingNamespaceWebhook := csvv1alpha1.WebhookDescription{
 		GenerateName:            util.HcoMutatingWebhookNS,
 		Type:                    csvv1alpha1.MutatingAdmissionWebhook,
@@ -832,6 +1030,42 @@ func GetCSVBase(params *CSVBaseParams) *csvv1alpha1.ClusterServiceVersion {
 		WebhookPath: ptr.To(util.HCONSWebhookPath),
 	}
 
+	// The namespaces that the HyperConverged CR refers to, like the common boot image namespace, are protected by
+	// another webhook. It intercepts the deletion of any namespace, so it should never block the deletion of the
+	// namespaces if the webhook is not available: failurePolicy = admissionregistrationv1.Ignore
+	mutatingDependentNamespaceWebhook := csvv1alpha1.WebhookDescription{
+		GenerateName:            util.HcoMutatingWebhookDependentNS,
+		Type:                    csvv1alpha1.MutatingAdmissionWebhook,
+		DeploymentName:          hcoWhDeploymentName,
+		ContainerPort:           util.WebhookPort,
+		AdmissionReviewVersions: stringListToSlice("v1beta1", "v1"),
+		SideEffects:             ptr.To(admissionregistrationv1.SideEffectClassNoneOnDryRun),
+		FailurePolicy:           ptr.To(admissionregistrationv1.Ignore),
+		TimeoutSeconds:          ptr.To[int32](10),
+		ObjectSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{
+					Key:      util.KubernetesMetadataName,
+					Operator: metav1.LabelSelectorOpNotIn,
+					Values:   []string{params.Namespace},
+				},
+			},
+		},
+		Rules: []admissionregistrationv1.RuleWithOperations{
+			{
+				Operations: []admissionregistrationv1.OperationType{
+					admissionregistrationv1.Delete,
+				},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   []string{""},
+					APIVersions: stringListToSlice("v1"),
+					Resources:   stringListToSlice("namespaces"),
+				},
+			},
+		},
+		WebhookPath: ptr.To(util.HCONSWebhookPath),
+	}
+
 	mutatingHyperConvergedWebhook := csvv1alpha1.WebhookDescription{
 		GenerateName:            util.HcoMutatingWebhookHyperConverged,
 		Type:                    csvv1alpha1.MutatingAdmissionWebhook,
@@ -857,6 +1091,19 @@ func GetCSVBase(params *CSVBaseParams) *csvv1alpha1.ClusterServiceVersion {
 		WebhookPath: ptr.To(util.HCOMutatingWebhookPath),
 	}
 
+	conversionWebhook := csvv1alpha1.WebhookDescription{
+		GenerateName:            util.HcoConversionWebhook,
+		Type:                    csvv1alpha1.ConversionWebhook,
+		DeploymentName:          hcoWhDeploymentName,
+		ContainerPort:           util.WebhookPort,
+		AdmissionReviewVersions: stringListToSlice("v1beta1", "v1"),
+		SideEffects:             ptr.To(admissionregistrationv1.SideEffectClassNone),
+		FailurePolicy:           ptr.To(admissionregistrationv1.Fail),
+		TimeoutSeconds:          ptr.To[int32](10),
+		ConversionCRDs:          stringListToSlice("hyperconvergeds.hco.kubevirt.io"),
+		WebhookPath:             ptr.To(util.HCOConvertWebhookPath),
+	}
+
 	return &csvv1alpha1.ClusterServiceVersion{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "operators.coreos.com/v1alpha1",
@@ -958,8 +1205,12 @@ func GetCSVBase(params *CSVBaseParams) *csvv1alpha1.ClusterServiceVersion {
 			InstallStrategy: csvv1alpha1.NamedInstallStrategy{},
 			WebhookDefinitions: []csvv1alpha1.WebhookDescription{
 				validatingWebhook,
+				statusValidatingWebhook,
+				operandsValidatingWebhook,
 				mutatingNamespaceWebhook,
+				mutatingDependentNamespaceWebhook,
 				mutatingHyperConvergedWebhook,
+				conversionWebhook,
 			},
 			CustomResourceDefinitions: csvv1alpha1.CustomResourceDefinitions{
 				Owned: []csvv1alpha1.CRDDescription{
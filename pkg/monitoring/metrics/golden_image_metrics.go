@@ -0,0 +1,142 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	ioprometheusclient "github.com/prometheus/client_model/go"
+)
+
+const (
+	labelNamespace      = "ns"
+	labelDataImportCron = "dataimportcron"
+	labelDigest         = "digest"
+
+	goldenImageFailing = 1.0
+	goldenImageHealthy = 0.0
+	digestCurrent      = 1.0
+)
+
+var (
+	goldenImageMetrics = []operatormetrics.Metric{
+		dataImportCronLastImportTimestamp,
+		dataImportCronDigestInfo,
+		dataImportCronFailing,
+		dataImportCronSchedulePeriod,
+	}
+
+	dataImportCronLastImportTimestamp = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_dataimportcron_last_import_timestamp_seconds",
+			Help: "The time of the last successful import of a golden image, in seconds since the epoch; the creation time of the DataImportCron if it never imported",
+		},
+		[]string{labelNamespace, labelDataImportCron},
+	)
+
+	dataImportCronDigestInfo = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_dataimportcron_digest_info",
+			Help: "The digest of the latest source image of a golden image (1); not reported if the DataImportCron did not poll the source yet",
+		},
+		[]string{labelNamespace, labelDataImportCron, labelDigest},
+	)
+
+	dataImportCronFailing = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_dataimportcron_failing",
+			Help: "Indicates whether a golden image is not up-to-date or not ready (1), with the reason of the failing condition, or if it is healthy (0)",
+		},
+		[]string{labelNamespace, labelDataImportCron, labelReason},
+	)
+
+	dataImportCronSchedulePeriod = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_dataimportcron_schedule_period_seconds",
+			Help: "The longest interval between two scheduled polls of the source of a golden image, in seconds",
+		},
+		[]string{labelNamespace, labelDataImportCron},
+	)
+)
+
+// ResetGoldenImages removes the golden image metrics of all the DataImportCrons, before they are reported again
+func ResetGoldenImages() {
+	dataImportCronLastImportTimestamp.Reset()
+	dataImportCronDigestInfo.Reset()
+	dataImportCronFailing.Reset()
+	dataImportCronSchedulePeriod.Reset()
+}
+
+// SetDataImportCronLastImportTimestamp sets the time of the last import of the golden image
+func SetDataImportCronLastImportTimestamp(namespace, name string, lastImport time.Time) {
+	dataImportCronLastImportTimestamp.WithLabelValues(namespace, name).Set(float64(lastImport.Unix()))
+}
+
+// GetDataImportCronLastImportTimestamp returns current value of gauge. If error is not nil then value is undefined
+func GetDataImportCronLastImportTimestamp(namespace, name string) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := dataImportCronLastImportTimestamp.WithLabelValues(namespace, name).Write(dto)
+	value := dto.Gauge.GetValue()
+
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+// SetDataImportCronDigest reports the current digest of the golden image
+func SetDataImportCronDigest(namespace, name, digest string) {
+	dataImportCronDigestInfo.WithLabelValues(namespace, name, digest).Set(digestCurrent)
+}
+
+// GetDataImportCronDigest returns current value of gauge. If error is not nil then value is undefined
+func GetDataImportCronDigest(namespace, name, digest string) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := dataImportCronDigestInfo.WithLabelValues(namespace, name, digest).Write(dto)
+	value := dto.Gauge.GetValue()
+
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+// SetDataImportCronFailing sets the gauge to 1 with the reason of the failing condition, if the golden image is
+// failing, or to 0 with an empty reason, if it is not
+func SetDataImportCronFailing(namespace, name string, failing bool, reason string) {
+	value := goldenImageHealthy
+	if failing {
+		value = goldenImageFailing
+	} else {
+		reason = ""
+	}
+	dataImportCronFailing.WithLabelValues(namespace, name, reason).Set(value)
+}
+
+// GetDataImportCronFailing returns current value of gauge. If error is not nil then value is undefined
+func GetDataImportCronFailing(namespace, name, reason string) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := dataImportCronFailing.WithLabelValues(namespace, name, reason).Write(dto)
+	value := dto.Gauge.GetValue()
+
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+// SetDataImportCronSchedulePeriod sets the longest interval between two scheduled polls of the golden image
+func SetDataImportCronSchedulePeriod(namespace, name string, period time.Duration) {
+	dataImportCronSchedulePeriod.WithLabelValues(namespace, name).Set(period.Seconds())
+}
+
+// GetDataImportCronSchedulePeriod returns current value of gauge. If error is not nil then value is undefined
+func GetDataImportCronSchedulePeriod(namespace, name string) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := dataImportCronSchedulePeriod.WithLabelValues(namespace, name).Write(dto)
+	value := dto.Gauge.GetValue()
+
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
@@ -6,6 +6,9 @@ func SetupMetrics() error {
 	return operatormetrics.RegisterMetrics(
 		operatorMetrics,
 		infrastructureMetrics,
+		reconcileMetrics,
+		upgradeMetrics,
+		goldenImageMetrics,
 	)
 }
 
@@ -10,6 +10,7 @@ import (
 const (
 	counterLabelCompName = "component_name"
 	counterLabelAnnName  = "annotation_name"
+	gaugeLabelOperand    = "operand"
 
 	hyperConvergedExists    = 1.0
 	hyperConvergedNotExists = 0.0
@@ -27,6 +28,7 @@ var (
 		unsafeModifications,
 		hyperConvergedCRExists,
 		systemHealthStatus,
+		reconcilePaused,
 	}
 
 	overwrittenModifications = operatormetrics.NewCounterVec(
@@ -40,7 +42,7 @@ var (
 	unsafeModifications = operatormetrics.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_hco_unsafe_modifications",
-			Help: "Count of unsafe modifications in the HyperConverged annotations",
+			Help: "Count of unsafe modifications in the HyperConverged annotations and in the spec.operandOverrides field",
 		},
 		[]string{counterLabelAnnName},
 	)
@@ -58,6 +60,14 @@ var (
 			Help: "Indicates whether the system health status is healthy (0), warning (1), or error (2), by aggregating the conditions of HCO and its secondary resources",
 		},
 	)
+
+	reconcilePaused = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_reconcile_paused",
+			Help: "Indicates whether HCO stopped reconciling the operand (1) or not (0), according to the spec.reconcilePolicy field of the HyperConverged custom resource",
+		},
+		[]string{gaugeLabelOperand},
+	)
 )
 
 // IncOverwrittenModifications increments counter by 1
@@ -134,6 +144,27 @@ func GetHCOMetricSystemHealthStatus() (float64, error) {
 	return value, nil
 }
 
+// SetReconcilePaused sets the gauge to 1 if the operand is not reconciled, or to 0 if it is
+func SetReconcilePaused(operand string, paused bool) {
+	value := 0.0
+	if paused {
+		value = 1.0
+	}
+	reconcilePaused.WithLabelValues(operand).Set(value)
+}
+
+// GetReconcilePaused returns current value of gauge. If error is not nil then value is undefined
+func GetReconcilePaused(operand string) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := reconcilePaused.WithLabelValues(operand).Write(dto)
+	value := dto.Gauge.GetValue()
+
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
 func getLabelsForObj(kind string, name string) string {
 	return strings.ToLower(kind + "/" + name)
 }
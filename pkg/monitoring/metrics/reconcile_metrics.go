@@ -0,0 +1,225 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	"github.com/prometheus/client_golang/prometheus"
+	ioprometheusclient "github.com/prometheus/client_model/go"
+)
+
+const (
+	labelOperation = "operation"
+	labelReason    = "reason"
+	labelComponent = "component"
+	labelCondition = "condition"
+	labelTrigger   = "trigger"
+
+	conditionTrue  = 1.0
+	conditionFalse = 0.0
+)
+
+// The operations of the operand reconciliation
+const (
+	OperandOperationCreate = "create"
+	OperandOperationUpdate = "update"
+	OperandOperationDelete = "delete"
+)
+
+var (
+	reconcileMetrics = []operatormetrics.Metric{
+		operandEnsureDuration,
+		operandOperations,
+		operandErrors,
+		componentCondition,
+		reconcileDuration,
+		reconcileRequeues,
+	}
+
+	operandEnsureDuration = operatormetrics.NewHistogramVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_operand_ensure_duration_seconds",
+			Help: "Duration of the reconciliation of an operand by HCO, in seconds",
+		},
+		prometheus.HistogramOpts{
+			Buckets: prometheus.ExponentialBuckets(0.005, 2, 12),
+		},
+		[]string{gaugeLabelOperand},
+	)
+
+	operandOperations = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_operand_operations_total",
+			Help: "Count of the create, update and delete operations of HCO on an operand",
+		},
+		[]string{gaugeLabelOperand, labelOperation},
+	)
+
+	operandErrors = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_operand_errors_total",
+			Help: "Count of the failed reconciliations of an operand by HCO, by the reason of the failure",
+		},
+		[]string{gaugeLabelOperand, labelReason},
+	)
+
+	componentCondition = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_component_condition",
+			Help: "Indicates whether the Available, Progressing, Degraded or Upgradeable condition of a component is True (1) or not (0)",
+		},
+		[]string{labelComponent, labelCondition},
+	)
+
+	reconcileDuration = operatormetrics.NewHistogramVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_reconcile_duration_seconds",
+			Help: "Duration of the reconciliation of the HyperConverged custom resource, in seconds, by the source that triggered it",
+		},
+		prometheus.HistogramOpts{
+			Buckets: prometheus.ExponentialBuckets(0.05, 2, 12),
+		},
+		[]string{labelTrigger},
+	)
+
+	reconcileRequeues = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_reconcile_requeues_total",
+			Help: "Count of the reconciliations of the HyperConverged custom resource that were requeued, either on request or because of an error, by the source that triggered them",
+		},
+		[]string{labelTrigger},
+	)
+)
+
+// ObserveOperandEnsure records the duration of the reconciliation of an operand
+func ObserveOperandEnsure(operand string, duration time.Duration) {
+	operandEnsureDuration.WithLabelValues(operand).Observe(duration.Seconds())
+}
+
+// GetOperandEnsureCount returns the number of the recorded reconciliations of an operand. If error is not nil then
+// value is undefined
+func GetOperandEnsureCount(operand string) (uint64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := operandEnsureDuration.WithLabelValues(operand).(prometheus.Histogram).Write(dto)
+	value := dto.Histogram.GetSampleCount()
+
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+// IncOperandOperations increments the counter of the operation on the operand by 1
+func IncOperandOperations(operand, operation string) {
+	operandOperations.WithLabelValues(operand, operation).Inc()
+}
+
+// GetOperandOperationsCount returns current value of counter. If error is not nil then value is undefined
+func GetOperandOperationsCount(operand, operation string) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := operandOperations.WithLabelValues(operand, operation).Write(dto)
+	value := dto.Counter.GetValue()
+
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+// IncOperandErrors increments the counter of the errors of the operand with this reason by 1
+func IncOperandErrors(operand, reason string) {
+	operandErrors.WithLabelValues(operand, reason).Inc()
+}
+
+// GetOperandErrorsCount returns current value of counter. If error is not nil then value is undefined
+func GetOperandErrorsCount(operand, reason string) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := operandErrors.WithLabelValues(operand, reason).Write(dto)
+	value := dto.Counter.GetValue()
+
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+// SetComponentCondition sets the gauge to 1 if the condition of the component is True, or to 0 if it is not
+func SetComponentCondition(component, condition string, isTrue bool) {
+	value := conditionFalse
+	if isTrue {
+		value = conditionTrue
+	}
+	componentCondition.WithLabelValues(component, condition).Set(value)
+}
+
+// GetComponentCondition returns current value of gauge. If error is not nil then value is undefined
+func GetComponentCondition(component, condition string) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := componentCondition.WithLabelValues(component, condition).Write(dto)
+	value := dto.Gauge.GetValue()
+
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+// DeleteComponentConditions removes the condition gauges of the component, when it is no longer deployed
+func DeleteComponentConditions(component string) {
+	componentCondition.DeletePartialMatch(prometheus.Labels{labelComponent: component})
+}
+
+// GetComponentConditionsCount returns the number of the condition gauges of the component
+func GetComponentConditionsCount(component string) int {
+	ch := make(chan prometheus.Metric)
+	go func() {
+		componentCondition.Collect(ch)
+		close(ch)
+	}()
+
+	count := 0
+	for metric := range ch {
+		dto := &ioprometheusclient.Metric{}
+		if err := metric.Write(dto); err != nil {
+			continue
+		}
+		for _, label := range dto.GetLabel() {
+			if label.GetName() == labelComponent && label.GetValue() == component {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// ObserveReconcile records the duration of a reconciliation of the HyperConverged CR, and whether it was requeued
+func ObserveReconcile(trigger string, duration time.Duration, requeued bool) {
+	reconcileDuration.WithLabelValues(trigger).Observe(duration.Seconds())
+	if requeued {
+		reconcileRequeues.WithLabelValues(trigger).Inc()
+	}
+}
+
+// GetReconcileCount returns the number of the recorded reconciliations. If error is not nil then value is undefined
+func GetReconcileCount(trigger string) (uint64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := reconcileDuration.WithLabelValues(trigger).(prometheus.Histogram).Write(dto)
+	value := dto.Histogram.GetSampleCount()
+
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+// GetReconcileRequeuesCount returns current value of counter. If error is not nil then value is undefined
+func GetReconcileRequeuesCount(trigger string) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := reconcileRequeues.WithLabelValues(trigger).Write(dto)
+	value := dto.Counter.GetValue()
+
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
@@ -0,0 +1,114 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	ioprometheusclient "github.com/prometheus/client_model/go"
+)
+
+const (
+	labelFromVersion = "from_version"
+	labelToVersion   = "to_version"
+
+	upgradeInProgress  = 1.0
+	componentUpgraded  = 1.0
+	componentUpgrading = 0.0
+)
+
+var (
+	upgradeMetrics = []operatormetrics.Metric{
+		upgradeInfo,
+		upgradeStartTimestamp,
+		upgradeComponentCompleted,
+	}
+
+	upgradeInfo = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_upgrade_info",
+			Help: "Indicates that HCO is upgrading from the from_version to the to_version (1); not reported when there is no upgrade in progress",
+		},
+		[]string{labelFromVersion, labelToVersion},
+	)
+
+	upgradeStartTimestamp = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_upgrade_start_timestamp_seconds",
+			Help: "The time when HCO started the upgrade in progress, in seconds since the epoch; 0 if there is no upgrade in progress",
+		},
+	)
+
+	upgradeComponentCompleted = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_upgrade_component_completed",
+			Help: "Indicates whether the component reached the target version of the upgrade in progress (1) or not (0); not reported when there is no upgrade in progress",
+		},
+		[]string{labelComponent},
+	)
+)
+
+// SetUpgradeStarted reports an upgrade in progress, from fromVersion to toVersion, that started at startTime. All the
+// components are reported as not completed, until HCO reads their CRs with the target version; so an upgrade that
+// gets stuck before that, e.g. because a component CR can't be created, is still reported as stuck.
+func SetUpgradeStarted(fromVersion, toVersion string, startTime time.Time, components []string) {
+	upgradeInfo.Reset()
+	upgradeComponentCompleted.Reset()
+	upgradeInfo.WithLabelValues(fromVersion, toVersion).Set(upgradeInProgress)
+	upgradeStartTimestamp.Set(float64(startTime.Unix()))
+	for _, component := range components {
+		upgradeComponentCompleted.WithLabelValues(component).Set(componentUpgrading)
+	}
+}
+
+// SetUpgradeCompleted clears the metrics of the upgrade, when it is completed or aborted
+func SetUpgradeCompleted() {
+	upgradeInfo.Reset()
+	upgradeComponentCompleted.Reset()
+	upgradeStartTimestamp.Set(0)
+}
+
+// GetUpgradeInfo returns current value of gauge. If error is not nil then value is undefined
+func GetUpgradeInfo(fromVersion, toVersion string) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := upgradeInfo.WithLabelValues(fromVersion, toVersion).Write(dto)
+	value := dto.Gauge.GetValue()
+
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+// GetUpgradeStartTimestamp returns current value of gauge. If error is not nil then value is undefined
+func GetUpgradeStartTimestamp() (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := upgradeStartTimestamp.Write(dto)
+	value := dto.Gauge.GetValue()
+
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+// SetUpgradeComponentCompleted sets the gauge to 1 if the component reached the target version of the upgrade, or to 0
+// if it did not
+func SetUpgradeComponentCompleted(component string, completed bool) {
+	value := componentUpgrading
+	if completed {
+		value = componentUpgraded
+	}
+	upgradeComponentCompleted.WithLabelValues(component).Set(value)
+}
+
+// GetUpgradeComponentCompleted returns current value of gauge. If error is not nil then value is undefined
+func GetUpgradeComponentCompleted(component string) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := upgradeComponentCompleted.WithLabelValues(component).Write(dto)
+	value := dto.Gauge.GetValue()
+
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
@@ -0,0 +1,57 @@
+package alerts
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	goldenImageOutdatedAlert = "HCOGoldenImageOutdated"
+
+	// the number of schedule periods a failing golden image can go without an import, before the
+	// HCOGoldenImageOutdated alert fires
+	defaultGoldenImageOutdatedPeriods = 3
+	goldenImageOutdatedPeriodsEnv     = "GOLDEN_IMAGE_OUTDATED_ALERT_PERIODS"
+)
+
+func goldenImageAlerts(outdatedPeriods int) []promv1.Rule {
+	return []promv1.Rule{
+		{
+			Alert: goldenImageOutdatedAlert,
+			Expr: intstr.FromString(fmt.Sprintf(
+				"(time() - kubevirt_hco_dataimportcron_last_import_timestamp_seconds > %d * kubevirt_hco_dataimportcron_schedule_period_seconds) and on(ns, dataimportcron) kubevirt_hco_dataimportcron_failing == 1",
+				outdatedPeriods,
+			)),
+			Annotations: map[string]string{
+				"description": fmt.Sprintf("The {{ $labels.dataimportcron }} golden image in the {{ $labels.ns }} namespace is failing, and was not imported for more than %d schedule periods.", outdatedPeriods),
+				"summary":     "The {{ $labels.dataimportcron }} golden image is outdated.",
+			},
+			Labels: map[string]string{
+				severityAlertLabelKey:     "warning",
+				healthImpactAlertLabelKey: "warning",
+			},
+		},
+	}
+}
+
+// getGoldenImageOutdatedPeriods returns the number of periods of the environment variable. An invalid value does not
+// prevent the operator from starting; it is logged, and the default is used instead.
+func getGoldenImageOutdatedPeriods() int {
+	value, exists := os.LookupEnv(goldenImageOutdatedPeriodsEnv)
+	if !exists {
+		return defaultGoldenImageOutdatedPeriods
+	}
+
+	periods, err := strconv.Atoi(value)
+	if err != nil || periods <= 0 {
+		logger.Error(fmt.Errorf("invalid %s environment variable: %q; must be a positive integer", goldenImageOutdatedPeriodsEnv, value),
+			"using the default number of periods", "periods", defaultGoldenImageOutdatedPeriods)
+		return defaultGoldenImageOutdatedPeriods
+	}
+
+	return periods
+}
@@ -1,7 +1,12 @@
 package alerts
 
 import (
+	"fmt"
+	"os"
+	"time"
+
 	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/common/model"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 )
@@ -11,11 +16,16 @@ const (
 	unsafeModificationAlert       = "UnsupportedHCOModification"
 	installationNotCompletedAlert = "HCOInstallationIncomplete"
 	singleStackIPv6Alert          = "SingleStackIPv6Unsupported"
+	upgradeStuckAlert             = "HCOUpgradeStuck"
 	severityAlertLabelKey         = "severity"
 	healthImpactAlertLabelKey     = "operator_health_impact"
+
+	// the time an upgrade can be in progress, before the HCOUpgradeStuck alert fires
+	defaultUpgradeStuckThreshold = model.Duration(2 * time.Hour)
+	upgradeStuckThresholdEnv     = "UPGRADE_STUCK_ALERT_THRESHOLD"
 )
 
-func operatorAlerts() []promv1.Rule {
+func operatorAlerts(upgradeStuckThreshold model.Duration) []promv1.Rule {
 	return []promv1.Rule{
 		{
 			Alert: outOfBandUpdateAlert,
@@ -66,5 +76,38 @@ func operatorAlerts() []promv1.Rule {
 				healthImpactAlertLabelKey: "critical",
 			},
 		},
+		{
+			Alert: upgradeStuckAlert,
+			Expr: intstr.FromString(fmt.Sprintf(
+				"kubevirt_hco_upgrade_component_completed == 0 and on() (kubevirt_hco_upgrade_start_timestamp_seconds > 0 and time() - kubevirt_hco_upgrade_start_timestamp_seconds > %d)",
+				int64(time.Duration(upgradeStuckThreshold).Seconds()),
+			)),
+			Annotations: map[string]string{
+				"description": fmt.Sprintf("The upgrade of HCO has been in progress for more than %s; the {{ $labels.component }} component did not reach the target version.", upgradeStuckThreshold),
+				"summary":     "The upgrade of HCO is stuck, waiting for {{ $labels.component }}.",
+			},
+			Labels: map[string]string{
+				severityAlertLabelKey:     "warning",
+				healthImpactAlertLabelKey: "warning",
+			},
+		},
+	}
+}
+
+// getUpgradeStuckThreshold returns the threshold of the environment variable. An invalid value does not prevent the
+// operator from starting; it is logged, and the default is used instead.
+func getUpgradeStuckThreshold() model.Duration {
+	value, exists := os.LookupEnv(upgradeStuckThresholdEnv)
+	if !exists {
+		return defaultUpgradeStuckThreshold
+	}
+
+	threshold, err := model.ParseDuration(value)
+	if err != nil || threshold <= 0 {
+		logger.Error(fmt.Errorf("invalid %s environment variable: %q; must be a positive duration", upgradeStuckThresholdEnv, value),
+			"using the default threshold", "threshold", defaultUpgradeStuckThreshold.String())
+		return defaultUpgradeStuckThreshold
 	}
+
+	return threshold
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/machadovilaca/operator-observability/pkg/operatorrules"
 	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 const (
@@ -20,9 +21,15 @@ const (
 	runbookURLTemplateEnv          = "RUNBOOK_URL_TEMPLATE"
 )
 
+var logger = logf.Log.WithName("alerts")
+
 func Register() error {
+	upgradeStuckThreshold := getUpgradeStuckThreshold()
+	goldenImageOutdatedPeriods := getGoldenImageOutdatedPeriods()
+
 	alerts := [][]promv1.Rule{
-		operatorAlerts(),
+		operatorAlerts(upgradeStuckThreshold),
+		goldenImageAlerts(goldenImageOutdatedPeriods),
 	}
 
 	runbookURLTemplate := getRunbookURLTemplate()
@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/machadovilaca/operator-observability/pkg/testutil"
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 )
 
 func TestRules(t *testing.T) {
@@ -34,6 +35,72 @@ var _ = Describe("Rules Validation", func() {
 		Expect(problems).To(BeEmpty())
 	})
 
+	It("Should set the threshold of the upgrade stuck alert from the environment", func() {
+		getUpgradeStuckAlert := func() *promv1.Rule {
+			for _, alert := range ListAlerts() {
+				if alert.Alert == "HCOUpgradeStuck" {
+					return &alert
+				}
+			}
+			return nil
+		}
+
+		alert := getUpgradeStuckAlert()
+		Expect(alert).ToNot(BeNil())
+		Expect(alert.Expr.String()).To(HaveSuffix("> 7200)"))
+		Expect(alert.Annotations).To(HaveKeyWithValue("description", ContainSubstring("for more than 2h;")))
+
+		GinkgoT().Setenv("UPGRADE_STUCK_ALERT_THRESHOLD", "90m")
+		Expect(SetupRules()).To(Succeed())
+
+		alert = getUpgradeStuckAlert()
+		Expect(alert).ToNot(BeNil())
+		Expect(alert.Expr.String()).To(HaveSuffix("> 5400)"))
+		Expect(alert.Annotations).To(HaveKeyWithValue("description", ContainSubstring("for more than 1h30m;")))
+
+		for _, invalid := range []string{"soon", "0s"} {
+			GinkgoT().Setenv("UPGRADE_STUCK_ALERT_THRESHOLD", invalid)
+			Expect(SetupRules()).To(Succeed())
+
+			alert = getUpgradeStuckAlert()
+			Expect(alert).ToNot(BeNil())
+			Expect(alert.Expr.String()).To(HaveSuffix("> 7200)"))
+		}
+	})
+
+	It("Should set the number of periods of the golden image outdated alert from the environment", func() {
+		getGoldenImageOutdatedAlert := func() *promv1.Rule {
+			for _, alert := range ListAlerts() {
+				if alert.Alert == "HCOGoldenImageOutdated" {
+					return &alert
+				}
+			}
+			return nil
+		}
+
+		alert := getGoldenImageOutdatedAlert()
+		Expect(alert).ToNot(BeNil())
+		Expect(alert.Expr.String()).To(ContainSubstring("> 3 * kubevirt_hco_dataimportcron_schedule_period_seconds"))
+		Expect(alert.Annotations).To(HaveKeyWithValue("description", ContainSubstring("for more than 3 schedule periods")))
+
+		GinkgoT().Setenv("GOLDEN_IMAGE_OUTDATED_ALERT_PERIODS", "5")
+		Expect(SetupRules()).To(Succeed())
+
+		alert = getGoldenImageOutdatedAlert()
+		Expect(alert).ToNot(BeNil())
+		Expect(alert.Expr.String()).To(ContainSubstring("> 5 * kubevirt_hco_dataimportcron_schedule_period_seconds"))
+		Expect(alert.Annotations).To(HaveKeyWithValue("description", ContainSubstring("for more than 5 schedule periods")))
+
+		for _, invalid := range []string{"0", "many"} {
+			GinkgoT().Setenv("GOLDEN_IMAGE_OUTDATED_ALERT_PERIODS", invalid)
+			Expect(SetupRules()).To(Succeed())
+
+			alert = getGoldenImageOutdatedAlert()
+			Expect(alert).ToNot(BeNil())
+			Expect(alert.Expr.String()).To(ContainSubstring("> 3 * kubevirt_hco_dataimportcron_schedule_period_seconds"))
+		}
+	})
+
 	It("Should validate recording rules", func() {
 		recordingRules := ListRecordingRules()
 		problems := linter.LintRecordingRules(recordingRules)
@@ -18,15 +18,20 @@ const (
 	KVUIProxyImageEnvV               = "KV_CONSOLE_PROXY_IMAGE"
 	PrimaryUDNImageEnvV              = "PRIMARY_UDN_SIDECAR_IMAGE"
 	HcoValidatingWebhook             = "validate-hco.kubevirt.io"
+	HcoStatusValidatingWebhook       = "validate-status-hco.kubevirt.io"
 	HcoMutatingWebhookNS             = "mutate-ns-hco.kubevirt.io"
+	HcoMutatingWebhookDependentNS    = "mutate-dependent-ns-hco.kubevirt.io"
 	PrometheusRuleCRDName            = "prometheusrules.monitoring.coreos.com"
 	ServiceMonitorCRDName            = "servicemonitors.monitoring.coreos.com"
 	HcoMutatingWebhookHyperConverged = "mutate-hyperconverged-hco.kubevirt.io"
+	HcoConversionWebhook             = "cv-hyperconverged-hco.kubevirt.io"
+	HcoOperandsValidatingWebhook     = "validate-operands-hco.kubevirt.io"
 	AppLabel                         = "app"
 	UndefinedNamespace               = ""
 	OpenshiftNamespace               = "openshift"
 	APIVersionAlpha                  = "v1alpha1"
 	APIVersionBeta                   = "v1beta1"
+	APIVersionV1                     = "v1"
 	CurrentAPIVersion                = APIVersionBeta
 	APIVersionGroup                  = "hco.kubevirt.io"
 	APIVersion                       = APIVersionGroup + "/" + CurrentAPIVersion
@@ -40,6 +45,23 @@ const (
 	AppLabelComponent = AppLabelPrefix + "/component"
 	// Operator name for managed-by label
 	OperatorName = "hco-operator"
+	// SSPOperatorName is the value of the managed-by label of the objects SSP creates, like the DataImportCrons of
+	// the golden images
+	SSPOperatorName = "ssp-operator"
+	// DataImportCronLabel is the label CDI sets on the DataSources it manages for a DataImportCron
+	DataImportCronLabel = "cdi.kubevirt.io/dataImportCron"
+	// FieldManager is the field manager HCO uses when it applies the operand CRs with server-side apply
+	FieldManager = "hco-operator"
+	// LegacyFieldManager is the field manager the API server recorded for the operand CRs, when HCO used to update
+	// them with a full Update. Its fields are migrated to FieldManager on the first apply.
+	LegacyFieldManager = "hyperconverged-cluster-operator"
+	// HcoServiceAccountName is the service account of the HCO operator and webhook
+	HcoServiceAccountName = "hyperconverged-cluster-operator"
+	// HyperConvergedFinalizerName is the finalizer of the HyperConverged CR. The operator removes it after removing
+	// the operands.
+	HyperConvergedFinalizerName = "kubevirt.io/hyperconverged"
+	// DefaultGoldenImagesNamespace is the namespace SSP creates the golden images in, when their namespace is not set
+	DefaultGoldenImagesNamespace = "kubevirt-os-images"
 	// Value for "part-of" label
 	HyperConvergedCluster    = "hyperconverged-cluster"
 	OpenshiftNodeSelectorAnn = "openshift.io/node-selector"
@@ -59,11 +81,17 @@ const (
 	HCOWebhookPath               = "/validate-hco-kubevirt-io-v1beta1-hyperconverged"
 	HCOMutatingWebhookPath       = "/mutate-hco-kubevirt-io-v1beta1-hyperconverged"
 	HCONSWebhookPath             = "/mutate-ns-hco-kubevirt-io"
+	HCOConvertWebhookPath        = "/convert"
+	HCOOperandsWebhookPath       = "/validate-hco-kubevirt-io-operands"
 	WebhookPort                  = 4343
 
 	WebhookCertName       = "apiserver.crt"
 	WebhookKeyName        = "apiserver.key"
 	DefaultWebhookCertDir = "/apiserver.local.config/certificates"
+	WebhookServiceName    = "hyperconverged-cluster-webhook-service"
+	HyperConvergedCRDName = "hyperconvergeds.hco.kubevirt.io"
+	// the name of the MutatingWebhookConfiguration of the non-OLM installation (deploy/webhooks.yaml)
+	MutatingWebhookConfigurationName = "mutate-hco.kubevirt.io"
 
 	CliDownloadsServerPort       = 8080
 	UIPluginServerPort     int32 = 9443
@@ -72,6 +100,16 @@ const (
 	APIServerCRName = "cluster"
 
 	DataImportCronEnabledAnnotation = "dataimportcrontemplate.kubevirt.io/enable"
+
+	// AutoDefaultsAnnotation records the fields of the HyperConverged CR that the mutating webhook set to defaults
+	// computed from the cluster topology, with their values, as a JSON object
+	AutoDefaultsAnnotation = "hco.kubevirt.io/auto-defaults"
+
+	// The defaults of the HyperConverged fields that the mutating webhook may set from the cluster topology. They are
+	// used when these fields are not set.
+	DefaultParallelMigrationsPerCluster uint32 = 5
+	DefaultVMICPUAllocationRatio               = 10
+	DefaultWorkloadUpdateMethod                = "LiveMigrate"
 )
 
 type AppComponent string
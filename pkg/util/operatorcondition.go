@@ -2,6 +2,7 @@ package util
 
 import (
 	"context"
+	"sync"
 
 	operatorframeworkv2 "github.com/operator-framework/api/pkg/operators/v2"
 	"github.com/operator-framework/operator-lib/conditions"
@@ -10,7 +11,8 @@ import (
 )
 
 var (
-	operatorConditionFactory conditions.Factory
+	operatorConditionFactory     conditions.Factory
+	operatorConditionFactoryLock sync.Mutex
 )
 
 // Condition - We just need the Set method in our code.
@@ -36,6 +38,9 @@ const (
 )
 
 var GetFactory = func(cl client.Client) conditions.Factory {
+	operatorConditionFactoryLock.Lock()
+	defer operatorConditionFactoryLock.Unlock()
+
 	if operatorConditionFactory == nil {
 		operatorConditionFactory = conditions.InClusterFactory{Client: cl}
 	}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -233,9 +234,17 @@ func EnsureDeleted(ctx context.Context, c client.Client, obj client.Object, hcoN
 	return ComponentResourceRemoval(ctx, c, obj, hcoName, logger, dryRun, wait, protectNonHCOObjects)
 }
 
-var hcoKvIoVersion string
+var (
+	hcoKvIoVersion     string
+	hcoKvIoVersionLock sync.Mutex
+)
 
+// GetHcoKvIoVersion returns the version of the HCO_KV_IO_VERSION environment variable. It is called by the operand
+// handlers that run concurrently, so the lazy initialization is guarded by a lock.
 func GetHcoKvIoVersion() string {
+	hcoKvIoVersionLock.Lock()
+	defer hcoKvIoVersionLock.Unlock()
+
 	if hcoKvIoVersion == "" {
 		hcoKvIoVersion = os.Getenv(HcoKvIoVersionName)
 	}
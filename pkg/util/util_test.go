@@ -3,6 +3,7 @@ package util
 import (
 	"context"
 	"os"
+	"sync"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -49,6 +50,35 @@ var _ = Describe("Test general utilities", func() {
 		})
 	})
 
+	Context("test GetHcoKvIoVersion", func() {
+		BeforeEach(func() {
+			hcoKvIoVersion = ""
+			DeferCleanup(func() {
+				hcoKvIoVersion = ""
+			})
+		})
+
+		It("should be safe to call concurrently", func() {
+			GinkgoT().Setenv(HcoKvIoVersionName, "1.2.3")
+
+			versions := make(chan string, 10)
+			wg := sync.WaitGroup{}
+			for range 10 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					versions <- GetHcoKvIoVersion()
+				}()
+			}
+			wg.Wait()
+			close(versions)
+
+			for version := range versions {
+				Expect(version).To(Equal("1.2.3"))
+			}
+		})
+	})
+
 	Context("test EnsureDeleted", func() {
 
 		const appName = "appName"
@@ -0,0 +1,331 @@
+package certificates
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/openshift/library-go/pkg/crypto"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	caName = "hyperconverged-cluster-webhook-ca"
+
+	checkInterval = time.Minute
+)
+
+// SelfManagedCertDir is the directory the webhook server reads its certificates from, when they are self-managed.
+var SelfManagedCertDir = filepath.Join(os.TempDir(), "hyperconverged-cluster-webhook", "serving-certs")
+
+var _ manager.Runnable = &Manager{}
+
+// Manager generates a self-signed CA and a serving certificate for the webhook server, when they are not injected by
+// OLM or by cert-manager. It renews them according to the spec.certConfig field of the HyperConverged CR, and injects
+// the CA into the caBundle of the webhook configurations, and of the conversion webhook of the HyperConverged CRD,
+// that call the webhook service.
+//
+// cert-manager may issue the certificates after the webhook pod was started; e.g. when cert-manager is deployed after
+// HCO. The manager checks for the injected certificates on each renewal. Once they are there, it stops managing the
+// certificates and the caBundles, and copies the injected certificates to the directory the webhook server reads them
+// from.
+type Manager struct {
+	logger          logr.Logger
+	cli             client.Client
+	namespace       string
+	injectedCertDir string
+	certDir         string
+	now             func() time.Time
+	injected        bool
+
+	ca             *crypto.CA
+	caDuration     time.Duration
+	serverCert     *x509.Certificate
+	serverDuration time.Duration
+}
+
+// NewManager returns a certificate manager. cli should not be a cached client, as the manager is initialized before
+// the cache is started, and as it reads cluster scoped objects that the cache does not watch. injectedCertDir is the
+// directory where OLM or cert-manager mount the certificates, and certDir is the directory the webhook server reads its
+// certificates from.
+func NewManager(logger logr.Logger, cli client.Client, namespace, injectedCertDir, certDir string) *Manager {
+	return &Manager{
+		logger:          logger,
+		cli:             cli,
+		namespace:       namespace,
+		injectedCertDir: injectedCertDir,
+		certDir:         certDir,
+		now:             time.Now,
+	}
+}
+
+// CertificatesExist checks if both the serving certificate and its key are in certDir
+func CertificatesExist(certDir string) bool {
+	for _, fname := range []string{hcoutil.WebhookCertName, hcoutil.WebhookKeyName} {
+		if _, err := os.Stat(filepath.Join(certDir, fname)); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Init generates the certificates and injects the CA, so the webhook server can start with them
+func (m *Manager) Init(ctx context.Context) error {
+	return m.ensureCertificates(ctx)
+}
+
+// Start periodically renews the certificates, until the context is done
+func (m *Manager) Start(ctx context.Context) error {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := m.ensureCertificates(ctx); err != nil {
+				m.logger.Error(err, "failed to renew the webhook certificates")
+			}
+		}
+	}
+}
+
+// NeedLeaderElection returns false, as every webhook pod serves with its own certificates. The pods patch the caBundles
+// with optimistic locking, and keep the CAs of each other in them.
+func (m *Manager) NeedLeaderElection() bool {
+	return false
+}
+
+func (m *Manager) ensureCertificates(ctx context.Context) error {
+	if m.injected || CertificatesExist(m.injectedCertDir) {
+		return m.useInjectedCertificates()
+	}
+
+	certConfig := m.getCertConfig(ctx)
+	caDuration, caRenewBefore := certConfig.CA.Duration.Duration, certConfig.CA.RenewBefore.Duration
+	serverDuration, serverRenewBefore := certConfig.Server.Duration.Duration, certConfig.Server.RenewBefore.Duration
+
+	if m.ca == nil || m.caDuration != caDuration || m.shouldRenew(m.ca.Config.Certs[0], caRenewBefore) {
+		caConfig, err := crypto.MakeSelfSignedCAConfigForDuration(caName, caDuration)
+		if err != nil {
+			return fmt.Errorf("failed to generate the webhook CA; %w", err)
+		}
+
+		m.logger.Info("generated a new webhook CA", "notAfter", caConfig.Certs[0].NotAfter)
+		m.ca = &crypto.CA{Config: caConfig, SerialGenerator: &crypto.RandomSerialGenerator{}}
+		m.caDuration = caDuration
+		m.serverCert = nil
+	}
+
+	if m.serverCert == nil || m.serverDuration != serverDuration || m.shouldRenew(m.serverCert, serverRenewBefore) {
+		serverConfig, err := m.ca.MakeServerCertForDuration(m.getHostnames(), serverDuration)
+		if err != nil {
+			return fmt.Errorf("failed to generate the webhook serving certificate; %w", err)
+		}
+
+		certFile := filepath.Join(m.certDir, hcoutil.WebhookCertName)
+		keyFile := filepath.Join(m.certDir, hcoutil.WebhookKeyName)
+		if err = serverConfig.WriteCertConfigFile(certFile, keyFile); err != nil {
+			return fmt.Errorf("failed to write the webhook serving certificate; %w", err)
+		}
+
+		m.logger.Info("generated a new webhook serving certificate", "notAfter", serverConfig.Certs[0].NotAfter)
+		m.serverCert = serverConfig.Certs[0]
+		m.serverDuration = serverDuration
+	}
+
+	return m.injectCABundle(ctx)
+}
+
+// copy the injected certificates to the directory the webhook server reads them from, if they were changed. The webhook
+// server watches this directory and reloads the certificates.
+func (m *Manager) useInjectedCertificates() error {
+	if !m.injected {
+		m.logger.Info("the webhook certificates were injected; stop managing them", "certDir", m.injectedCertDir)
+		m.injected = true
+	}
+
+	for _, fname := range []string{hcoutil.WebhookKeyName, hcoutil.WebhookCertName} {
+		data, err := os.ReadFile(filepath.Join(m.injectedCertDir, fname))
+		if err != nil {
+			return fmt.Errorf("failed to read the injected webhook certificates; %w", err)
+		}
+
+		target := filepath.Join(m.certDir, fname)
+		if current, err := os.ReadFile(target); err == nil && bytes.Equal(current, data) {
+			continue
+		}
+
+		if err = os.WriteFile(target, data, 0600); err != nil {
+			return fmt.Errorf("failed to copy the injected webhook certificates; %w", err)
+		}
+	}
+
+	return nil
+}
+
+// read the certificate configuration from the HyperConverged CR. If it can't be read, use the defaults.
+func (m *Manager) getCertConfig(ctx context.Context) v1beta1.HyperConvergedCertConfig {
+	hc := &v1beta1.HyperConverged{}
+	err := m.cli.Get(ctx, client.ObjectKey{Namespace: m.namespace, Name: hcoutil.HyperConvergedName}, hc)
+	if err != nil && !apierrors.IsNotFound(err) {
+		m.logger.Error(err, "failed to read the HyperConverged CR; using the default certificate configuration")
+	}
+
+	if err != nil {
+		hc = &v1beta1.HyperConverged{}
+	}
+	v1beta1.SetObjectDefaults_HyperConverged(hc)
+
+	return hc.Spec.CertConfig
+}
+
+func (m *Manager) shouldRenew(cert *x509.Certificate, renewBefore time.Duration) bool {
+	return !m.now().Before(cert.NotAfter.Add(-renewBefore))
+}
+
+func (m *Manager) getHostnames() sets.Set[string] {
+	return sets.New(
+		hcoutil.WebhookServiceName,
+		fmt.Sprintf("%s.%s", hcoutil.WebhookServiceName, m.namespace),
+		fmt.Sprintf("%s.%s.svc", hcoutil.WebhookServiceName, m.namespace),
+		fmt.Sprintf("%s.%s.svc.cluster.local", hcoutil.WebhookServiceName, m.namespace),
+	)
+}
+
+func (m *Manager) isWebhookService(svc *admissionregistrationv1.ServiceReference) bool {
+	return svc != nil && svc.Name == hcoutil.WebhookServiceName && svc.Namespace == m.namespace
+}
+
+func (m *Manager) injectCABundle(ctx context.Context) error {
+	vwcList := &admissionregistrationv1.ValidatingWebhookConfigurationList{}
+	if err := m.cli.List(ctx, vwcList); err != nil {
+		return err
+	}
+
+	for i := range vwcList.Items {
+		err := injectWithRetry(ctx, m, &vwcList.Items[i], func(vwc *admissionregistrationv1.ValidatingWebhookConfiguration) {
+			for j := range vwc.Webhooks {
+				if m.isWebhookService(vwc.Webhooks[j].ClientConfig.Service) {
+					vwc.Webhooks[j].ClientConfig.CABundle = m.getCABundle(vwc.Webhooks[j].ClientConfig.CABundle)
+				}
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	mwcList := &admissionregistrationv1.MutatingWebhookConfigurationList{}
+	if err := m.cli.List(ctx, mwcList); err != nil {
+		return err
+	}
+
+	for i := range mwcList.Items {
+		err := injectWithRetry(ctx, m, &mwcList.Items[i], func(mwc *admissionregistrationv1.MutatingWebhookConfiguration) {
+			for j := range mwc.Webhooks {
+				if m.isWebhookService(mwc.Webhooks[j].ClientConfig.Service) {
+					mwc.Webhooks[j].ClientConfig.CABundle = m.getCABundle(mwc.Webhooks[j].ClientConfig.CABundle)
+				}
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	crd := &extv1.CustomResourceDefinition{}
+	if err := m.cli.Get(ctx, client.ObjectKey{Name: hcoutil.HyperConvergedCRDName}, crd); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	return injectWithRetry(ctx, m, crd, func(crd *extv1.CustomResourceDefinition) {
+		conversion := crd.Spec.Conversion
+		if conversion == nil || conversion.Webhook == nil || conversion.Webhook.ClientConfig == nil {
+			return
+		}
+
+		if svc := conversion.Webhook.ClientConfig.Service; svc == nil || svc.Name != hcoutil.WebhookServiceName || svc.Namespace != m.namespace {
+			return
+		}
+
+		conversion.Webhook.ClientConfig.CABundle = m.getCABundle(conversion.Webhook.ClientConfig.CABundle)
+	})
+}
+
+// injectWithRetry injects the CA into obj, and patches it if it was modified. The patch is optimistically locked, so
+// the caBundle another webhook pod has just set is not overwritten; on conflict, obj is read again and the injection
+// is retried.
+func injectWithRetry[T client.Object](ctx context.Context, m *Manager, obj T, inject func(T)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		orig := obj.DeepCopyObject().(T)
+		inject(obj)
+
+		err := m.patchIfModified(ctx, orig, obj)
+		if apierrors.IsConflict(err) {
+			if getErr := m.cli.Get(ctx, client.ObjectKeyFromObject(obj), obj); getErr != nil {
+				return getErr
+			}
+		}
+		return err
+	})
+}
+
+func (m *Manager) patchIfModified(ctx context.Context, orig, obj client.Object) error {
+	// the optimistically locked patch always contains the resourceVersion, so the modification is checked without it
+	patch := client.MergeFromWithOptions(orig, client.MergeFromWithOptimisticLock{})
+	data, err := client.MergeFrom(orig).Data(obj)
+	if err != nil {
+		return err
+	}
+
+	if string(data) == "{}" {
+		return nil
+	}
+
+	m.logger.Info("injecting the webhook CA", "kind", fmt.Sprintf("%T", obj), "name", obj.GetName())
+	return m.cli.Patch(ctx, obj, patch)
+}
+
+// getCABundle returns a bundle with the current CA, followed by the CAs of the existing bundle that were not expired
+// yet. Keeping them, keeps the certificates they signed valid: the certificate of the previous CA, until the webhook
+// server reloads its new certificate, or the certificate of another webhook pod during a rolling update.
+func (m *Manager) getCABundle(existing []byte) []byte {
+	caCert := m.ca.Config.Certs[0]
+	certs := []*x509.Certificate{caCert}
+
+	// ignore the error; a malformed bundle is replaced by a new one
+	existingCerts, _ := crypto.CertsFromPEM(existing)
+	now := m.now()
+	for _, cert := range existingCerts {
+		if cert.Equal(caCert) || now.After(cert.NotAfter) {
+			continue
+		}
+		certs = append(certs, cert)
+	}
+
+	bundle, err := crypto.EncodeCertificates(certs...)
+	if err != nil {
+		return existing
+	}
+
+	return bundle
+}
@@ -0,0 +1,281 @@
+package certificates
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/library-go/pkg/crypto"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const namespace = "kubevirt-hyperconverged"
+
+func TestCertificates(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Webhook Certificates Suite")
+}
+
+var _ = Describe("webhook certificates manager", func() {
+	var (
+		ctx             context.Context
+		s               *runtime.Scheme
+		injectedCertDir string
+		certDir         string
+	)
+
+	hcoService := &admissionregistrationv1.ServiceReference{Name: hcoutil.WebhookServiceName, Namespace: namespace}
+	otherService := &admissionregistrationv1.ServiceReference{Name: "ssp-operator-service", Namespace: namespace}
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		s = runtime.NewScheme()
+		Expect(admissionregistrationv1.AddToScheme(s)).To(Succeed())
+		Expect(extv1.AddToScheme(s)).To(Succeed())
+		Expect(v1beta1.AddToScheme(s)).To(Succeed())
+		injectedCertDir = GinkgoT().TempDir()
+		certDir = GinkgoT().TempDir()
+	})
+
+	newVWC := func() *admissionregistrationv1.ValidatingWebhookConfiguration {
+		return &admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "validate-hco.kubevirt.io"},
+			Webhooks: []admissionregistrationv1.ValidatingWebhook{
+				{Name: "validate-hco.kubevirt.io", ClientConfig: admissionregistrationv1.WebhookClientConfig{Service: hcoService}},
+				{Name: "validate-ssp.kubevirt.io", ClientConfig: admissionregistrationv1.WebhookClientConfig{Service: otherService}},
+			},
+		}
+	}
+
+	newMWC := func() *admissionregistrationv1.MutatingWebhookConfiguration {
+		return &admissionregistrationv1.MutatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "mutate-hco.kubevirt.io"},
+			Webhooks: []admissionregistrationv1.MutatingWebhook{
+				{Name: "mutate-ns-hco.kubevirt.io", ClientConfig: admissionregistrationv1.WebhookClientConfig{Service: hcoService}},
+			},
+		}
+	}
+
+	newCRD := func() *extv1.CustomResourceDefinition {
+		return &extv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: hcoutil.HyperConvergedCRDName},
+			Spec: extv1.CustomResourceDefinitionSpec{
+				Conversion: &extv1.CustomResourceConversion{
+					Strategy: extv1.WebhookConverter,
+					Webhook: &extv1.WebhookConversion{
+						ClientConfig: &extv1.WebhookClientConfig{
+							Service: &extv1.ServiceReference{Name: hcoutil.WebhookServiceName, Namespace: namespace},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	newManagerWithInterceptor := func(funcs interceptor.Funcs, objs ...client.Object) (*Manager, client.Client) {
+		cli := fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).WithInterceptorFuncs(funcs).Build()
+		return NewManager(logf.Log.WithName("certificates-test"), cli, namespace, injectedCertDir, certDir), cli
+	}
+
+	newManager := func(objs ...client.Object) (*Manager, client.Client) {
+		return newManagerWithInterceptor(interceptor.Funcs{}, objs...)
+	}
+
+	readServerCert := func() *x509.Certificate {
+		keyPair, err := tls.LoadX509KeyPair(filepath.Join(certDir, hcoutil.WebhookCertName), filepath.Join(certDir, hcoutil.WebhookKeyName))
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		cert, err := x509.ParseCertificate(keyPair.Certificate[0])
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		return cert
+	}
+
+	getBundle := func(cli client.Client) []*x509.Certificate {
+		vwc := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+		ExpectWithOffset(1, cli.Get(ctx, client.ObjectKeyFromObject(newVWC()), vwc)).To(Succeed())
+		certs, err := crypto.CertsFromPEM(vwc.Webhooks[0].ClientConfig.CABundle)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		return certs
+	}
+
+	It("should generate a serving certificate for the webhook service", func() {
+		m, _ := newManager()
+		Expect(m.Init(ctx)).To(Succeed())
+
+		cert := readServerCert()
+		for _, host := range []string{
+			"hyperconverged-cluster-webhook-service.kubevirt-hyperconverged.svc",
+			"hyperconverged-cluster-webhook-service.kubevirt-hyperconverged.svc.cluster.local",
+		} {
+			Expect(cert.VerifyHostname(host)).To(Succeed())
+		}
+
+		roots := x509.NewCertPool()
+		roots.AddCert(m.ca.Config.Certs[0])
+		_, err := cert.Verify(x509.VerifyOptions{Roots: roots, DNSName: "hyperconverged-cluster-webhook-service.kubevirt-hyperconverged.svc"})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should inject the CA into the webhooks that call the webhook service", func() {
+		m, cli := newManager(newVWC(), newMWC(), newCRD())
+		Expect(m.Init(ctx)).To(Succeed())
+
+		caCert := m.ca.Config.Certs[0]
+
+		vwc := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(newVWC()), vwc)).To(Succeed())
+		Expect(getBundle(cli)).To(ConsistOf(caCert))
+		Expect(vwc.Webhooks[1].ClientConfig.CABundle).To(BeEmpty())
+
+		mwc := &admissionregistrationv1.MutatingWebhookConfiguration{}
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(newMWC()), mwc)).To(Succeed())
+		Expect(crypto.CertsFromPEM(mwc.Webhooks[0].ClientConfig.CABundle)).To(ConsistOf(caCert))
+
+		crd := &extv1.CustomResourceDefinition{}
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(newCRD()), crd)).To(Succeed())
+		Expect(crypto.CertsFromPEM(crd.Spec.Conversion.Webhook.ClientConfig.CABundle)).To(ConsistOf(caCert))
+	})
+
+	It("should use the durations from the HyperConverged CR", func() {
+		hc := &v1beta1.HyperConverged{
+			ObjectMeta: metav1.ObjectMeta{Name: hcoutil.HyperConvergedName, Namespace: namespace},
+		}
+		v1beta1.SetObjectDefaults_HyperConverged(hc)
+		hc.Spec.CertConfig.CA.Duration = &metav1.Duration{Duration: 10 * time.Hour}
+		hc.Spec.CertConfig.Server.Duration = &metav1.Duration{Duration: 5 * time.Hour}
+
+		m, _ := newManager(hc)
+		Expect(m.Init(ctx)).To(Succeed())
+
+		Expect(m.ca.Config.Certs[0].NotAfter).To(BeTemporally("~", time.Now().Add(10*time.Hour), time.Minute))
+		Expect(readServerCert().NotAfter).To(BeTemporally("~", time.Now().Add(5*time.Hour), time.Minute))
+	})
+
+	It("should use the default durations, if the HyperConverged CR does not exist", func() {
+		m, _ := newManager()
+		Expect(m.Init(ctx)).To(Succeed())
+
+		Expect(m.ca.Config.Certs[0].NotAfter).To(BeTemporally("~", time.Now().Add(48*time.Hour), time.Minute))
+		Expect(readServerCert().NotAfter).To(BeTemporally("~", time.Now().Add(24*time.Hour), time.Minute))
+	})
+
+	It("should not renew the certificates before their renewBefore time", func() {
+		m, cli := newManager(newVWC())
+		Expect(m.Init(ctx)).To(Succeed())
+		caCert, serverCert := m.ca.Config.Certs[0], readServerCert()
+
+		m.now = func() time.Time { return time.Now().Add(11 * time.Hour) }
+		Expect(m.ensureCertificates(ctx)).To(Succeed())
+
+		Expect(m.ca.Config.Certs[0]).To(Equal(caCert))
+		Expect(readServerCert()).To(Equal(serverCert))
+		Expect(getBundle(cli)).To(ConsistOf(caCert))
+	})
+
+	It("should renew the serving certificate, but not the CA, after the server renewBefore time", func() {
+		m, cli := newManager(newVWC())
+		Expect(m.Init(ctx)).To(Succeed())
+		caCert, serverCert := m.ca.Config.Certs[0], readServerCert()
+
+		m.now = func() time.Time { return time.Now().Add(13 * time.Hour) }
+		Expect(m.ensureCertificates(ctx)).To(Succeed())
+
+		Expect(m.ca.Config.Certs[0]).To(Equal(caCert))
+		Expect(readServerCert()).ToNot(Equal(serverCert))
+		Expect(getBundle(cli)).To(ConsistOf(caCert))
+	})
+
+	It("should rotate the CA after its renewBefore time, and keep the previous CA in the bundle until it expires", func() {
+		m, cli := newManager(newVWC())
+		Expect(m.Init(ctx)).To(Succeed())
+		oldCA := m.ca.Config.Certs[0]
+
+		m.now = func() time.Time { return time.Now().Add(25 * time.Hour) }
+		Expect(m.ensureCertificates(ctx)).To(Succeed())
+
+		newCA := m.ca.Config.Certs[0]
+		Expect(newCA).ToNot(Equal(oldCA))
+		Expect(getBundle(cli)).To(Equal([]*x509.Certificate{newCA, oldCA}))
+
+		roots := x509.NewCertPool()
+		roots.AddCert(newCA)
+		_, err := readServerCert().Verify(x509.VerifyOptions{Roots: roots})
+		Expect(err).ToNot(HaveOccurred())
+
+		m.now = func() time.Time { return time.Now().Add(49 * time.Hour) }
+		Expect(m.injectCABundle(ctx)).To(Succeed())
+		Expect(getBundle(cli)).To(ConsistOf(newCA))
+	})
+
+	It("should write the certificates to a new directory", func() {
+		certDir = filepath.Join(certDir, "serving-certs")
+		m, _ := newManager()
+		Expect(m.Init(ctx)).To(Succeed())
+
+		Expect(os.Stat(filepath.Join(certDir, hcoutil.WebhookCertName))).ToNot(BeNil())
+		Expect(os.Stat(filepath.Join(certDir, hcoutil.WebhookKeyName))).ToNot(BeNil())
+	})
+
+	It("should keep the CA another webhook pod has just injected", func() {
+		otherCA, err := crypto.MakeSelfSignedCAConfigForDuration("other-webhook-ca", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		otherBundle, err := crypto.EncodeCertificates(otherCA.Certs...)
+		Expect(err).ToNot(HaveOccurred())
+
+		conflicts := 0
+		m, cli := newManagerWithInterceptor(interceptor.Funcs{
+			Patch: func(ctx context.Context, cli client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+				if _, ok := obj.(*admissionregistrationv1.ValidatingWebhookConfiguration); ok && conflicts == 0 {
+					conflicts++
+					// another pod injects its CA, between the read and the patch of this pod
+					vwc := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+					Expect(cli.Get(ctx, client.ObjectKeyFromObject(obj), vwc)).To(Succeed())
+					vwc.Webhooks[0].ClientConfig.CABundle = otherBundle
+					Expect(cli.Update(ctx, vwc)).To(Succeed())
+				}
+				return cli.Patch(ctx, obj, patch, opts...)
+			},
+		}, newVWC())
+
+		Expect(m.Init(ctx)).To(Succeed())
+
+		Expect(conflicts).To(Equal(1))
+		Expect(getBundle(cli)).To(Equal([]*x509.Certificate{m.ca.Config.Certs[0], otherCA.Certs[0]}))
+	})
+
+	It("should stop managing the certificates, once they are injected", func() {
+		m, cli := newManager(newVWC())
+		Expect(m.Init(ctx)).To(Succeed())
+		caCert := m.ca.Config.Certs[0]
+
+		for fname, content := range map[string]string{hcoutil.WebhookCertName: "injected cert", hcoutil.WebhookKeyName: "injected key"} {
+			Expect(os.WriteFile(filepath.Join(injectedCertDir, fname), []byte(content), 0600)).To(Succeed())
+		}
+
+		m.now = func() time.Time { return time.Now().Add(25 * time.Hour) }
+		Expect(m.ensureCertificates(ctx)).To(Succeed())
+
+		Expect(os.ReadFile(filepath.Join(certDir, hcoutil.WebhookCertName))).To(BeEquivalentTo("injected cert"))
+		Expect(os.ReadFile(filepath.Join(certDir, hcoutil.WebhookKeyName))).To(BeEquivalentTo("injected key"))
+		Expect(getBundle(cli)).To(ConsistOf(caCert))
+
+		Expect(os.WriteFile(filepath.Join(injectedCertDir, hcoutil.WebhookCertName), []byte("renewed cert"), 0600)).To(Succeed())
+		Expect(m.ensureCertificates(ctx)).To(Succeed())
+		Expect(os.ReadFile(filepath.Join(certDir, hcoutil.WebhookCertName))).To(BeEquivalentTo("renewed cert"))
+	})
+})
@@ -2,20 +2,22 @@ package mutator
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
-
-	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+	"strconv"
+	"strings"
 
 	"gomodules.xyz/jsonpatch/v2"
 	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
-	kubevirtcorev1 "kubevirt.io/api/core/v1"
 )
 
 var (
@@ -54,7 +56,7 @@ const (
 	dictAnnotationPathTemplate = annotationPathTemplate + "/cdi.kubevirt.io~1storage.bind.immediate.requested"
 )
 
-func (hcm *HyperConvergedMutator) mutateHyperConverged(_ context.Context, req admission.Request) admission.Response {
+func (hcm *HyperConvergedMutator) mutateHyperConverged(ctx context.Context, req admission.Request) admission.Response {
 	hc := &hcov1beta1.HyperConverged{}
 	err := hcm.decoder.Decode(req, hc)
 	if err != nil {
@@ -79,46 +81,106 @@ func (hcm *HyperConvergedMutator) mutateHyperConverged(_ context.Context, req ad
 		}
 	}
 
-	if hc.Spec.EvictionStrategy == nil {
-		ci := hcoutil.GetClusterInfo()
-		if ci.IsInfrastructureHighlyAvailable() {
-			patches = append(patches, jsonpatch.JsonPatchOperation{
-				Operation: "add",
-				Path:      "/spec/evictionStrategy",
-				Value:     kubevirtcorev1.EvictionStrategyLiveMigrate,
-			})
-		} else {
-			patches = append(patches, jsonpatch.JsonPatchOperation{
-				Operation: "add",
-				Path:      "/spec/evictionStrategy",
-				Value:     kubevirtcorev1.EvictionStrategyNone,
-			})
-		}
+	topologyPatches, err := hcm.getTopologyDefaultPatches(ctx, req, hc)
+	if err != nil {
+		hcMutatorLogger.Error(err, "failed to set the topology defaults of the HyperConverged custom resource")
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	patches = append(patches, topologyPatches...)
 
+	migrationPatches, warnings, err := getFieldMigrationPatches(req.Object.Raw)
+	if err != nil {
+		hcMutatorLogger.Error(err, "failed to migrate the deprecated fields of the HyperConverged custom resource")
+		return admission.Errored(http.StatusBadRequest, err)
 	}
+	patches = append(patches, migrationPatches...)
 
-	if hc.Spec.MediatedDevicesConfiguration != nil {
-		if len(hc.Spec.MediatedDevicesConfiguration.MediatedDevicesTypes) > 0 && len(hc.Spec.MediatedDevicesConfiguration.MediatedDeviceTypes) == 0 { //nolint SA1019
-			patches = append(patches, jsonpatch.JsonPatchOperation{
-				Operation: "add",
-				Path:      "/spec/mediatedDevicesConfiguration/mediatedDeviceTypes",
-				Value:     hc.Spec.MediatedDevicesConfiguration.MediatedDevicesTypes, //nolint SA1019
-			})
+	if len(patches) > 0 {
+		return admission.Patched("mutated", patches...).WithWarnings(warnings...)
+	}
+
+	return admission.Allowed("").WithWarnings(warnings...)
+}
+
+// getFieldMigrationPatches applies the field migrations of the common.FieldMigrations registry to the requested
+// HyperConverged CR, and returns the patches that set the migrated fields. It returns one warning for each deprecated
+// field that is set: that it was migrated, or that it is deprecated, if the field that replaces it is already set.
+// The validating webhook does not warn about these fields again.
+func getFieldMigrationPatches(raw []byte) ([]jsonpatch.JsonPatchOperation, admission.Warnings, error) {
+	obj := map[string]any{}
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return nil, nil, err
+	}
+	orig := runtime.DeepCopyJSON(obj)
+
+	deprecatedFields, err := common.GetDeprecatedFields(orig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	migrated, err := common.ApplyFieldMigrations(obj)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var patches []jsonpatch.JsonPatchOperation
+	migratedPaths := make(map[string]bool, len(migrated))
+	for _, df := range migrated {
+		patches = append(patches, getAddPatch(orig, obj, df.NewPathElements))
+		migratedPaths[df.OldPath] = true
+	}
+
+	var warnings admission.Warnings
+	for _, df := range deprecatedFields {
+		if migratedPaths[df.OldPath] {
+			warnings = append(warnings, df.MigratedWarning())
+		} else {
+			warnings = append(warnings, df.DeprecatedWarning())
 		}
-		for i, hcoNodeMdevTypeConf := range hc.Spec.MediatedDevicesConfiguration.NodeMediatedDeviceTypes {
-			if len(hcoNodeMdevTypeConf.MediatedDevicesTypes) > 0 && len(hcoNodeMdevTypeConf.MediatedDeviceTypes) == 0 { //nolint SA1019
-				patches = append(patches, jsonpatch.JsonPatchOperation{
-					Operation: "add",
-					Path:      fmt.Sprintf("/spec/mediatedDevicesConfiguration/nodeMediatedDeviceTypes/%d/mediatedDeviceTypes", i),
-					Value:     hcoNodeMdevTypeConf.MediatedDevicesTypes, //nolint SA1019
-				})
-			}
+	}
+
+	return patches, warnings, nil
+}
+
+// getAddPatch returns an "add" operation for the value of the path in the migrated object. If some elements of the
+// path are missing in the original object, the operation adds the first missing one, with its whole value.
+func getAddPatch(orig, migrated any, path []string) jsonpatch.JsonPatchOperation {
+	origValue, migratedValue := orig, migrated
+	pointer := ""
+	for _, element := range path {
+		pointer += "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(element)
+		migratedValue = getChild(migratedValue, element)
+
+		var found bool
+		if origValue, found = getChildIfExists(origValue, element); !found {
+			break
 		}
 	}
 
-	if len(patches) > 0 {
-		return admission.Patched("mutated", patches...)
+	return jsonpatch.JsonPatchOperation{
+		Operation: "add",
+		Path:      pointer,
+		Value:     migratedValue,
 	}
+}
+
+func getChild(value any, element string) any {
+	child, _ := getChildIfExists(value, element)
+	return child
+}
 
-	return admission.Allowed("")
+func getChildIfExists(value any, element string) (any, bool) {
+	switch v := value.(type) {
+	case map[string]any:
+		child, found := v[element]
+		return child, found
+	case []any:
+		index, err := strconv.Atoi(element)
+		if err != nil || index < 0 || index >= len(v) {
+			return nil, false
+		}
+		return v[index], true
+	default:
+		return nil, false
+	}
 }
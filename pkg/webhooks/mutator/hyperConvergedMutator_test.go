@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"gomodules.xyz/jsonpatch/v2"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -17,6 +18,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
 
 	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
@@ -248,12 +250,12 @@ var _ = Describe("test HyperConverged mutator", func() {
 			Expect(res.Patches[2]).To(Equal(jsonpatch.JsonPatchOperation{
 				Operation: "add",
 				Path:      "/spec/mediatedDevicesConfiguration/mediatedDeviceTypes",
-				Value:     []string{"nvidia-222", "nvidia-230"},
+				Value:     []any{"nvidia-222", "nvidia-230"},
 			}))
 			Expect(res.Patches[3]).To(Equal(jsonpatch.JsonPatchOperation{
 				Operation: "add",
 				Path:      "/spec/mediatedDevicesConfiguration/nodeMediatedDeviceTypes/1/mediatedDeviceTypes",
-				Value:     []string{"nvidia-229"},
+				Value:     []any{"nvidia-229"},
 			}))
 		})
 
@@ -288,11 +290,18 @@ var _ = Describe("test HyperConverged mutator", func() {
 				Entry("should set EvictionStrategyNone if not set and on SNO",
 					true,
 					nil,
-					[]jsonpatch.JsonPatchOperation{jsonpatch.JsonPatchOperation{
-						Operation: "add",
-						Path:      "/spec/evictionStrategy",
-						Value:     kubevirtcorev1.EvictionStrategyNone,
-					}},
+					[]jsonpatch.JsonPatchOperation{
+						{
+							Operation: "add",
+							Path:      "/spec/evictionStrategy",
+							Value:     string(kubevirtcorev1.EvictionStrategyNone),
+						},
+						{
+							Operation: "add",
+							Path:      "/metadata/annotations",
+							Value:     map[string]any{hcoutil.AutoDefaultsAnnotation: `{"spec.evictionStrategy":"None"}`},
+						},
+					},
 				),
 				Entry("should not override EvictionStrategy if set and on SNO - 1",
 					true,
@@ -312,11 +321,18 @@ var _ = Describe("test HyperConverged mutator", func() {
 				Entry("should set EvictionStrategyLiveMigrate if not set and not on SNO",
 					false,
 					nil,
-					[]jsonpatch.JsonPatchOperation{jsonpatch.JsonPatchOperation{
-						Operation: "add",
-						Path:      "/spec/evictionStrategy",
-						Value:     kubevirtcorev1.EvictionStrategyLiveMigrate,
-					}},
+					[]jsonpatch.JsonPatchOperation{
+						{
+							Operation: "add",
+							Path:      "/spec/evictionStrategy",
+							Value:     string(kubevirtcorev1.EvictionStrategyLiveMigrate),
+						},
+						{
+							Operation: "add",
+							Path:      "/metadata/annotations",
+							Value:     map[string]any{hcoutil.AutoDefaultsAnnotation: `{"spec.evictionStrategy":"LiveMigrate"}`},
+						},
+					},
 				),
 				Entry("should not override EvictionStrategy if set and not on SNO - 1",
 					false,
@@ -336,7 +352,239 @@ var _ = Describe("test HyperConverged mutator", func() {
 			)
 		})
 
-		DescribeTable("Check mediatedDevicesTypes -> mediatedDeviceTypes transition", func(initialMDConfiguration *v1beta1.MediatedDevicesConfiguration, patches []jsonpatch.JsonPatchOperation) {
+		Context("Check the defaults that depend on the cluster topology", func() {
+
+			getClusterInfo := hcoutil.GetClusterInfo
+
+			BeforeEach(func() {
+				hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+					return &commontestutils.ClusterInfoMock{}
+				}
+				v1beta1.SetObjectDefaults_HyperConverged(cr)
+			})
+
+			AfterEach(func() {
+				hcoutil.GetClusterInfo = getClusterInfo
+			})
+
+			newNode := func(name string, labels map[string]string) *corev1.Node {
+				return &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   name,
+						Labels: labels,
+					},
+				}
+			}
+
+			newNodes := func(count int) []client.Object {
+				nodes := make([]client.Object, 0, count)
+				for i := range count {
+					nodes = append(nodes, newNode(fmt.Sprintf("node%d", i), nil))
+				}
+				return nodes
+			}
+
+			mutate := func(req admission.Request, nodes ...client.Object) admission.Response {
+				mutator = initHCMutator(s, commontestutils.InitClient(nodes))
+				res := mutator.Handle(context.TODO(), req)
+				ExpectWithOffset(1, res.Allowed).To(BeTrue())
+				return res
+			}
+
+			DescribeTable("should set the defaults on create", func(nodes []client.Object, patches []jsonpatch.JsonPatchOperation) {
+				res := mutate(admission.Request{AdmissionRequest: newCreateRequest(cr, hcoV1beta1Codec)}, nodes...)
+				Expect(res.Patches).To(Equal(patches))
+			},
+				Entry("no nodes", nil, nil),
+				Entry("small cluster", newNodes(3), []jsonpatch.JsonPatchOperation{
+					{
+						Operation: "add",
+						Path:      "/spec/resourceRequirements",
+						Value:     map[string]any{"vmiCPUAllocationRatio": int64(4)},
+					},
+					{
+						Operation: "add",
+						Path:      "/metadata/annotations",
+						Value:     map[string]any{hcoutil.AutoDefaultsAnnotation: `{"spec.resourceRequirements.vmiCPUAllocationRatio":4}`},
+					},
+				}),
+				Entry("medium cluster", newNodes(5), nil),
+				Entry("large cluster", newNodes(8), []jsonpatch.JsonPatchOperation{
+					{
+						Operation: "add",
+						Path:      "/spec/liveMigrationConfig/parallelMigrationsPerCluster",
+						Value:     int64(8),
+					},
+					{
+						Operation: "add",
+						Path:      "/metadata/annotations",
+						Value:     map[string]any{hcoutil.AutoDefaultsAnnotation: `{"spec.liveMigrationConfig.parallelMigrationsPerCluster":8}`},
+					},
+				}),
+				Entry("very large cluster", newNodes(30), []jsonpatch.JsonPatchOperation{
+					{
+						Operation: "add",
+						Path:      "/spec/liveMigrationConfig/parallelMigrationsPerCluster",
+						Value:     int64(20),
+					},
+					{
+						Operation: "add",
+						Path:      "/metadata/annotations",
+						Value:     map[string]any{hcoutil.AutoDefaultsAnnotation: `{"spec.liveMigrationConfig.parallelMigrationsPerCluster":20}`},
+					},
+				}),
+				Entry("single node", newNodes(1), []jsonpatch.JsonPatchOperation{
+					{
+						Operation: "add",
+						Path:      "/spec/workloadUpdateStrategy/workloadUpdateMethods",
+						Value:     []any{},
+					},
+					{
+						Operation: "add",
+						Path:      "/spec/resourceRequirements",
+						Value:     map[string]any{"vmiCPUAllocationRatio": int64(4)},
+					},
+					{
+						Operation: "add",
+						Path:      "/metadata/annotations",
+						Value:     map[string]any{hcoutil.AutoDefaultsAnnotation: `{"spec.resourceRequirements.vmiCPUAllocationRatio":4,"spec.workloadUpdateStrategy.workloadUpdateMethods":[]}`},
+					},
+				}),
+			)
+
+			It("should not override the fields that the user set", func() {
+				cr.Spec.LiveMigrationConfig.ParallelMigrationsPerCluster = ptr.To[uint32](3)
+				cr.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []string{"Evict"}
+				cr.Spec.ResourceRequirements = &v1beta1.OperandResourceRequirements{VmiCPUAllocationRatio: ptr.To(8)}
+				cr.Spec.DefaultCPUModel = ptr.To("Haswell")
+
+				node := newNode("node0", map[string]string{
+					kubevirtcorev1.HostModelCPULabel + "Skylake": "true",
+					kubevirtcorev1.CPUModelLabel + "Skylake":     "true",
+				})
+
+				res := mutate(admission.Request{AdmissionRequest: newCreateRequest(cr, hcoV1beta1Codec)}, node)
+				Expect(res.Patches).To(BeEmpty())
+			})
+
+			It("should not override the fields that the user set to the fallback values", func() {
+				cr.Spec.LiveMigrationConfig.ParallelMigrationsPerCluster = ptr.To(hcoutil.DefaultParallelMigrationsPerCluster)
+				cr.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []string{hcoutil.DefaultWorkloadUpdateMethod}
+				cr.Spec.ResourceRequirements = &v1beta1.OperandResourceRequirements{VmiCPUAllocationRatio: ptr.To(hcoutil.DefaultVMICPUAllocationRatio)}
+
+				By("a single node")
+				res := mutate(admission.Request{AdmissionRequest: newCreateRequest(cr, hcoV1beta1Codec)}, newNodes(1)...)
+				Expect(res.Patches).To(BeEmpty())
+
+				By("a large cluster")
+				res = mutate(admission.Request{AdmissionRequest: newCreateRequest(cr, hcoV1beta1Codec)}, newNodes(8)...)
+				Expect(res.Patches).To(BeEmpty())
+			})
+
+			It("should only count the schedulable nodes that can run the workloads", func() {
+				cr.Spec.ResourceRequirements = &v1beta1.OperandResourceRequirements{VmiCPUAllocationRatio: ptr.To(8)}
+
+				unschedulable := newNode("unschedulable", nil)
+				unschedulable.Spec.Unschedulable = true
+
+				tainted := newNode("tainted", map[string]string{"infra": "true"})
+				tainted.Spec.Taints = []corev1.Taint{{Key: "dedicated", Value: "infra", Effect: corev1.TaintEffectNoSchedule}}
+
+				preferNoSchedule := newNode("prefer-no-schedule", nil)
+				preferNoSchedule.Spec.Taints = []corev1.Taint{{Key: "dedicated", Value: "db", Effect: corev1.TaintEffectPreferNoSchedule}}
+
+				infra := newNode("infra", map[string]string{"infra": "true"})
+
+				nodes := []client.Object{unschedulable, tainted, preferNoSchedule, infra}
+				singleNodePatch := jsonpatch.JsonPatchOperation{
+					Operation: "add",
+					Path:      "/spec/workloadUpdateStrategy/workloadUpdateMethods",
+					Value:     []any{},
+				}
+
+				By("without node placement, two nodes are schedulable")
+				res := mutate(admission.Request{AdmissionRequest: newCreateRequest(cr, hcoV1beta1Codec)}, nodes...)
+				Expect(res.Patches).To(BeEmpty())
+
+				By("the node selector matches two nodes, but the workloads don't tolerate the taint of one of them")
+				cr.Spec.Workloads.NodePlacement = &sdkapi.NodePlacement{
+					NodeSelector: map[string]string{"infra": "true"},
+				}
+				res = mutate(admission.Request{AdmissionRequest: newCreateRequest(cr, hcoV1beta1Codec)}, nodes...)
+				Expect(res.Patches).To(ContainElement(singleNodePatch))
+
+				By("the workloads tolerate the taint")
+				cr.Spec.Workloads.NodePlacement.Tolerations = []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}}
+				res = mutate(admission.Request{AdmissionRequest: newCreateRequest(cr, hcoV1beta1Codec)}, nodes...)
+				Expect(res.Patches).To(BeEmpty())
+			})
+
+			DescribeTable("should set the default CPU model to a host model that all the nodes support", func(nodes []client.Object, expectedModel string) {
+				cr.Spec.ResourceRequirements = &v1beta1.OperandResourceRequirements{VmiCPUAllocationRatio: ptr.To(8)}
+
+				res := mutate(admission.Request{AdmissionRequest: newCreateRequest(cr, hcoV1beta1Codec)}, nodes...)
+				if expectedModel == "" {
+					Expect(res.Patches).To(BeEmpty())
+					return
+				}
+
+				Expect(res.Patches).To(Equal([]jsonpatch.JsonPatchOperation{
+					{
+						Operation: "add",
+						Path:      "/spec/defaultCPUModel",
+						Value:     expectedModel,
+					},
+					{
+						Operation: "add",
+						Path:      "/metadata/annotations",
+						Value:     map[string]any{hcoutil.AutoDefaultsAnnotation: fmt.Sprintf(`{"spec.defaultCPUModel":%q}`, expectedModel)},
+					},
+				}))
+			},
+				Entry("same host model", []client.Object{
+					newNode("node0", map[string]string{kubevirtcorev1.HostModelCPULabel + "Skylake": "true", kubevirtcorev1.CPUModelLabel + "Skylake": "true"}),
+					newNode("node1", map[string]string{kubevirtcorev1.HostModelCPULabel + "Skylake": "true", kubevirtcorev1.CPUModelLabel + "Skylake": "true"}),
+				}, "Skylake"),
+				Entry("the host model of the older node", []client.Object{
+					newNode("node0", map[string]string{
+						kubevirtcorev1.HostModelCPULabel + "Skylake": "true",
+						kubevirtcorev1.CPUModelLabel + "Skylake":     "true",
+						kubevirtcorev1.CPUModelLabel + "Haswell":     "true",
+					}),
+					newNode("node1", map[string]string{kubevirtcorev1.HostModelCPULabel + "Haswell": "true", kubevirtcorev1.CPUModelLabel + "Haswell": "true"}),
+				}, "Haswell"),
+				Entry("no common model", []client.Object{
+					newNode("node0", map[string]string{kubevirtcorev1.HostModelCPULabel + "Skylake": "true", kubevirtcorev1.CPUModelLabel + "Skylake": "true"}),
+					newNode("node1", map[string]string{kubevirtcorev1.HostModelCPULabel + "EPYC": "true", kubevirtcorev1.CPUModelLabel + "EPYC": "true"}),
+				}, ""),
+				Entry("a node without CPU model labels", []client.Object{
+					newNode("node0", map[string]string{kubevirtcorev1.HostModelCPULabel + "Skylake": "true", kubevirtcorev1.CPUModelLabel + "Skylake": "true"}),
+					newNode("node1", nil),
+				}, ""),
+			)
+
+			It("should only set the evictionStrategy default on update, and keep the recorded defaults", func() {
+				cr.Annotations = map[string]string{hcoutil.AutoDefaultsAnnotation: `{"spec.defaultCPUModel":"Skylake"}`}
+				origCR := cr.DeepCopy()
+				cr.Spec.EvictionStrategy = nil
+
+				res := mutate(admission.Request{AdmissionRequest: newUpdateRequest(origCR, cr, hcoV1beta1Codec)}, newNodes(1)...)
+				Expect(res.Patches).To(Equal([]jsonpatch.JsonPatchOperation{
+					{
+						Operation: "add",
+						Path:      "/spec/evictionStrategy",
+						Value:     string(kubevirtcorev1.EvictionStrategyLiveMigrate),
+					},
+					{
+						Operation: "add",
+						Path:      "/metadata/annotations/hco.kubevirt.io~1auto-defaults",
+						Value:     `{"spec.defaultCPUModel":"Skylake","spec.evictionStrategy":"LiveMigrate"}`,
+					},
+				}))
+			})
+		})
+
+		DescribeTable("Check mediatedDevicesTypes -> mediatedDeviceTypes transition", func(initialMDConfiguration *v1beta1.MediatedDevicesConfiguration, patches []jsonpatch.JsonPatchOperation, warnings []string) {
 			cr.Spec.MediatedDevicesConfiguration = initialMDConfiguration
 
 			req := admission.Request{AdmissionRequest: newCreateRequest(cr, hcoV1beta1Codec)}
@@ -345,10 +593,12 @@ var _ = Describe("test HyperConverged mutator", func() {
 			Expect(res.Allowed).To(BeTrue())
 
 			Expect(res.Patches).To(Equal(patches))
+			Expect(res.Warnings).To(ConsistOf(warnings))
 		},
 			Entry("should do nothing if nothing is there",
 				nil,
 				nil,
+				nil,
 			),
 			Entry("should do nothing if already using mediatedDeviceTypes",
 				&v1beta1.MediatedDevicesConfiguration{
@@ -373,6 +623,7 @@ var _ = Describe("test HyperConverged mutator", func() {
 					},
 				},
 				nil,
+				nil,
 			),
 			Entry("should set the mediatedDeviceTypes if using only deprecated ones",
 				&v1beta1.MediatedDevicesConfiguration{
@@ -400,19 +651,24 @@ var _ = Describe("test HyperConverged mutator", func() {
 					jsonpatch.JsonPatchOperation{
 						Operation: "add",
 						Path:      "/spec/mediatedDevicesConfiguration/mediatedDeviceTypes",
-						Value:     []string{"nvidia-222", "nvidia-230"},
+						Value:     []any{"nvidia-222", "nvidia-230"},
 					},
 					jsonpatch.JsonPatchOperation{
 						Operation: "add",
 						Path:      "/spec/mediatedDevicesConfiguration/nodeMediatedDeviceTypes/0/mediatedDeviceTypes",
-						Value:     []string{"nvidia-223"},
+						Value:     []any{"nvidia-223"},
 					},
 					jsonpatch.JsonPatchOperation{
 						Operation: "add",
 						Path:      "/spec/mediatedDevicesConfiguration/nodeMediatedDeviceTypes/1/mediatedDeviceTypes",
-						Value:     []string{"nvidia-229"},
+						Value:     []any{"nvidia-229"},
 					},
 				},
+				[]string{
+					"spec.mediatedDevicesConfiguration.mediatedDevicesTypes: the deprecated field was copied to spec.mediatedDevicesConfiguration.mediatedDeviceTypes; the field is removed in version 1.15.0",
+					"spec.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[0].mediatedDevicesTypes: the deprecated field was copied to spec.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[0].mediatedDeviceTypes; the field is removed in version 1.15.0",
+					"spec.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[1].mediatedDevicesTypes: the deprecated field was copied to spec.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[1].mediatedDeviceTypes; the field is removed in version 1.15.0",
+				},
 			),
 			Entry("should set the mediatedDeviceTypes only when needed if using a mix of the two",
 				&v1beta1.MediatedDevicesConfiguration{
@@ -448,14 +704,42 @@ var _ = Describe("test HyperConverged mutator", func() {
 					jsonpatch.JsonPatchOperation{
 						Operation: "add",
 						Path:      "/spec/mediatedDevicesConfiguration/mediatedDeviceTypes",
-						Value:     []string{"nvidia-222", "nvidia-230"},
+						Value:     []any{"nvidia-222", "nvidia-230"},
 					},
 					jsonpatch.JsonPatchOperation{
 						Operation: "add",
 						Path:      "/spec/mediatedDevicesConfiguration/nodeMediatedDeviceTypes/1/mediatedDeviceTypes",
-						Value:     []string{"nvidia-229"},
+						Value:     []any{"nvidia-229"},
 					},
 				},
+				[]string{
+					"spec.mediatedDevicesConfiguration.mediatedDevicesTypes: the deprecated field was copied to spec.mediatedDevicesConfiguration.mediatedDeviceTypes; the field is removed in version 1.15.0",
+					"spec.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[1].mediatedDevicesTypes: the deprecated field was copied to spec.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[1].mediatedDeviceTypes; the field is removed in version 1.15.0",
+				},
+			),
+			Entry("should only warn about the deprecated fields, if the new ones are already set",
+				&v1beta1.MediatedDevicesConfiguration{
+					MediatedDevicesTypes: []string{"nvidia-222"},
+					MediatedDeviceTypes:  []string{"nvidia-230"},
+					NodeMediatedDeviceTypes: []v1beta1.NodeMediatedDeviceTypesConfig{
+						{
+							NodeSelector: map[string]string{
+								"testLabel1": "true",
+							},
+							MediatedDevicesTypes: []string{
+								"nvidia-223",
+							},
+							MediatedDeviceTypes: []string{
+								"nvidia-223",
+							},
+						},
+					},
+				},
+				nil,
+				[]string{
+					"spec.mediatedDevicesConfiguration.mediatedDevicesTypes: the field is deprecated, and is removed in version 1.15.0; use spec.mediatedDevicesConfiguration.mediatedDeviceTypes instead",
+					"spec.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[0].mediatedDevicesTypes: the field is deprecated, and is removed in version 1.15.0; use spec.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[0].mediatedDeviceTypes instead",
+				},
 			),
 		)
 
@@ -473,3 +757,27 @@ func pointerEvictionStrategy(strategy kubevirtcorev1.EvictionStrategy) *kubevirt
 	str := strategy
 	return &str
 }
+
+var _ = Describe("getAddPatch", func() {
+	It("should add the first missing element of the path, with its whole value", func() {
+		orig := map[string]any{"spec": map[string]any{"list": []any{map[string]any{}}}}
+		migrated := map[string]any{"spec": map[string]any{"list": []any{map[string]any{"a/b": map[string]any{"c": "d"}}}}}
+
+		Expect(getAddPatch(orig, migrated, []string{"spec", "list", "0", "a/b", "c"})).To(Equal(jsonpatch.JsonPatchOperation{
+			Operation: "add",
+			Path:      "/spec/list/0/a~1b",
+			Value:     map[string]any{"c": "d"},
+		}))
+	})
+
+	It("should replace an existing empty value", func() {
+		orig := map[string]any{"spec": map[string]any{"a": nil}}
+		migrated := map[string]any{"spec": map[string]any{"a": []any{"b"}}}
+
+		Expect(getAddPatch(orig, migrated, []string{"spec", "a"})).To(Equal(jsonpatch.JsonPatchOperation{
+			Operation: "add",
+			Path:      "/spec/a",
+			Value:     []any{"b"},
+		}))
+	})
+})
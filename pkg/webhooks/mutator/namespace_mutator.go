@@ -2,20 +2,33 @@ package mutator
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
 const (
 	ignoreOperationMessage   = "ignoring other operations"
 	admittingDeletionMessage = "the hcoNamespace doesn't contain HyperConverged CR, admitting its deletion"
 	deniedDeletionMessage    = "HyperConverged CR is still present, please remove it before deleting the containing hcoNamespace"
+
+	deniedDependentNsDeletionMessage = "the %s namespace is used by the HyperConverged CR (%s); remove it from the HyperConverged CR, or annotate the namespace with %s=true, before deleting it"
+
+	// AllowNsDeletionAnnotation allows to delete a namespace that the HyperConverged CR refers to, e.g. in the
+	// spec.commonBootImageNamespace field. It does not apply to the HCO namespace.
+	AllowNsDeletionAnnotation = "hco.kubevirt.io/allowDeletion"
 )
 
 var (
@@ -62,35 +75,86 @@ func (nm *NsMutator) handleNsDelete(ctx context.Context, req admission.Request)
 		return admission.Errored(http.StatusBadRequest, err)
 	}
 
-	admitted, err := nm.handleMutatingNsDelete(ctx, ns)
+	deniedMessage, err := nm.handleMutatingNsDelete(ctx, ns)
 	if err != nil {
 		return admission.Errored(http.StatusInternalServerError, err)
 	}
 
-	if admitted {
+	if deniedMessage == "" {
 		return admission.Allowed(admittingDeletionMessage)
 	}
 
-	return admission.Denied(deniedDeletionMessage)
+	return admission.Denied(deniedMessage)
 }
 
-func (nm *NsMutator) handleMutatingNsDelete(ctx context.Context, ns *corev1.Namespace) (bool, error) {
+// handleMutatingNsDelete returns the reason to deny the deletion of the namespace, or an empty string if the deletion
+// is allowed. The HCO namespace can't be deleted while the HyperConverged CR exists. The other namespaces that the
+// HyperConverged CR refers to can't be deleted, unless they are annotated with the AllowNsDeletionAnnotation.
+func (nm *NsMutator) handleMutatingNsDelete(ctx context.Context, ns *corev1.Namespace) (string, error) {
 	logger.Info("validating hcoNamespace deletion", "name", ns.Name)
 
-	if ns.Name != nm.namespace {
-		logger.Info("ignoring request for a different hcoNamespace")
-		return true, nil
-	}
-
 	// Block the deletion if the hcoNamespace with a clear error message
 	// if HCO CR is still there
-	_, err := getHcoObject(ctx, nm.cli, nm.namespace)
+	hco, err := getHcoObject(ctx, nm.cli, nm.namespace)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
-			return true, nil
+			return "", nil
 		}
-		return false, err
+		return "", err
+	}
+
+	if ns.Name == nm.namespace {
+		logger.Info("HCO CR still exists, forbid hcoNamespace deletion")
+		return deniedDeletionMessage, nil
+	}
+
+	fields := getNamespaceReferences(hco, ns.Name)
+	if len(fields) == 0 {
+		logger.Info("ignoring request for a namespace that is not used by the HyperConverged CR")
+		return "", nil
+	}
+
+	if ns.Annotations[AllowNsDeletionAnnotation] == "true" {
+		logger.Info("the namespace is used by the HyperConverged CR, but its deletion is explicitly allowed", "fields", fields)
+		return "", nil
+	}
+
+	logger.Info("the namespace is used by the HyperConverged CR, forbid its deletion", "fields", fields)
+	return fmt.Sprintf(deniedDependentNsDeletionMessage, ns.Name, strings.Join(fields, ", "), AllowNsDeletionAnnotation), nil
+}
+
+// getNamespaceReferences returns the fields of the HyperConverged CR that refer to the namespace. When the namespace
+// of the golden images is not set, SSP creates them in the default golden images namespace, so the unset fields refer
+// to it.
+func getNamespaceReferences(hco *v1beta1.HyperConverged, namespace string) []string {
+	var fields []string
+
+	specPath := field.NewPath("spec")
+	bootImageNamespace := ptr.Deref(hco.Spec.CommonBootImageNamespace, "")
+	if bootImageNamespace == "" && ptr.Deref(hco.Spec.FeatureGates.EnableCommonBootImageImport, false) {
+		bootImageNamespace = hcoutil.DefaultGoldenImagesNamespace
+	}
+
+	if bootImageNamespace == namespace {
+		fields = append(fields, specPath.Child("commonBootImageNamespace").String())
+	}
+
+	if ptr.Deref(hco.Spec.CommonTemplatesNamespace, "") == namespace {
+		fields = append(fields, specPath.Child("commonTemplatesNamespace").String())
+	}
+
+	for i, dict := range hco.Spec.DataImportCronTemplates {
+		if getGoldenImagesNamespace(dict.Namespace) == namespace {
+			fields = append(fields, specPath.Child("dataImportCronTemplates").Index(i).Child("metadata", "namespace").String())
+		}
+	}
+
+	return fields
+}
+
+func getGoldenImagesNamespace(namespace string) string {
+	if namespace == "" {
+		return hcoutil.DefaultGoldenImagesNamespace
 	}
-	logger.Info("HCO CR still exists, forbid hcoNamespace deletion")
-	return false, nil
+	return namespace
 }
@@ -10,6 +10,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
@@ -138,6 +139,117 @@ var _ = Describe("webhooks mutator", func() {
 			Expect(res.Allowed).To(BeTrue())
 		})
 
+		Context("namespaces that the HyperConverged CR refers to", func() {
+			const dependentNs = "golden-images"
+
+			var hco *v1beta1.HyperConverged
+
+			BeforeEach(func() {
+				hco = cr.DeepCopy()
+			})
+
+			newNs := func(annotations map[string]string) *corev1.Namespace {
+				return &corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        dependentNs,
+						Annotations: annotations,
+					},
+				}
+			}
+
+			DescribeTable("should not allow the deletion of the namespace", func(modify func(hc *v1beta1.HyperConverged), field string) {
+				modify(hco)
+				nsMutator := initMutator(s, commontestutils.InitClient([]client.Object{hco}))
+				req := admission.Request{AdmissionRequest: newRequest(admissionv1.Delete, newNs(nil), corev1Codec)}
+
+				res := nsMutator.Handle(context.TODO(), req)
+				Expect(res.Allowed).To(BeFalse())
+				Expect(res.Result.Message).To(Equal("the golden-images namespace is used by the HyperConverged CR (" + field + "); remove it from the HyperConverged CR, or annotate the namespace with hco.kubevirt.io/allowDeletion=true, before deleting it"))
+			},
+				Entry("if it is the common boot image namespace", func(hc *v1beta1.HyperConverged) {
+					hc.Spec.CommonBootImageNamespace = ptr.To(dependentNs)
+				}, "spec.commonBootImageNamespace"),
+				Entry("if it is the common templates namespace", func(hc *v1beta1.HyperConverged) {
+					hc.Spec.CommonTemplatesNamespace = ptr.To(dependentNs)
+				}, "spec.commonTemplatesNamespace"),
+				Entry("if it is the namespace of a DataImportCronTemplate", func(hc *v1beta1.HyperConverged) {
+					hc.Spec.DataImportCronTemplates = []v1beta1.DataImportCronTemplate{
+						{ObjectMeta: metav1.ObjectMeta{Name: "dict1"}},
+						{ObjectMeta: metav1.ObjectMeta{Name: "dict2", Namespace: dependentNs}},
+					}
+				}, "spec.dataImportCronTemplates[1].metadata.namespace"),
+				Entry("with all the fields that refer to it", func(hc *v1beta1.HyperConverged) {
+					hc.Spec.CommonBootImageNamespace = ptr.To(dependentNs)
+					hc.Spec.CommonTemplatesNamespace = ptr.To(dependentNs)
+				}, "spec.commonBootImageNamespace, spec.commonTemplatesNamespace"),
+			)
+
+			DescribeTable("should protect the default golden images namespace", func(modify func(hc *v1beta1.HyperConverged), field string) {
+				modify(hco)
+				nsMutator := initMutator(s, commontestutils.InitClient([]client.Object{hco}))
+				ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: util.DefaultGoldenImagesNamespace}}
+				req := admission.Request{AdmissionRequest: newRequest(admissionv1.Delete, ns, corev1Codec)}
+
+				res := nsMutator.Handle(context.TODO(), req)
+				if field == "" {
+					Expect(res.Allowed).To(BeTrue())
+					return
+				}
+				Expect(res.Allowed).To(BeFalse())
+				Expect(res.Result.Message).To(Equal("the kubevirt-os-images namespace is used by the HyperConverged CR (" + field + "); remove it from the HyperConverged CR, or annotate the namespace with hco.kubevirt.io/allowDeletion=true, before deleting it"))
+			},
+				Entry("if the common boot image namespace is not set", func(hc *v1beta1.HyperConverged) {
+					hc.Spec.FeatureGates.EnableCommonBootImageImport = ptr.To(true)
+				}, "spec.commonBootImageNamespace"),
+				Entry("not if the common boot image namespace is set to another namespace", func(hc *v1beta1.HyperConverged) {
+					hc.Spec.FeatureGates.EnableCommonBootImageImport = ptr.To(true)
+					hc.Spec.CommonBootImageNamespace = ptr.To(dependentNs)
+				}, ""),
+				Entry("not if the common boot images are not imported", func(hc *v1beta1.HyperConverged) {
+					hc.Spec.FeatureGates.EnableCommonBootImageImport = ptr.To(false)
+				}, ""),
+				Entry("if the namespace of a DataImportCronTemplate is not set", func(hc *v1beta1.HyperConverged) {
+					hc.Spec.FeatureGates.EnableCommonBootImageImport = ptr.To(false)
+					hc.Spec.DataImportCronTemplates = []v1beta1.DataImportCronTemplate{
+						{ObjectMeta: metav1.ObjectMeta{Name: "dict1", Namespace: dependentNs}},
+						{ObjectMeta: metav1.ObjectMeta{Name: "dict2"}},
+					}
+				}, "spec.dataImportCronTemplates[1].metadata.namespace"),
+			)
+
+			It("should allow the deletion of the namespace, if it is annotated", func() {
+				hco.Spec.CommonBootImageNamespace = ptr.To(dependentNs)
+				nsMutator := initMutator(s, commontestutils.InitClient([]client.Object{hco}))
+				ns := newNs(map[string]string{AllowNsDeletionAnnotation: "true"})
+				req := admission.Request{AdmissionRequest: newRequest(admissionv1.Delete, ns, corev1Codec)}
+
+				res := nsMutator.Handle(context.TODO(), req)
+				Expect(res.Allowed).To(BeTrue())
+			})
+
+			It("should allow the deletion of the namespace, if the HyperConverged CR does not exist", func() {
+				nsMutator := initMutator(s, commontestutils.InitClient(nil))
+				req := admission.Request{AdmissionRequest: newRequest(admissionv1.Delete, newNs(nil), corev1Codec)}
+
+				res := nsMutator.Handle(context.TODO(), req)
+				Expect(res.Allowed).To(BeTrue())
+			})
+
+			It("should not allow the annotation to bypass the protection of the HCO namespace", func() {
+				hcoNs := &corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        HcoValidNamespace,
+						Annotations: map[string]string{AllowNsDeletionAnnotation: "true"},
+					},
+				}
+				nsMutator := initMutator(s, commontestutils.InitClient([]client.Object{hco}))
+				req := admission.Request{AdmissionRequest: newRequest(admissionv1.Delete, hcoNs, corev1Codec)}
+
+				res := nsMutator.Handle(context.TODO(), req)
+				Expect(res.Allowed).To(BeFalse())
+			})
+		})
+
 		It("should allow other operations", func() {
 			cli := commontestutils.InitClient([]client.Object{cr})
 			nsMutator := initMutator(s, cli)
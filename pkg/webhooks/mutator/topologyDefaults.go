@@ -0,0 +1,272 @@
+package mutator
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+
+	"gomodules.xyz/jsonpatch/v2"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	// the cluster can run one migration per workload node in parallel, within these limits
+	minParallelMigrationsPerCluster = 5
+	maxParallelMigrationsPerCluster = 20
+
+	// small edge clusters have up to smallClusterMaxNodes workload nodes. They have less CPU to overcommit, so each
+	// virtual CPU requests a larger fraction of a physical CPU
+	smallClusterMaxNodes              = 3
+	smallClusterVMICPUAllocationRatio = 4
+)
+
+// clusterTopology is the information about the cluster that the topology defaults are computed from
+type clusterTopology struct {
+	infrastructureHighlyAvailable bool
+	// workloadNodes are the schedulable nodes that can run VMs. It's empty if the nodes are unknown.
+	workloadNodes []corev1.Node
+}
+
+// topologyDefault is a default of a HyperConverged field, that depends on the topology of the cluster
+type topologyDefault struct {
+	// path is the path of the field, e.g. "spec.evictionStrategy"
+	path string
+	// createOnly is true if the default is only applied when the HyperConverged CR is created
+	createOnly bool
+	// isUnset returns true if the field is not set
+	isUnset func(hc *hcov1beta1.HyperConverged) bool
+	// getDefault returns the default value of the field, and false if there is no default for this topology, or if it
+	// is the same as the fallback that the operator uses when the field is not set
+	getDefault func(topology *clusterTopology) (any, bool)
+}
+
+var topologyDefaults = []topologyDefault{
+	{
+		path: "spec.evictionStrategy",
+		isUnset: func(hc *hcov1beta1.HyperConverged) bool {
+			return hc.Spec.EvictionStrategy == nil
+		},
+		getDefault: func(topology *clusterTopology) (any, bool) {
+			if topology.infrastructureHighlyAvailable {
+				return string(kubevirtcorev1.EvictionStrategyLiveMigrate), true
+			}
+			return string(kubevirtcorev1.EvictionStrategyNone), true
+		},
+	},
+	{
+		path:       "spec.liveMigrationConfig.parallelMigrationsPerCluster",
+		createOnly: true,
+		isUnset: func(hc *hcov1beta1.HyperConverged) bool {
+			return hc.Spec.LiveMigrationConfig.ParallelMigrationsPerCluster == nil
+		},
+		getDefault: func(topology *clusterTopology) (any, bool) {
+			value := min(max(len(topology.workloadNodes), minParallelMigrationsPerCluster), maxParallelMigrationsPerCluster)
+			if value == int(hcoutil.DefaultParallelMigrationsPerCluster) {
+				return nil, false
+			}
+			return int64(value), true
+		},
+	},
+	{
+		path:       "spec.workloadUpdateStrategy.workloadUpdateMethods",
+		createOnly: true,
+		isUnset: func(hc *hcov1beta1.HyperConverged) bool {
+			return hc.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods == nil
+		},
+		getDefault: func(topology *clusterTopology) (any, bool) {
+			// VMs can't be live migrated if there is only one node. Don't try to update them automatically, instead of
+			// creating migrations that can never complete.
+			if len(topology.workloadNodes) != 1 {
+				return nil, false
+			}
+			return []any{}, true
+		},
+	},
+	{
+		path:       "spec.resourceRequirements.vmiCPUAllocationRatio",
+		createOnly: true,
+		isUnset: func(hc *hcov1beta1.HyperConverged) bool {
+			return hc.Spec.ResourceRequirements == nil || hc.Spec.ResourceRequirements.VmiCPUAllocationRatio == nil
+		},
+		getDefault: func(topology *clusterTopology) (any, bool) {
+			if len(topology.workloadNodes) == 0 || len(topology.workloadNodes) > smallClusterMaxNodes {
+				return nil, false
+			}
+			return int64(smallClusterVMICPUAllocationRatio), true
+		},
+	},
+	// The CPU model labels are set by the KubeVirt node labeller, so they don't exist yet when the HyperConverged CR is
+	// created on a fresh installation, and this default is only applied if the HyperConverged CR is created after
+	// KubeVirt was deployed, e.g. when it is recreated. The default is not computed later by the operator: it does not
+	// watch the nodes, and the field may be left unset on purpose, to use the host model.
+	{
+		path:       "spec.defaultCPUModel",
+		createOnly: true,
+		isUnset: func(hc *hcov1beta1.HyperConverged) bool {
+			return hc.Spec.DefaultCPUModel == nil
+		},
+		getDefault: func(topology *clusterTopology) (any, bool) {
+			model := getCommonCPUModel(topology.workloadNodes)
+			return model, model != ""
+		},
+	},
+}
+
+// getTopologyDefaultPatches sets the fields of the requested HyperConverged CR that are not set, to the defaults that
+// depend on the topology of the cluster, and records them in the auto-defaults annotation.
+func (hcm *HyperConvergedMutator) getTopologyDefaultPatches(ctx context.Context, req admission.Request, hc *hcov1beta1.HyperConverged) ([]jsonpatch.JsonPatchOperation, error) {
+	obj := map[string]any{}
+	if err := json.Unmarshal(req.Object.Raw, &obj); err != nil {
+		return nil, err
+	}
+
+	topology := &clusterTopology{
+		infrastructureHighlyAvailable: hcoutil.GetClusterInfo().IsInfrastructureHighlyAvailable(),
+	}
+	if req.Operation == admissionv1.Create {
+		nodes, err := hcm.getWorkloadNodes(ctx, hc)
+		if err != nil {
+			// don't block the creation of the HyperConverged CR; use the static defaults instead
+			hcMutatorLogger.Error(err, "failed to list the nodes; skipping the defaults that depend on them")
+		}
+		topology.workloadNodes = nodes
+	}
+
+	var patches []jsonpatch.JsonPatchOperation
+	autoDefaults := map[string]any{}
+	for _, td := range topologyDefaults {
+		if td.createOnly && req.Operation != admissionv1.Create {
+			continue
+		}
+
+		if !td.isUnset(hc) {
+			continue
+		}
+
+		value, ok := td.getDefault(topology)
+		if !ok {
+			continue
+		}
+
+		patch, err := setField(obj, value, strings.Split(td.path, ".")...)
+		if err != nil {
+			return nil, err
+		}
+		patches = append(patches, patch)
+		autoDefaults[td.path] = value
+	}
+
+	if len(autoDefaults) == 0 {
+		return patches, nil
+	}
+
+	// keep the defaults that were recorded when the HyperConverged CR was created
+	recorded := map[string]any{}
+	if annotation, found := hc.Annotations[hcoutil.AutoDefaultsAnnotation]; found {
+		if err := json.Unmarshal([]byte(annotation), &recorded); err != nil {
+			recorded = map[string]any{}
+		}
+	}
+	maps.Copy(recorded, autoDefaults)
+
+	annotation, err := json.Marshal(recorded)
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := setField(obj, string(annotation), "metadata", "annotations", hcoutil.AutoDefaultsAnnotation)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(patches, patch), nil
+}
+
+// getWorkloadNodes returns the schedulable nodes that match the node placement of the workloads
+func (hcm *HyperConvergedMutator) getWorkloadNodes(ctx context.Context, hc *hcov1beta1.HyperConverged) ([]corev1.Node, error) {
+	var opts []client.ListOption
+	var tolerations []corev1.Toleration
+	if np := hc.Spec.Workloads.NodePlacement; np != nil {
+		if len(np.NodeSelector) > 0 {
+			opts = append(opts, client.MatchingLabels(np.NodeSelector))
+		}
+		tolerations = np.Tolerations
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := hcm.cli.List(ctx, nodeList, opts...); err != nil {
+		return nil, err
+	}
+
+	var nodes []corev1.Node
+	for _, node := range nodeList.Items {
+		if !node.Spec.Unschedulable && toleratesTaints(node.Spec.Taints, tolerations) {
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes, nil
+}
+
+func toleratesTaints(taints []corev1.Taint, tolerations []corev1.Toleration) bool {
+	for i := range taints {
+		taint := &taints[i]
+		if taint.Effect == corev1.TaintEffectPreferNoSchedule {
+			continue
+		}
+
+		if !slices.ContainsFunc(tolerations, func(toleration corev1.Toleration) bool {
+			return toleration.ToleratesTaint(taint)
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// getCommonCPUModel returns the host CPU model of one of the nodes, that all the nodes support, according to the CPU
+// model labels of the KubeVirt node labeller. It returns an empty string if there is no such model.
+func getCommonCPUModel(nodes []corev1.Node) string {
+	var hostModels []string
+	for _, node := range nodes {
+		for label, value := range node.Labels {
+			if value == "true" && strings.HasPrefix(label, kubevirtcorev1.HostModelCPULabel) {
+				hostModels = append(hostModels, strings.TrimPrefix(label, kubevirtcorev1.HostModelCPULabel))
+			}
+		}
+	}
+
+	slices.Sort(hostModels)
+	for _, model := range slices.Compact(hostModels) {
+		if !slices.ContainsFunc(nodes, func(node corev1.Node) bool {
+			return node.Labels[kubevirtcorev1.CPUModelLabel+model] != "true"
+		}) {
+			return model
+		}
+	}
+
+	return ""
+}
+
+// setField sets the field of the path in obj, and returns the patch that sets it in the original object
+func setField(obj map[string]any, value any, path ...string) (jsonpatch.JsonPatchOperation, error) {
+	orig := runtime.DeepCopyJSON(obj)
+	if err := unstructured.SetNestedField(obj, value, path...); err != nil {
+		return jsonpatch.JsonPatchOperation{}, err
+	}
+
+	return getAddPatch(orig, obj, path), nil
+}
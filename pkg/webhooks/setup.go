@@ -16,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
 
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
@@ -40,6 +41,7 @@ func SetupWebhookWithManager(ctx context.Context, mgr ctrl.Manager, isOpenshift
 	whHandler := validator.NewWebhookHandler(logger, mgr.GetClient(), decoder, operatorNsEnv, isOpenshift, hcoTLSSecurityProfile)
 	nsMutator := mutator.NewNsMutator(mgr.GetClient(), decoder, operatorNsEnv)
 	hyperConvergedMutator := mutator.NewHyperConvergedMutator(mgr.GetClient(), decoder)
+	operandGuard := validator.NewOperandGuard(logger, mgr.GetClient(), operatorNsEnv)
 
 	if err := allowWatchAllNamespaces(ctx, mgr); err != nil {
 		return err
@@ -50,6 +52,8 @@ func SetupWebhookWithManager(ctx context.Context, mgr ctrl.Manager, isOpenshift
 	srv.Register(hcoutil.HCONSWebhookPath, &webhook.Admission{Handler: nsMutator})
 	srv.Register(hcoutil.HCOMutatingWebhookPath, &webhook.Admission{Handler: hyperConvergedMutator})
 	srv.Register(hcoutil.HCOWebhookPath, &webhook.Admission{Handler: whHandler})
+	srv.Register(hcoutil.HCOOperandsWebhookPath, &webhook.Admission{Handler: operandGuard})
+	srv.Register(hcoutil.HCOConvertWebhookPath, conversion.NewWebhookHandler(mgr.GetScheme()))
 
 	return nil
 }
@@ -0,0 +1,194 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimetav1 "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var (
+	networkAttachmentDefinitionGVK = schema.GroupVersionKind{Group: "k8s.cni.cncf.io", Version: "v1", Kind: "NetworkAttachmentDefinition"}
+	storageClassGVK                = schema.GroupVersionKind{Group: "storage.k8s.io", Version: "v1", Kind: "StorageClass"}
+	runtimeClassGVK                = schema.GroupVersionKind{Group: "node.k8s.io", Version: "v1", Kind: "RuntimeClass"}
+
+	hyperConvergedGroupKind = schema.GroupKind{Group: hcoutil.APIVersionGroup, Kind: hcoutil.HyperConvergedKind}
+)
+
+// validateClusterState validates the fields of the HyperConverged CR that refer to other objects in the cluster. On
+// update, only the modified fields are validated, so a change in the cluster won't block unrelated updates of the
+// HyperConverged CR. exists is nil on create.
+func (wh *WebhookHandler) validateClusterState(ctx context.Context, requested, exists *v1beta1.HyperConverged) error {
+	var errs field.ErrorList
+
+	specPath := field.NewPath("spec")
+
+	for _, ref := range []struct {
+		fldPath    *field.Path
+		gvk        schema.GroupVersionKind
+		namespaced bool
+		get        func(spec *v1beta1.HyperConvergedSpec) *string
+	}{
+		{
+			fldPath:    specPath.Child("liveMigrationConfig", "network"),
+			gvk:        networkAttachmentDefinitionGVK,
+			namespaced: true,
+			get:        func(spec *v1beta1.HyperConvergedSpec) *string { return spec.LiveMigrationConfig.Network },
+		},
+		{
+			fldPath: specPath.Child("scratchSpaceStorageClass"),
+			gvk:     storageClassGVK,
+			get:     func(spec *v1beta1.HyperConvergedSpec) *string { return spec.ScratchSpaceStorageClass },
+		},
+		{
+			fldPath: specPath.Child("vmStateStorageClass"),
+			gvk:     storageClassGVK,
+			get:     func(spec *v1beta1.HyperConvergedSpec) *string { return spec.VMStateStorageClass },
+		},
+		{
+			fldPath: specPath.Child("defaultRuntimeClass"),
+			gvk:     runtimeClassGVK,
+			get:     func(spec *v1beta1.HyperConvergedSpec) *string { return spec.DefaultRuntimeClass },
+		},
+	} {
+		name := ptr.Deref(ref.get(&requested.Spec), "")
+		if name == "" || (exists != nil && ptr.Deref(ref.get(&exists.Spec), "") == name) {
+			continue
+		}
+
+		key := client.ObjectKey{Name: name}
+		if ref.namespaced {
+			key.Namespace = requested.Namespace
+		}
+
+		fldErrs, err := wh.validateObjectExists(ctx, ref.fldPath, ref.gvk, key)
+		if err != nil {
+			return err
+		}
+		errs = append(errs, fldErrs...)
+	}
+
+	if exists == nil || !reflect.DeepEqual(exists.Spec.PermittedHostDevices, requested.Spec.PermittedHostDevices) {
+		errs = append(errs, validatePermittedHostDevicesResourceNames(requested.Spec.PermittedHostDevices, specPath.Child("permittedHostDevices"))...)
+	}
+
+	if len(errs) > 0 {
+		return apierrors.NewInvalid(hyperConvergedGroupKind, requested.Name, errs)
+	}
+
+	return nil
+}
+
+// validateObjectExists returns a field error if the object does not exist, or if its kind is not served by the cluster
+func (wh *WebhookHandler) validateObjectExists(ctx context.Context, fldPath *field.Path, gvk schema.GroupVersionKind, key client.ObjectKey) (field.ErrorList, error) {
+	obj := &metav1.PartialObjectMetadata{}
+	obj.SetGroupVersionKind(gvk)
+
+	err := wh.cli.Get(ctx, key, obj)
+	if err == nil {
+		return nil, nil
+	}
+
+	if apierrors.IsNotFound(err) || apimetav1.IsNoMatchError(err) {
+		detail := fmt.Sprintf("the %s does not exist", gvk.Kind)
+		if key.Namespace != "" {
+			detail = fmt.Sprintf("the %s does not exist in the %s namespace", gvk.Kind, key.Namespace)
+		}
+		return field.ErrorList{field.Invalid(fldPath, key.Name, detail)}, nil
+	}
+
+	wh.logger.Error(err, "failed to read an object referenced by the HyperConverged CR", "kind", gvk.Kind, "name", key.Name)
+	return nil, err
+}
+
+// validatePermittedHostDevicesResourceNames rejects permitted host devices that are advertised with the same resource
+// name. Disabled devices are not passed to KubeVirt, and so they are ignored.
+func validatePermittedHostDevicesResourceNames(phd *v1beta1.PermittedHostDevices, fldPath *field.Path) field.ErrorList {
+	if phd == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	resourceNames := map[string]bool{}
+	checkResourceName := func(resourceName string, disabled bool, devicePath *field.Path) {
+		if disabled {
+			return
+		}
+		if resourceNames[resourceName] {
+			errs = append(errs, field.Duplicate(devicePath.Child("resourceName"), resourceName))
+		}
+		resourceNames[resourceName] = true
+	}
+
+	for i, dev := range phd.PciHostDevices {
+		checkResourceName(dev.ResourceName, dev.Disabled, fldPath.Child("pciHostDevices").Index(i))
+	}
+	for i, dev := range phd.USBHostDevices {
+		checkResourceName(dev.ResourceName, dev.Disabled, fldPath.Child("usbHostDevices").Index(i))
+	}
+	for i, dev := range phd.MediatedDevices {
+		checkResourceName(dev.ResourceName, dev.Disabled, fldPath.Child("mediatedDevices").Index(i))
+	}
+
+	return errs
+}
+
+// getNodePlacementWarnings returns a warning for each infra or workloads node selector that does not match any node.
+// Nodes may be added later, so the request is not rejected. exists is nil on create.
+func (wh *WebhookHandler) getNodePlacementWarnings(ctx context.Context, requested, exists *v1beta1.HyperConverged) admission.Warnings {
+	var warnings admission.Warnings
+
+	for _, np := range []struct {
+		fldPath *field.Path
+		get     func(spec *v1beta1.HyperConvergedSpec) map[string]string
+	}{
+		{
+			fldPath: field.NewPath("spec", "infra", "nodePlacement", "nodeSelector"),
+			get: func(spec *v1beta1.HyperConvergedSpec) map[string]string {
+				if spec.Infra.NodePlacement == nil {
+					return nil
+				}
+				return spec.Infra.NodePlacement.NodeSelector
+			},
+		},
+		{
+			fldPath: field.NewPath("spec", "workloads", "nodePlacement", "nodeSelector"),
+			get: func(spec *v1beta1.HyperConvergedSpec) map[string]string {
+				if spec.Workloads.NodePlacement == nil {
+					return nil
+				}
+				return spec.Workloads.NodePlacement.NodeSelector
+			},
+		},
+	} {
+		nodeSelector := np.get(&requested.Spec)
+		if len(nodeSelector) == 0 || (exists != nil && reflect.DeepEqual(np.get(&exists.Spec), nodeSelector)) {
+			continue
+		}
+
+		nodes := &metav1.PartialObjectMetadataList{}
+		nodes.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("NodeList"))
+		if err := wh.cli.List(ctx, nodes, client.MatchingLabels(nodeSelector), client.Limit(1)); err != nil {
+			wh.logger.Error(err, "failed to list the nodes that match a node selector", "field", np.fldPath.String())
+			continue
+		}
+
+		if len(nodes.Items) == 0 {
+			warnings = append(warnings, fmt.Sprintf("%s: no node matches the node selector", np.fldPath.String()))
+		}
+	}
+
+	return warnings
+}
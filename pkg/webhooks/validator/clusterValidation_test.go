@@ -0,0 +1,206 @@
+package validator
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("cluster aware validation", func() {
+	const (
+		nadName          = "migration-network"
+		storageClassName = "local-storage"
+		runtimeClassName = "custom-runtime"
+	)
+
+	var (
+		s       *runtime.Scheme
+		decoder admission.Decoder
+		ctx     context.Context
+		cr      *v1beta1.HyperConverged
+	)
+
+	BeforeEach(func() {
+		Expect(os.Setenv("OPERATOR_NAMESPACE", HcoValidNamespace)).To(Succeed())
+		s = scheme.Scheme
+		Expect(v1beta1.AddToScheme(s)).To(Succeed())
+		decoder = admission.NewDecoder(s)
+		ctx = context.TODO()
+		cr = commontestutils.NewHco()
+	})
+
+	newNAD := func(namespace string) *unstructured.Unstructured {
+		nad := &unstructured.Unstructured{}
+		nad.SetGroupVersionKind(networkAttachmentDefinitionGVK)
+		nad.SetName(nadName)
+		nad.SetNamespace(namespace)
+		return nad
+	}
+
+	newWebhookHandler := func(objs ...client.Object) *WebhookHandler {
+		cli := fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+		return NewWebhookHandler(logger, cli, decoder, HcoValidNamespace, true, nil)
+	}
+
+	Context("referenced objects", func() {
+		It("should reject references to missing objects on create, and name the fields", func() {
+			wh := newWebhookHandler()
+
+			cr.Spec.LiveMigrationConfig.Network = ptr.To(nadName)
+			cr.Spec.ScratchSpaceStorageClass = ptr.To(storageClassName)
+			cr.Spec.VMStateStorageClass = ptr.To(storageClassName)
+			cr.Spec.DefaultRuntimeClass = ptr.To(runtimeClassName)
+
+			err := wh.ValidateCreate(ctx, false, cr)
+			Expect(err).To(MatchError(And(
+				ContainSubstring(`spec.liveMigrationConfig.network: Invalid value: "migration-network": the NetworkAttachmentDefinition does not exist in the kubevirt-hyperconverged namespace`),
+				ContainSubstring(`spec.scratchSpaceStorageClass: Invalid value: "local-storage": the StorageClass does not exist`),
+				ContainSubstring(`spec.vmStateStorageClass: Invalid value: "local-storage": the StorageClass does not exist`),
+				ContainSubstring(`spec.defaultRuntimeClass: Invalid value: "custom-runtime": the RuntimeClass does not exist`),
+			)))
+		})
+
+		It("should allow references to existing objects", func() {
+			wh := newWebhookHandler(
+				newNAD(HcoValidNamespace),
+				&storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: storageClassName}},
+				&nodev1.RuntimeClass{ObjectMeta: metav1.ObjectMeta{Name: runtimeClassName}, Handler: "runc"},
+			)
+
+			cr.Spec.LiveMigrationConfig.Network = ptr.To(nadName)
+			cr.Spec.ScratchSpaceStorageClass = ptr.To(storageClassName)
+			cr.Spec.VMStateStorageClass = ptr.To(storageClassName)
+			cr.Spec.DefaultRuntimeClass = ptr.To(runtimeClassName)
+
+			Expect(wh.ValidateCreate(ctx, false, cr)).To(Succeed())
+		})
+
+		It("should reject a NetworkAttachmentDefinition from another namespace", func() {
+			wh := newWebhookHandler(newNAD("default"))
+
+			cr.Spec.LiveMigrationConfig.Network = ptr.To(nadName)
+
+			Expect(wh.ValidateCreate(ctx, false, cr)).To(MatchError(ContainSubstring("spec.liveMigrationConfig.network")))
+		})
+
+		It("should reject a modified reference to a missing object on update", func() {
+			existing := cr.DeepCopy()
+			existing.Spec.ScratchSpaceStorageClass = ptr.To(storageClassName)
+
+			wh := newWebhookHandler(&storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: storageClassName}})
+
+			cr.Spec.ScratchSpaceStorageClass = ptr.To("another-storage")
+
+			Expect(wh.ValidateUpdate(ctx, false, cr, existing)).To(MatchError(ContainSubstring(
+				`spec.scratchSpaceStorageClass: Invalid value: "another-storage": the StorageClass does not exist`,
+			)))
+		})
+
+		It("should not check unmodified references on update", func() {
+			cr.Spec.ScratchSpaceStorageClass = ptr.To(storageClassName)
+			cr.Spec.DefaultRuntimeClass = ptr.To(runtimeClassName)
+			existing := cr.DeepCopy()
+
+			hco := commontestutils.NewHco()
+			cli := getFakeClient(hco)
+			wh := NewWebhookHandler(logger, cli, decoder, HcoValidNamespace, true, nil)
+
+			Expect(wh.validateClusterState(ctx, cr, existing)).To(Succeed())
+		})
+	})
+
+	Context("permitted host devices", func() {
+		It("should reject duplicate resource names, across the device lists", func() {
+			wh := newWebhookHandler()
+
+			cr.Spec.PermittedHostDevices = &v1beta1.PermittedHostDevices{
+				PciHostDevices: []v1beta1.PciHostDevice{
+					{PCIDeviceSelector: "111", ResourceName: "name1"},
+					{PCIDeviceSelector: "222", ResourceName: "name1"},
+				},
+				MediatedDevices: []v1beta1.MediatedHostDevice{
+					{MDEVNameSelector: "333", ResourceName: "name2"},
+				},
+				USBHostDevices: []v1beta1.USBHostDevice{
+					{ResourceName: "name2", Selectors: []v1beta1.USBSelector{{Vendor: "0001", Product: "0002"}}},
+				},
+			}
+
+			err := wh.ValidateCreate(ctx, false, cr)
+			Expect(err).To(MatchError(And(
+				ContainSubstring(`spec.permittedHostDevices.pciHostDevices[1].resourceName: Duplicate value: "name1"`),
+				ContainSubstring(`spec.permittedHostDevices.mediatedDevices[0].resourceName: Duplicate value: "name2"`),
+			)))
+		})
+
+		It("should ignore disabled devices", func() {
+			wh := newWebhookHandler()
+
+			cr.Spec.PermittedHostDevices = &v1beta1.PermittedHostDevices{
+				PciHostDevices: []v1beta1.PciHostDevice{
+					{PCIDeviceSelector: "111", ResourceName: "name1"},
+					{PCIDeviceSelector: "222", ResourceName: "name1", Disabled: true},
+				},
+			}
+
+			Expect(wh.ValidateCreate(ctx, false, cr)).To(Succeed())
+		})
+	})
+
+	Context("node placement warnings", func() {
+		var v1beta1Codec runtime.Encoder
+
+		BeforeEach(func() {
+			v1beta1Codec = serializer.NewCodecFactory(s).LegacyCodec(v1beta1.SchemeGroupVersion)
+		})
+
+		It("should warn about node selectors that don't match any node", func() {
+			wh := newWebhookHandler(&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1", Labels: map[string]string{"infra": "true"}}})
+
+			cr.Spec.Infra.NodePlacement = &sdkapi.NodePlacement{NodeSelector: map[string]string{"infra": "true"}}
+			cr.Spec.Workloads.NodePlacement = &sdkapi.NodePlacement{NodeSelector: map[string]string{"workloads": "true"}}
+
+			res := wh.Handle(ctx, newRequest(admissionv1.Create, cr, v1beta1Codec, false))
+			Expect(res.Allowed).To(BeTrue())
+			Expect(res.Warnings).To(ConsistOf("spec.workloads.nodePlacement.nodeSelector: no node matches the node selector"))
+		})
+
+		It("should not warn if the node selectors match nodes", func() {
+			wh := newWebhookHandler(&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1", Labels: map[string]string{"infra": "true", "workloads": "true"}}})
+
+			cr.Spec.Infra.NodePlacement = &sdkapi.NodePlacement{NodeSelector: map[string]string{"infra": "true"}}
+			cr.Spec.Workloads.NodePlacement = &sdkapi.NodePlacement{NodeSelector: map[string]string{"workloads": "true"}}
+
+			Expect(wh.getNodePlacementWarnings(ctx, cr, nil)).To(BeEmpty())
+		})
+
+		It("should not warn about unmodified node selectors on update", func() {
+			wh := newWebhookHandler()
+
+			cr.Spec.Infra.NodePlacement = &sdkapi.NodePlacement{NodeSelector: map[string]string{"infra": "true"}}
+			existing := cr.DeepCopy()
+
+			Expect(wh.getNodePlacementWarnings(ctx, cr, existing)).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,98 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-logr/logr"
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ admission.Handler = &OperandGuard{}
+
+// OperandGuard validates the updates of the operand CRs that HCO manages. HCO reverts the changes in the fields it
+// controls, so according to the spec.reconcilePolicy.outOfBandChanges field of the HyperConverged CR, the guard warns
+// about such changes, or denies them, pointing to the HyperConverged field that should be used instead.
+type OperandGuard struct {
+	logger    logr.Logger
+	cli       client.Client
+	namespace string
+}
+
+func NewOperandGuard(logger logr.Logger, cli client.Client, namespace string) *OperandGuard {
+	return &OperandGuard{
+		logger:    logger,
+		cli:       cli,
+		namespace: namespace,
+	}
+}
+
+func (og *OperandGuard) Handle(ctx context.Context, req admission.Request) admission.Response {
+	operand, ok := operands.OperandKinds[req.Kind.Kind]
+	if req.Operation != admissionv1.Update || !ok {
+		return admission.Allowed("")
+	}
+
+	if req.UserInfo.Username == fmt.Sprintf("system:serviceaccount:%s:%s", og.namespace, hcoutil.HcoServiceAccountName) {
+		return admission.Allowed("")
+	}
+
+	hc := &v1beta1.HyperConverged{}
+	if err := og.cli.Get(ctx, client.ObjectKey{Namespace: og.namespace, Name: hcoutil.HyperConvergedName}, hc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.Allowed("")
+		}
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	policy := getOutOfBandChangesPolicy(hc)
+	if policy == v1beta1.OutOfBandChangesAllow || operands.IsOperandUnmanaged(hc, operand) {
+		return admission.Allowed("")
+	}
+
+	oldObj, newObj := &unstructured.Unstructured{}, &unstructured.Unstructured{}
+	if err := oldObj.UnmarshalJSON(req.OldObject.Raw); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if err := newObj.UnmarshalJSON(req.Object.Raw); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	changes, err := operands.GetOutOfBandChanges(operand, oldObj, newObj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if len(changes) == 0 {
+		return admission.Allowed("")
+	}
+
+	messages := make([]string, 0, len(changes))
+	for _, change := range changes {
+		messages = append(messages, fmt.Sprintf("%s: the field is controlled by the %s field of the HyperConverged CR, and HCO will revert the change", change.Field, change.ControlledBy))
+	}
+
+	og.logger.Info("out-of-band change of an operand CR", "kind", req.Kind.Kind, "name", req.Name, "user", req.UserInfo.Username, "policy", policy)
+
+	if policy == v1beta1.OutOfBandChangesDeny {
+		return admission.Denied(strings.Join(messages, "; "))
+	}
+
+	return admission.Allowed("").WithWarnings(messages...)
+}
+
+func getOutOfBandChangesPolicy(hc *v1beta1.HyperConverged) v1beta1.OutOfBandChangesPolicy {
+	if hc.Spec.ReconcilePolicy == nil || hc.Spec.ReconcilePolicy.OutOfBandChanges == "" {
+		return v1beta1.OutOfBandChangesAllow
+	}
+	return hc.Spec.ReconcilePolicy.OutOfBandChanges
+}
@@ -0,0 +1,156 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("operand guard", func() {
+	const (
+		hcoOwnedFields = `{"f:spec":{"f:configuration":{"f:migrations":{"f:parallelMigrationsPerCluster":{}}}}}`
+		expectedMsg    = "spec.configuration.migrations.parallelMigrationsPerCluster: the field is controlled by the spec.liveMigrationConfig field of the HyperConverged CR, and HCO will revert the change"
+	)
+
+	var (
+		s   *runtime.Scheme
+		ctx context.Context
+		hco *v1beta1.HyperConverged
+	)
+
+	BeforeEach(func() {
+		s = scheme.Scheme
+		Expect(v1beta1.AddToScheme(s)).To(Succeed())
+		ctx = context.TODO()
+		hco = commontestutils.NewHco()
+		hco.Spec.ReconcilePolicy = &v1beta1.ReconcilePolicy{OutOfBandChanges: v1beta1.OutOfBandChangesDeny}
+	})
+
+	newOperandGuard := func(objs ...client.Object) *OperandGuard {
+		cli := fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+		return NewOperandGuard(logger, cli, HcoValidNamespace)
+	}
+
+	newKV := func(parallelMigrations uint32) *kubevirtcorev1.KubeVirt {
+		return &kubevirtcorev1.KubeVirt{
+			TypeMeta: metav1.TypeMeta{APIVersion: "kubevirt.io/v1", Kind: "KubeVirt"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubevirt-" + hcoutil.HyperConvergedName,
+				Namespace: HcoValidNamespace,
+				ManagedFields: []metav1.ManagedFieldsEntry{
+					{
+						Manager:   hcoutil.FieldManager,
+						Operation: metav1.ManagedFieldsOperationApply,
+						FieldsV1:  &metav1.FieldsV1{Raw: []byte(hcoOwnedFields)},
+					},
+				},
+			},
+			Spec: kubevirtcorev1.KubeVirtSpec{
+				Configuration: kubevirtcorev1.KubeVirtConfiguration{
+					MigrationConfiguration: &kubevirtcorev1.MigrationConfiguration{
+						ParallelMigrationsPerCluster: &parallelMigrations,
+					},
+				},
+			},
+		}
+	}
+
+	newOperandRequest := func(oldObj, newObj client.Object, username string) admission.Request {
+		oldRaw, err := json.Marshal(oldObj)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		newRaw, err := json.Marshal(newObj)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+
+		return admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Update,
+				Kind:      metav1.GroupVersionKind{Group: "kubevirt.io", Version: "v1", Kind: "KubeVirt"},
+				Name:      newObj.GetName(),
+				Namespace: newObj.GetNamespace(),
+				UserInfo:  authenticationv1.UserInfo{Username: username},
+				OldObject: runtime.RawExtension{Raw: oldRaw},
+				Object:    runtime.RawExtension{Raw: newRaw},
+			},
+		}
+	}
+
+	It("should deny an out-of-band change, if the policy is Deny", func() {
+		og := newOperandGuard(hco)
+
+		res := og.Handle(ctx, newOperandRequest(newKV(5), newKV(10), "system:admin"))
+		Expect(res.Allowed).To(BeFalse())
+		Expect(res.Result.Message).To(Equal(expectedMsg))
+	})
+
+	It("should warn about an out-of-band change, if the policy is Warn", func() {
+		hco.Spec.ReconcilePolicy.OutOfBandChanges = v1beta1.OutOfBandChangesWarn
+		og := newOperandGuard(hco)
+
+		res := og.Handle(ctx, newOperandRequest(newKV(5), newKV(10), "system:admin"))
+		Expect(res.Allowed).To(BeTrue())
+		Expect(res.Warnings).To(ConsistOf(expectedMsg))
+	})
+
+	It("should allow the changes that don't modify fields HCO controls", func() {
+		og := newOperandGuard(hco)
+		kv := newKV(5)
+		kv.Spec.ImagePullPolicy = "Always"
+
+		res := og.Handle(ctx, newOperandRequest(newKV(5), kv, "system:admin"))
+		Expect(res.Allowed).To(BeTrue())
+		Expect(res.Warnings).To(BeEmpty())
+	})
+
+	It("should allow the changes made by HCO", func() {
+		og := newOperandGuard(hco)
+
+		res := og.Handle(ctx, newOperandRequest(newKV(5), newKV(10), fmt.Sprintf("system:serviceaccount:%s:%s", HcoValidNamespace, hcoutil.HcoServiceAccountName)))
+		Expect(res.Allowed).To(BeTrue())
+	})
+
+	DescribeTable("should allow out-of-band changes", func(modify func(hc *v1beta1.HyperConverged)) {
+		modify(hco)
+		og := newOperandGuard(hco)
+
+		res := og.Handle(ctx, newOperandRequest(newKV(5), newKV(10), "system:admin"))
+		Expect(res.Allowed).To(BeTrue())
+		Expect(res.Warnings).To(BeEmpty())
+	},
+		Entry("if the policy is Allow", func(hc *v1beta1.HyperConverged) {
+			hc.Spec.ReconcilePolicy.OutOfBandChanges = v1beta1.OutOfBandChangesAllow
+		}),
+		Entry("if the policy is not set", func(hc *v1beta1.HyperConverged) {
+			hc.Spec.ReconcilePolicy = nil
+		}),
+		Entry("if the reconciliation is paused", func(hc *v1beta1.HyperConverged) {
+			hc.Spec.ReconcilePolicy.Paused = true
+		}),
+		Entry("if the operand is Unmanaged", func(hc *v1beta1.HyperConverged) {
+			hc.Spec.ReconcilePolicy.Operands = &v1beta1.OperandManagementStates{KubeVirt: v1beta1.ManagementStateUnmanaged}
+		}),
+	)
+
+	It("should allow the changes if the HyperConverged CR does not exist", func() {
+		og := newOperandGuard()
+
+		res := og.Handle(ctx, newOperandRequest(newKV(5), newKV(10), "system:admin"))
+		Expect(res.Allowed).To(BeTrue())
+	})
+})
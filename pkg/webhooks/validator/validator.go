@@ -6,25 +6,22 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
 	"github.com/samber/lo"
-	xsync "golang.org/x/sync/errgroup"
 	admissionv1 "k8s.io/api/admission/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"k8s.io/utils/strings/slices"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
-	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
-	kubevirtcorev1 "kubevirt.io/api/core/v1"
-	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
-	sspv1beta2 "kubevirt.io/ssp-operator/api/v1beta2"
-
 	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
@@ -32,6 +29,8 @@ import (
 
 const (
 	updateDryRunTimeOut = time.Second * 3
+
+	statusSubresource = "status"
 )
 
 type WebhookHandler struct {
@@ -64,7 +63,10 @@ func (wh *WebhookHandler) Handle(ctx context.Context, req admission.Request) adm
 
 	dryRun := req.DryRun != nil && *req.DryRun
 
-	var err error
+	var (
+		err      error
+		warnings admission.Warnings
+	)
 	switch req.Operation {
 	case admissionv1.Create:
 		if err := wh.decoder.Decode(req, obj); err != nil {
@@ -72,7 +74,15 @@ func (wh *WebhookHandler) Handle(ctx context.Context, req admission.Request) adm
 		}
 
 		err = wh.ValidateCreate(ctx, dryRun, obj)
+		if err == nil {
+			warnings = wh.getWarnings(ctx, obj, nil)
+		}
 	case admissionv1.Update:
+		if req.SubResource == statusSubresource {
+			err = wh.validateStatusUpdate(req)
+			break
+		}
+
 		oldObj := &v1beta1.HyperConverged{}
 		if err := wh.decoder.DecodeRaw(req.Object, obj); err != nil {
 			return admission.Errored(http.StatusBadRequest, err)
@@ -81,7 +91,13 @@ func (wh *WebhookHandler) Handle(ctx context.Context, req admission.Request) adm
 			return admission.Errored(http.StatusBadRequest, err)
 		}
 
-		err = wh.ValidateUpdate(ctx, dryRun, obj, oldObj)
+		var dryRunWarnings admission.Warnings
+		if err = wh.validateFinalizerRemoval(req, obj, oldObj); err == nil {
+			dryRunWarnings, err = wh.validateUpdate(ctx, dryRun, obj, oldObj)
+		}
+		if err == nil {
+			warnings = append(wh.getWarnings(ctx, obj, oldObj), dryRunWarnings...)
+		}
 	case admissionv1.Delete:
 		// In reference to PR: https://github.com/kubernetes/kubernetes/pull/76346
 		// OldObject contains the object being deleted
@@ -104,10 +120,10 @@ func (wh *WebhookHandler) Handle(ctx context.Context, req admission.Request) adm
 	}
 
 	// Return allowed if everything succeeded.
-	return admission.Allowed("")
+	return admission.Allowed("").WithWarnings(warnings...)
 }
 
-func (wh *WebhookHandler) ValidateCreate(_ context.Context, dryrun bool, hc *v1beta1.HyperConverged) error {
+func (wh *WebhookHandler) ValidateCreate(ctx context.Context, dryrun bool, hc *v1beta1.HyperConverged) error {
 	wh.logger.Info("Validating create", "name", hc.Name, "namespace:", hc.Namespace)
 
 	if err := wh.validateCertConfig(hc); err != nil {
@@ -118,6 +134,10 @@ func (wh *WebhookHandler) ValidateCreate(_ context.Context, dryrun bool, hc *v1b
 		return fmt.Errorf("invalid namespace for v1beta1.HyperConverged - please use the %s namespace", wh.namespace)
 	}
 
+	if hc.Name != hcoutil.HyperConvergedName {
+		return fmt.Errorf("invalid name for v1beta1.HyperConverged - please use the %s name", hcoutil.HyperConvergedName)
+	}
+
 	if err := wh.validateDataImportCronTemplates(hc); err != nil {
 		return err
 	}
@@ -130,164 +150,142 @@ func (wh *WebhookHandler) ValidateCreate(_ context.Context, dryrun bool, hc *v1b
 		return err
 	}
 
-	if _, err := operands.NewKubeVirt(hc); err != nil {
+	if err := wh.validateAlertSilences(hc); err != nil {
 		return err
 	}
 
-	if _, err := operands.NewCDI(hc); err != nil {
+	if err := wh.validateHostPathProvisioner(hc); err != nil {
 		return err
 	}
 
-	if _, err := operands.NewNetworkAddons(hc); err != nil {
+	if err := wh.validateClusterState(ctx, hc, nil); err != nil {
 		return err
 	}
 
-	if _, _, err := operands.NewSSP(hc); err != nil {
+	if _, err := operands.NewKubeVirt(hc); err != nil {
 		return err
 	}
 
-	if !dryrun {
-		hcoTLSConfigCache = hc.Spec.TLSSecurityProfile
+	if _, err := operands.NewCDI(hc); err != nil {
+		return err
 	}
 
-	return nil
-}
+	if _, err := operands.NewNetworkAddons(hc); err != nil {
+		return err
+	}
 
-func (wh *WebhookHandler) getOperands(requested *v1beta1.HyperConverged) (*kubevirtcorev1.KubeVirt, *cdiv1beta1.CDI, *networkaddonsv1.NetworkAddonsConfig, error) {
-	if err := wh.validateCertConfig(requested); err != nil {
-		return nil, nil, nil, err
+	if _, _, err := operands.NewSSP(hc); err != nil {
+		return err
 	}
 
-	kv, err := operands.NewKubeVirt(requested)
-	if err != nil {
-		return nil, nil, nil, err
+	if _, err := operands.NewAAQ(hc); err != nil {
+		return err
 	}
 
-	cdi, err := operands.NewCDI(requested)
-	if err != nil {
-		return nil, nil, nil, err
+	if _, err := operands.NewHostPathProvisioner(hc); err != nil {
+		return err
 	}
 
-	cna, err := operands.NewNetworkAddons(requested)
-	if err != nil {
-		return nil, nil, nil, err
+	if !dryrun {
+		hcoTLSConfigCache = hc.Spec.TLSSecurityProfile
 	}
 
-	return kv, cdi, cna, nil
+	return nil
 }
 
-// ValidateUpdate is the ValidateUpdate webhook implementation. It calls all the resources in parallel, to dry-run the
-// upgrade.
+// ValidateUpdate is the ValidateUpdate webhook implementation. It dry-runs the update of all the objects that HCO
+// creates and reconciles for the requested HyperConverged CR, in parallel.
 func (wh *WebhookHandler) ValidateUpdate(ctx context.Context, dryrun bool, requested *v1beta1.HyperConverged, exists *v1beta1.HyperConverged) error {
+	_, err := wh.validateUpdate(ctx, dryrun, requested, exists)
+	return err
+}
+
+// validateUpdate validates the update, and returns a warning for the objects that the webhook can't render, and so
+// can't dry-run
+func (wh *WebhookHandler) validateUpdate(ctx context.Context, dryrun bool, requested *v1beta1.HyperConverged, exists *v1beta1.HyperConverged) (admission.Warnings, error) {
 	wh.logger.Info("Validating update", "name", requested.Name)
 
 	if err := wh.validateDataImportCronTemplates(requested); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := wh.validateTLSSecurityProfiles(requested); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := wh.validateMediatedDeviceTypes(requested); err != nil {
-		return err
+		return nil, err
+	}
+
+	if err := wh.validateAlertSilences(requested); err != nil {
+		return nil, err
 	}
 
 	// If no change is detected in the spec nor the annotations - nothing to validate
 	if reflect.DeepEqual(exists.Spec, requested.Spec) &&
 		reflect.DeepEqual(exists.Annotations, requested.Annotations) {
-		return nil
+		return nil, nil
 	}
 
-	kv, cdi, cna, err := wh.getOperands(requested)
-	if err != nil {
-		return err
+	if err := wh.validateCertConfig(requested); err != nil {
+		return nil, err
 	}
 
-	toCtx, cancel := context.WithTimeout(ctx, updateDryRunTimeOut)
-	defer cancel()
-
-	eg, egCtx := xsync.WithContext(toCtx)
-	opts := &client.UpdateOptions{DryRun: []string{metav1.DryRunAll}}
-
-	resources := []client.Object{
-		kv,
-		cdi,
-		cna,
+	if err := wh.validateHostPathProvisioner(requested); err != nil {
+		return nil, err
 	}
 
-	if wh.isOpenshift {
-		ssp, _, err := operands.NewSSP(requested)
-		if err != nil {
-			return err
-		}
-		resources = append(resources, ssp)
+	if err := wh.validateClusterState(ctx, requested, exists); err != nil {
+		return nil, err
 	}
 
-	for _, obj := range resources {
-		func(o client.Object) {
-			eg.Go(func() error {
-				return wh.updateOperatorCr(egCtx, requested, o, opts)
-			})
-		}(obj)
+	runners, skipped, err := operands.GetDryRunners(wh.cli, wh.cli.Scheme(), hcoutil.GetClusterInfo(), requested, exists)
+	if err != nil {
+		return nil, err
 	}
 
-	err = eg.Wait()
-	if err != nil {
-		return err
+	if err = wh.dryRunOperands(ctx, runners); err != nil {
+		return nil, err
 	}
 
 	if !dryrun {
 		hcoTLSConfigCache = requested.Spec.TLSSecurityProfile
 	}
 
-	return nil
-}
-
-func (wh *WebhookHandler) updateOperatorCr(ctx context.Context, hc *v1beta1.HyperConverged, exists client.Object, opts *client.UpdateOptions) error {
-	err := hcoutil.GetRuntimeObject(ctx, wh.cli, exists)
-	if err != nil {
-		wh.logger.Error(err, "failed to get object from kubernetes", "kind", exists.GetObjectKind())
-		return err
+	var warnings admission.Warnings
+	if len(skipped) > 0 {
+		warnings = append(warnings, fmt.Sprintf("the following objects were not validated, because the webhook can't render them: %s", strings.Join(skipped, ", ")))
 	}
 
-	switch existing := exists.(type) {
-	case *kubevirtcorev1.KubeVirt:
-		required, err := operands.NewKubeVirt(hc)
-		if err != nil {
-			return err
-		}
-		required.Spec.DeepCopyInto(&existing.Spec)
+	return warnings, nil
+}
 
-	case *cdiv1beta1.CDI:
-		required, err := operands.NewCDI(hc)
-		if err != nil {
-			return err
-		}
-		required.Spec.DeepCopyInto(&existing.Spec)
+// dryRunOperands runs all the dry-runners in parallel, each with its own timeout, and returns the errors of all the
+// failed ones
+func (wh *WebhookHandler) dryRunOperands(ctx context.Context, runners []operands.DryRunner) error {
+	errs := make([]error, len(runners))
 
-	case *networkaddonsv1.NetworkAddonsConfig:
-		required, err := operands.NewNetworkAddons(hc)
-		if err != nil {
-			return err
-		}
-		required.Spec.DeepCopyInto(&existing.Spec)
+	var wg sync.WaitGroup
+	for i, runner := range runners {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
 
-	case *sspv1beta2.SSP:
-		required, _, err := operands.NewSSP(hc)
-		if err != nil {
-			return err
-		}
-		required.Spec.DeepCopyInto(&existing.Spec)
-	}
+			toCtx, cancel := context.WithTimeout(ctx, updateDryRunTimeOut)
+			defer cancel()
 
-	if err = wh.cli.Update(ctx, exists, opts); err != nil {
-		wh.logger.Error(err, "failed to dry-run update the object", "kind", exists.GetObjectKind())
-		return err
+			if err := runner.DryRun(toCtx); err != nil {
+				wh.logger.Error(err, "failed to dry-run update the object", "kind", runner.Kind)
+				errs[i] = fmt.Errorf("failed to dry-run update the %s: %w", runner.Kind, err)
+				return
+			}
+
+			wh.logger.Info("dry-run update the object passed", "kind", runner.Kind)
+		}()
 	}
+	wg.Wait()
 
-	wh.logger.Info("dry-run update the object passed", "kind", exists.GetObjectKind())
-	return nil
+	return errors.Join(errs...)
 }
 
 func (wh *WebhookHandler) ValidateDelete(ctx context.Context, dryrun bool, hc *v1beta1.HyperConverged) error {
@@ -312,6 +310,44 @@ func (wh *WebhookHandler) ValidateDelete(ctx context.Context, dryrun bool, hc *v
 	return nil
 }
 
+// validateFinalizerRemoval denies the removal of the HyperConverged finalizer by anyone but the operator, while the
+// uninstall strategy blocks the uninstallation if workloads exist: the operator removes the finalizer after removing
+// the operands, so removing it manually orphans them.
+func (wh *WebhookHandler) validateFinalizerRemoval(req admission.Request, requested, exists *v1beta1.HyperConverged) error {
+	if !slices.Contains(exists.Finalizers, hcoutil.HyperConvergedFinalizerName) || slices.Contains(requested.Finalizers, hcoutil.HyperConvergedFinalizerName) {
+		return nil
+	}
+
+	if wh.isOperator(req) {
+		return nil
+	}
+
+	if !isUninstallBlocked(exists) && !isUninstallBlocked(requested) {
+		return nil
+	}
+
+	return fmt.Errorf("the %s finalizer can only be removed by the operator, while spec.uninstallStrategy is %s; set spec.uninstallStrategy to %s to remove it manually",
+		hcoutil.HyperConvergedFinalizerName, v1beta1.HyperConvergedUninstallStrategyBlockUninstallIfWorkloadsExist, v1beta1.HyperConvergedUninstallStrategyRemoveWorkloads)
+}
+
+// validateStatusUpdate denies the updates of the HyperConverged status by anyone but the operator. The status reports
+// the state of the operands, and some of its fields, like status.dataImportSchedule, are read back by the operator.
+func (wh *WebhookHandler) validateStatusUpdate(req admission.Request) error {
+	if wh.isOperator(req) {
+		return nil
+	}
+
+	return errors.New("the status of the HyperConverged CR can only be updated by the operator")
+}
+
+func (wh *WebhookHandler) isOperator(req admission.Request) bool {
+	return req.UserInfo.Username == fmt.Sprintf("system:serviceaccount:%s:%s", wh.namespace, hcoutil.HcoServiceAccountName)
+}
+
+func isUninstallBlocked(hc *v1beta1.HyperConverged) bool {
+	return hc.Spec.UninstallStrategy == "" || hc.Spec.UninstallStrategy == v1beta1.HyperConvergedUninstallStrategyBlockUninstallIfWorkloadsExist
+}
+
 func (wh *WebhookHandler) validateCertConfig(hc *v1beta1.HyperConverged) error {
 	minimalDuration := metav1.Duration{Duration: 10 * time.Minute}
 
@@ -396,6 +432,44 @@ func (wh *WebhookHandler) validateMediatedDeviceTypes(hc *v1beta1.HyperConverged
 	return nil
 }
 
+func (wh *WebhookHandler) validateAlertSilences(hc *v1beta1.HyperConverged) error {
+	if hc.Spec.AlertSilences == nil {
+		return nil
+	}
+
+	for _, silence := range hc.Spec.AlertSilences.Silences {
+		if silence.Duration != nil && silence.Duration.Duration <= 0 {
+			return fmt.Errorf("spec.alertSilences.silences[%s].duration: the duration must be positive", silence.Name)
+		}
+
+		for _, matcher := range silence.Matchers {
+			if !matcher.IsRegex {
+				continue
+			}
+
+			if _, err := regexp.Compile(matcher.Value); err != nil {
+				return fmt.Errorf("spec.alertSilences.silences[%s]: invalid regular expression for the %s matcher: %w", silence.Name, matcher.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateHostPathProvisioner rejects the enableHostPathProvisioner feature gate without a storage pool: the HPP
+// operator can't reconcile a HostPathProvisioner CR without storage pools.
+func (wh *WebhookHandler) validateHostPathProvisioner(hc *v1beta1.HyperConverged) error {
+	if !ptr.Deref(hc.Spec.FeatureGates.EnableHostPathProvisioner, false) {
+		return nil
+	}
+
+	if hc.Spec.HostPathProvisioner == nil || len(hc.Spec.HostPathProvisioner.StoragePools) == 0 {
+		return fmt.Errorf("spec.hostPathProvisioner.storagePools: at least one storage pool is required when the enableHostPathProvisioner feature gate is set")
+	}
+
+	return nil
+}
+
 func hasRequiredHTTP2Ciphers(ciphers []string) bool {
 	var requiredHTTP2Ciphers = []string{
 		"ECDHE-RSA-AES128-GCM-SHA256",
@@ -419,8 +493,10 @@ func validationResponseFromStatus(allowed bool, status metav1.Status) admission.
 
 func SelectCipherSuitesAndMinTLSVersion() ([]string, openshiftconfigv1.TLSProtocolVersion) {
 	ci := hcoutil.GetClusterInfo()
-	profile := ci.GetTLSSecurityProfile(hcoTLSConfigCache)
+	return getTLSProfileSpec(ci.GetTLSSecurityProfile(hcoTLSConfigCache))
+}
 
+func getTLSProfileSpec(profile *openshiftconfigv1.TLSSecurityProfile) ([]string, openshiftconfigv1.TLSProtocolVersion) {
 	if profile.Custom != nil {
 		return profile.Custom.TLSProfileSpec.Ciphers, profile.Custom.TLSProfileSpec.MinTLSVersion
 	}
@@ -13,6 +13,7 @@ import (
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -20,6 +21,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -192,6 +194,11 @@ var _ = Describe("webhooks validator", func() {
 			Expect(wh.ValidateCreate(ctx, dryRun, cr)).ToNot(Succeed())
 		})
 
+		It("should reject creation of a resource with an arbitrary name", func() {
+			cr.ObjectMeta.Name = "my-hyperconverged"
+			Expect(wh.ValidateCreate(ctx, dryRun, cr)).To(MatchError("invalid name for v1beta1.HyperConverged - please use the kubevirt-hyperconverged name"))
+		})
+
 		DescribeTable("Validate annotations", func(annotations map[string]string, assertion types.GomegaMatcher) {
 			cr.Annotations = annotations
 			Expect(wh.ValidateCreate(ctx, dryRun, cr)).To(assertion)
@@ -236,15 +243,15 @@ var _ = Describe("webhooks validator", func() {
 					PciHostDevices: []v1beta1.PciHostDevice{
 						{
 							PCIDeviceSelector: "111",
-							ResourceName:      "name",
+							ResourceName:      "name1",
 						},
 						{
 							PCIDeviceSelector: "222",
-							ResourceName:      "name",
+							ResourceName:      "name2",
 						},
 						{
 							PCIDeviceSelector: "333",
-							ResourceName:      "name",
+							ResourceName:      "name3",
 						},
 					},
 				}
@@ -256,15 +263,15 @@ var _ = Describe("webhooks validator", func() {
 					MediatedDevices: []v1beta1.MediatedHostDevice{
 						{
 							MDEVNameSelector: "111",
-							ResourceName:     "name",
+							ResourceName:     "name1",
 						},
 						{
 							MDEVNameSelector: "222",
-							ResourceName:     "name",
+							ResourceName:     "name2",
 						},
 						{
 							MDEVNameSelector: "333",
-							ResourceName:     "name",
+							ResourceName:     "name3",
 						},
 					},
 				}
@@ -486,6 +493,7 @@ var _ = Describe("webhooks validator", func() {
 			}
 			dryRun = false
 			ctx = context.TODO()
+			mockOpenshiftClusterInfo()
 		})
 
 		It("should correctly handle a valid update request", func() {
@@ -683,6 +691,83 @@ var _ = Describe("webhooks validator", func() {
 			Expect(err).To(MatchError(context.DeadlineExceeded))
 		})
 
+		It("should return the errors of all the failed dry-run updates", func() {
+			cli := getFakeClient(hco)
+			kvError := getKindWriteError("KubeVirt", ErrFakeKvError)
+			cdiError := getKindWriteError("CDI", ErrFakeCdiError)
+			cli.InitiateUpdateErrors(func(obj client.Object) error {
+				return errors.Join(kvError(obj), cdiError(obj))
+			})
+
+			wh := NewWebhookHandler(logger, cli, decoder, HcoValidNamespace, true, nil)
+
+			newHco := &v1beta1.HyperConverged{}
+			hco.DeepCopyInto(newHco)
+			// change something in workloads to trigger dry-run update
+			newHco.Spec.Workloads.NodePlacement.NodeSelector["a change"] = "Something else"
+
+			err := wh.ValidateUpdate(ctx, dryRun, newHco, hco)
+			Expect(err).To(MatchError(ErrFakeKvError))
+			Expect(err).To(MatchError(ErrFakeCdiError))
+			Expect(err).To(MatchError(ContainSubstring("failed to dry-run update the KubeVirt")))
+			Expect(err).To(MatchError(ContainSubstring("failed to dry-run update the CDI")))
+		})
+
+		It("should dry-run create the objects of a newly deployed operand", func() {
+			cli := getFakeClient(hco)
+			errFakeAAQError := errors.New("fake AAQ error")
+			cli.InitiateCreateErrors(getKindWriteError("AAQ", errFakeAAQError))
+
+			wh := NewWebhookHandler(logger, cli, decoder, HcoValidNamespace, true, nil)
+
+			newHco := &v1beta1.HyperConverged{}
+			hco.DeepCopyInto(newHco)
+			newHco.Spec.FeatureGates.EnableApplicationAwareQuota = ptr.To(true)
+
+			Expect(wh.ValidateUpdate(ctx, dryRun, newHco, hco)).To(MatchError(errFakeAAQError))
+		})
+
+		It("should warn about the objects that can't be rendered", func() {
+			// the test process has no image stream manifest directory
+			Expect(os.Setenv("DASHBOARD_FILES_LOCATION", "/not/existing/dir")).To(Succeed())
+			DeferCleanup(os.Unsetenv, "DASHBOARD_FILES_LOCATION")
+
+			cli := getFakeClient(hco)
+
+			wh := NewWebhookHandler(logger, cli, decoder, HcoValidNamespace, true, nil)
+
+			newHco := &v1beta1.HyperConverged{}
+			hco.DeepCopyInto(newHco)
+			newHco.Spec.Workloads.NodePlacement.NodeSelector["a change"] = "Something else"
+
+			warnings, err := wh.validateUpdate(ctx, dryRun, newHco, hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(HaveLen(1))
+			Expect(warnings[0]).To(ContainSubstring("the following objects were not validated"))
+			Expect(warnings[0]).To(ContainSubstring("dashboard ConfigMap"))
+			Expect(warnings[0]).To(ContainSubstring("ImageStream"))
+		})
+
+		It("should not modify the objects in the cluster", func() {
+			cli := getFakeClient(hco)
+
+			wh := NewWebhookHandler(logger, cli, decoder, HcoValidNamespace, true, nil)
+
+			newHco := &v1beta1.HyperConverged{}
+			hco.DeepCopyInto(newHco)
+			newHco.Spec.Workloads.NodePlacement.NodeSelector["a change"] = "Something else"
+			newHco.Spec.FeatureGates.EnableApplicationAwareQuota = ptr.To(true)
+
+			Expect(wh.ValidateUpdate(ctx, dryRun, newHco, hco)).To(Succeed())
+
+			kv := operands.NewKubeVirtWithNameOnly(hco)
+			Expect(cli.Get(ctx, client.ObjectKeyFromObject(kv), kv)).To(Succeed())
+			Expect(kv.Spec.Workloads.NodePlacement.NodeSelector).ToNot(HaveKey("a change"))
+
+			aaq := operands.NewAAQWithNameOnly(hco)
+			Expect(cli.Get(ctx, client.ObjectKeyFromObject(aaq), aaq)).To(MatchError(apierrors.IsNotFound, "not found error"))
+		})
+
 		It("should not return error if nothing was changed", func() {
 			cli := getFakeClient(hco)
 			cli.InitiateUpdateErrors(initiateTimeout)
@@ -706,15 +791,15 @@ var _ = Describe("webhooks validator", func() {
 					PciHostDevices: []v1beta1.PciHostDevice{
 						{
 							PCIDeviceSelector: "111",
-							ResourceName:      "name",
+							ResourceName:      "name1",
 						},
 						{
 							PCIDeviceSelector: "222",
-							ResourceName:      "name",
+							ResourceName:      "name2",
 						},
 						{
 							PCIDeviceSelector: "333",
-							ResourceName:      "name",
+							ResourceName:      "name3",
 						},
 					},
 				}
@@ -731,15 +816,15 @@ var _ = Describe("webhooks validator", func() {
 					MediatedDevices: []v1beta1.MediatedHostDevice{
 						{
 							MDEVNameSelector: "111",
-							ResourceName:     "name",
+							ResourceName:     "name1",
 						},
 						{
 							MDEVNameSelector: "222",
-							ResourceName:     "name",
+							ResourceName:     "name2",
 						},
 						{
 							MDEVNameSelector: "333",
-							ResourceName:     "name",
+							ResourceName:     "name3",
 						},
 					},
 				}
@@ -1239,11 +1324,109 @@ var _ = Describe("webhooks validator", func() {
 		})
 	})
 
+	Context("finalizer removal", func() {
+		var hco *v1beta1.HyperConverged
+
+		BeforeEach(func() {
+			Expect(os.Setenv("OPERATOR_NAMESPACE", HcoValidNamespace)).To(Succeed())
+			hco = commontestutils.NewHco()
+			hco.Finalizers = []string{util.HyperConvergedFinalizerName}
+			mockOpenshiftClusterInfo()
+		})
+
+		newFinalizerRemovalRequest := func(username string, requested *v1beta1.HyperConverged) admission.Request {
+			req := newRequest(admissionv1.Update, hco, v1beta1Codec, false)
+			req.Object = runtime.RawExtension{
+				Raw:    []byte(runtime.EncodeOrDie(v1beta1Codec, requested)),
+				Object: requested,
+			}
+			req.UserInfo.Username = username
+			return req
+		}
+
+		DescribeTable("should only allow the operator to remove the finalizer, while the uninstallation is blocked", func(strategy v1beta1.HyperConvergedUninstallStrategy, username string, allowed bool) {
+			hco.Spec.UninstallStrategy = strategy
+			wh := NewWebhookHandler(logger, getFakeClient(hco), decoder, HcoValidNamespace, true, nil)
+
+			requested := hco.DeepCopy()
+			requested.Finalizers = nil
+
+			res := wh.Handle(context.TODO(), newFinalizerRemovalRequest(username, requested))
+			Expect(res.Allowed).To(Equal(allowed))
+			if !allowed {
+				Expect(res.Result.Message).To(Equal("the kubevirt.io/hyperconverged finalizer can only be removed by the operator, while spec.uninstallStrategy is BlockUninstallIfWorkloadsExist; set spec.uninstallStrategy to RemoveWorkloads to remove it manually"))
+			}
+		},
+			Entry("block strategy, user", v1beta1.HyperConvergedUninstallStrategyBlockUninstallIfWorkloadsExist, "kube:admin", false),
+			Entry("default strategy, user", v1beta1.HyperConvergedUninstallStrategy(""), "kube:admin", false),
+			Entry("block strategy, operator", v1beta1.HyperConvergedUninstallStrategyBlockUninstallIfWorkloadsExist, "system:serviceaccount:kubevirt-hyperconverged:hyperconverged-cluster-operator", true),
+			Entry("block strategy, service account of another namespace", v1beta1.HyperConvergedUninstallStrategyBlockUninstallIfWorkloadsExist, "system:serviceaccount:other-ns:hyperconverged-cluster-operator", false),
+			Entry("remove workloads strategy, user", v1beta1.HyperConvergedUninstallStrategyRemoveWorkloads, "kube:admin", true),
+		)
+
+		It("should not allow to change the strategy and to remove the finalizer in the same request", func() {
+			wh := NewWebhookHandler(logger, getFakeClient(hco), decoder, HcoValidNamespace, true, nil)
+
+			requested := hco.DeepCopy()
+			requested.Finalizers = nil
+			requested.Spec.UninstallStrategy = v1beta1.HyperConvergedUninstallStrategyRemoveWorkloads
+
+			res := wh.Handle(context.TODO(), newFinalizerRemovalRequest("kube:admin", requested))
+			Expect(res.Allowed).To(BeFalse())
+		})
+
+		It("should allow other changes of the finalizers", func() {
+			wh := NewWebhookHandler(logger, getFakeClient(hco), decoder, HcoValidNamespace, true, nil)
+
+			requested := hco.DeepCopy()
+			requested.Finalizers = append(requested.Finalizers, "example.com/finalizer")
+
+			res := wh.Handle(context.TODO(), newFinalizerRemovalRequest("kube:admin", requested))
+			Expect(res.Allowed).To(BeTrue())
+		})
+	})
+
+	Context("status update", func() {
+		var hco *v1beta1.HyperConverged
+
+		BeforeEach(func() {
+			Expect(os.Setenv("OPERATOR_NAMESPACE", HcoValidNamespace)).To(Succeed())
+			hco = commontestutils.NewHco()
+			mockOpenshiftClusterInfo()
+		})
+
+		DescribeTable("should only allow the operator to update the status", func(username string, allowed bool) {
+			wh := NewWebhookHandler(logger, getFakeClient(hco), decoder, HcoValidNamespace, true, nil)
+
+			requested := hco.DeepCopy()
+			requested.Status.DataImportSchedule = "1 2 * * *"
+
+			req := newRequest(admissionv1.Update, hco, v1beta1Codec, false)
+			req.Object = runtime.RawExtension{
+				Raw:    []byte(runtime.EncodeOrDie(v1beta1Codec, requested)),
+				Object: requested,
+			}
+			req.SubResource = "status"
+			req.UserInfo.Username = username
+
+			res := wh.Handle(context.TODO(), req)
+			Expect(res.Allowed).To(Equal(allowed))
+			if !allowed {
+				Expect(res.Result.Message).To(Equal("the status of the HyperConverged CR can only be updated by the operator"))
+			}
+		},
+			Entry("user", "kube:admin", false),
+			Entry("operator", "system:serviceaccount:kubevirt-hyperconverged:hyperconverged-cluster-operator", true),
+			Entry("service account of another namespace", "system:serviceaccount:other-ns:hyperconverged-cluster-operator", false),
+		)
+	})
+
 	Context("unsupported annotation", func() {
 		var hco *v1beta1.HyperConverged
 		BeforeEach(func() {
 			Expect(os.Setenv("OPERATOR_NAMESPACE", HcoValidNamespace)).To(Succeed())
 			hco = commontestutils.NewHco()
+			mockOpenshiftClusterInfo()
 		})
 
 		DescribeTable("should accept if annotation is valid",
@@ -1284,6 +1467,99 @@ var _ = Describe("webhooks validator", func() {
 			Entry("should reject if cna annotation is invalid", common.JSONPatchCNAOAnnotationName, invalidCnaAnnotation),
 			Entry("should accept if ssp annotation is invalid", common.JSONPatchSSPAnnotationName, invalidSspAnnotation),
 		)
+		It("should reject with the index and the path of the failing operation", func() {
+			cli := getFakeClient(hco)
+			wh := NewWebhookHandler(logger, cli, decoder, HcoValidNamespace, true, nil)
+
+			newHco := hco.DeepCopy()
+			newHco.Annotations = map[string]string{
+				common.JSONPatchKVAnnotationName: `[
+					{"op": "add", "path": "/spec/configuration/developerConfiguration/featureGates/-", "value": "fg1"},
+					{"op": "add", "path": "/metadata/labels/fg", "value": "fg1"}
+				]`,
+			}
+
+			Expect(wh.ValidateUpdate(context.TODO(), false, newHco, hco)).To(MatchError(
+				"failed to render KubeVirt: invalid jsonPatch in the kubevirt.kubevirt.io/jsonpatch annotation: operation 1 (add /metadata/labels/fg): can only modify spec fields",
+			))
+
+			newHco.Namespace = HcoValidNamespace
+			Expect(wh.ValidateCreate(context.TODO(), false, newHco)).To(MatchError(
+				"invalid jsonPatch in the kubevirt.kubevirt.io/jsonpatch annotation: operation 1 (add /metadata/labels/fg): can only modify spec fields",
+			))
+		})
+	})
+
+	Context("operand overrides", func() {
+		var hco *v1beta1.HyperConverged
+		BeforeEach(func() {
+			Expect(os.Setenv("OPERATOR_NAMESPACE", HcoValidNamespace)).To(Succeed())
+			hco = commontestutils.NewHco()
+			mockOpenshiftClusterInfo()
+		})
+
+		validOverride := &v1beta1.OperandOverride{
+			Patches: []v1beta1.OperandPatch{
+				{Op: "add", Path: "/spec/unknownField", Value: &apiextensionsv1.JSON{Raw: []byte(`"value"`)}},
+			},
+		}
+
+		failingOverride := &v1beta1.OperandOverride{
+			Patches: []v1beta1.OperandPatch{
+				{Op: "remove", Path: "/spec/notExistingField"},
+			},
+		}
+
+		DescribeTable("should accept valid override on create",
+			func(setOverride func(*v1beta1.OperandOverrides, *v1beta1.OperandOverride)) {
+				wh := NewWebhookHandler(logger, getFakeClient(hco), decoder, HcoValidNamespace, true, nil)
+
+				hco.Spec.OperandOverrides = &v1beta1.OperandOverrides{}
+				setOverride(hco.Spec.OperandOverrides, validOverride)
+
+				Expect(wh.ValidateCreate(context.TODO(), false, hco)).To(Succeed())
+			},
+			Entry("kubevirt", func(o *v1beta1.OperandOverrides, ov *v1beta1.OperandOverride) { o.KubeVirt = ov }),
+			Entry("cdi", func(o *v1beta1.OperandOverrides, ov *v1beta1.OperandOverride) { o.CDI = ov }),
+			Entry("cnao", func(o *v1beta1.OperandOverrides, ov *v1beta1.OperandOverride) { o.NetworkAddonsConfig = ov }),
+			Entry("ssp", func(o *v1beta1.OperandOverrides, ov *v1beta1.OperandOverride) { o.SSP = ov }),
+			Entry("aaq", func(o *v1beta1.OperandOverrides, ov *v1beta1.OperandOverride) { o.AAQ = ov }),
+		)
+
+		DescribeTable("should reject failing override on create",
+			func(operand string, setOverride func(*v1beta1.OperandOverrides, *v1beta1.OperandOverride)) {
+				wh := NewWebhookHandler(logger, getFakeClient(hco), decoder, HcoValidNamespace, true, nil)
+
+				hco.Spec.OperandOverrides = &v1beta1.OperandOverrides{}
+				setOverride(hco.Spec.OperandOverrides, failingOverride)
+
+				Expect(wh.ValidateCreate(context.TODO(), false, hco)).To(MatchError(ContainSubstring("failed to apply spec.operandOverrides.%s", operand)))
+			},
+			Entry("kubevirt", v1beta1.OperandKubeVirt, func(o *v1beta1.OperandOverrides, ov *v1beta1.OperandOverride) { o.KubeVirt = ov }),
+			Entry("cdi", v1beta1.OperandCDI, func(o *v1beta1.OperandOverrides, ov *v1beta1.OperandOverride) { o.CDI = ov }),
+			Entry("cnao", v1beta1.OperandNetworkAddonsConfig, func(o *v1beta1.OperandOverrides, ov *v1beta1.OperandOverride) { o.NetworkAddonsConfig = ov }),
+			Entry("ssp", v1beta1.OperandSSP, func(o *v1beta1.OperandOverrides, ov *v1beta1.OperandOverride) { o.SSP = ov }),
+			Entry("aaq", v1beta1.OperandAAQ, func(o *v1beta1.OperandOverrides, ov *v1beta1.OperandOverride) { o.AAQ = ov }),
+		)
+
+		DescribeTable("should reject failing override on update",
+			func(operand string, setOverride func(*v1beta1.OperandOverrides, *v1beta1.OperandOverride)) {
+				wh := NewWebhookHandler(logger, getFakeClient(hco), decoder, HcoValidNamespace, true, nil)
+
+				newHco := hco.DeepCopy()
+				// only the deployed operands are rendered on update
+				newHco.Spec.FeatureGates.EnableApplicationAwareQuota = ptr.To(true)
+				newHco.Spec.OperandOverrides = &v1beta1.OperandOverrides{}
+				setOverride(newHco.Spec.OperandOverrides, failingOverride)
+
+				Expect(wh.ValidateUpdate(context.TODO(), false, newHco, hco)).To(MatchError(ContainSubstring("failed to apply spec.operandOverrides.%s", operand)))
+			},
+			Entry("kubevirt", v1beta1.OperandKubeVirt, func(o *v1beta1.OperandOverrides, ov *v1beta1.OperandOverride) { o.KubeVirt = ov }),
+			Entry("cdi", v1beta1.OperandCDI, func(o *v1beta1.OperandOverrides, ov *v1beta1.OperandOverride) { o.CDI = ov }),
+			Entry("cnao", v1beta1.OperandNetworkAddonsConfig, func(o *v1beta1.OperandOverrides, ov *v1beta1.OperandOverride) { o.NetworkAddonsConfig = ov }),
+			Entry("ssp", v1beta1.OperandSSP, func(o *v1beta1.OperandOverrides, ov *v1beta1.OperandOverride) { o.SSP = ov }),
+			Entry("aaq", v1beta1.OperandAAQ, func(o *v1beta1.OperandOverrides, ov *v1beta1.OperandOverride) { o.AAQ = ov }),
+		)
 	})
 
 	Context("hcoTLSConfigCache", func() {
@@ -1751,6 +2027,123 @@ var _ = Describe("webhooks validator", func() {
 
 	})
 
+	Context("AlertSilences", func() {
+		var cr *v1beta1.HyperConverged
+		var newCr *v1beta1.HyperConverged
+		var ctx context.Context
+
+		BeforeEach(func() {
+			Expect(os.Setenv("OPERATOR_NAMESPACE", HcoValidNamespace)).To(Succeed())
+			cr = commontestutils.NewHco()
+			newCr = cr.DeepCopy()
+			ctx = context.TODO()
+		})
+
+		DescribeTable("Check the alert silences", func(silence v1beta1.AlertSilence, expected types.GomegaMatcher) {
+			newCr.Spec.AlertSilences = &v1beta1.AlertSilencesConfig{
+				Silences: []v1beta1.AlertSilence{silence},
+			}
+
+			// create
+			Expect(wh.ValidateCreate(ctx, false, newCr)).To(expected)
+
+			// update
+			cli := getFakeClient(cr)
+			cli.InitiateUpdateErrors(getUpdateError(noFailure))
+			whU := NewWebhookHandler(logger, cli, decoder, HcoValidNamespace, true, nil)
+			Expect(whU.ValidateUpdate(ctx, false, newCr, cr)).To(expected)
+		},
+			Entry("should allow a silence with a valid regular expression and a duration",
+				v1beta1.AlertSilence{
+					Name: "test-silence",
+					Matchers: []v1beta1.AlertSilenceMatcher{
+						{Name: "alertname", Value: "KubeVirt.*", IsRegex: true},
+					},
+					Duration: &metav1.Duration{Duration: time.Hour},
+				},
+				Succeed(),
+			),
+			Entry("should allow a silence without a duration",
+				v1beta1.AlertSilence{
+					Name: "test-silence",
+					Matchers: []v1beta1.AlertSilenceMatcher{
+						{Name: "alertname", Value: "("},
+					},
+				},
+				Succeed(),
+			),
+			Entry("should reject an invalid regular expression",
+				v1beta1.AlertSilence{
+					Name: "test-silence",
+					Matchers: []v1beta1.AlertSilenceMatcher{
+						{Name: "alertname", Value: "(", IsRegex: true},
+					},
+				},
+				MatchError(ContainSubstring("invalid regular expression for the alertname matcher")),
+			),
+			Entry("should reject a non-positive duration",
+				v1beta1.AlertSilence{
+					Name: "test-silence",
+					Matchers: []v1beta1.AlertSilenceMatcher{
+						{Name: "alertname", Value: "KubeVirtVMStuck"},
+					},
+					Duration: &metav1.Duration{},
+				},
+				MatchError(ContainSubstring("the duration must be positive")),
+			),
+		)
+	})
+
+	Context("HostPathProvisioner", func() {
+		var cr *v1beta1.HyperConverged
+		var newCr *v1beta1.HyperConverged
+		var ctx context.Context
+
+		BeforeEach(func() {
+			Expect(os.Setenv("OPERATOR_NAMESPACE", HcoValidNamespace)).To(Succeed())
+			cr = commontestutils.NewHco()
+			newCr = cr.DeepCopy()
+			ctx = context.TODO()
+		})
+
+		DescribeTable("Check the storage pools", func(enabled *bool, config *v1beta1.HostPathProvisionerConfig, expected types.GomegaMatcher) {
+			newCr.Spec.FeatureGates.EnableHostPathProvisioner = enabled
+			newCr.Spec.HostPathProvisioner = config
+
+			// create
+			Expect(wh.ValidateCreate(ctx, false, newCr)).To(expected)
+
+			// update
+			cli := getFakeClient(cr)
+			cli.InitiateUpdateErrors(getUpdateError(noFailure))
+			whU := NewWebhookHandler(logger, cli, decoder, HcoValidNamespace, true, nil)
+			Expect(whU.ValidateUpdate(ctx, false, newCr, cr)).To(expected)
+		},
+			Entry("should allow enabling HPP with a storage pool",
+				ptr.To(true),
+				&v1beta1.HostPathProvisionerConfig{
+					StoragePools: []v1beta1.HostPathStoragePool{{Name: "local", Path: "/var/hpvolumes"}},
+				},
+				Succeed(),
+			),
+			Entry("should reject enabling HPP without the hostPathProvisioner field",
+				ptr.To(true),
+				nil,
+				MatchError(ContainSubstring("at least one storage pool is required")),
+			),
+			Entry("should reject enabling HPP without storage pools",
+				ptr.To(true),
+				&v1beta1.HostPathProvisionerConfig{NodePlacement: newHyperConvergedConfig()},
+				MatchError(ContainSubstring("at least one storage pool is required")),
+			),
+			Entry("should allow the hostPathProvisioner field without storage pools, if HPP is not enabled",
+				ptr.To(false),
+				&v1beta1.HostPathProvisionerConfig{},
+				Succeed(),
+			),
+		)
+	})
+
 })
 
 func newHyperConvergedConfig() *sdkapi.NodePlacement {
@@ -1784,6 +2177,17 @@ func newHyperConvergedConfig() *sdkapi.NodePlacement {
 	}
 }
 
+// mockOpenshiftClusterInfo makes the operands render the objects of an OpenShift cluster, for the dry-run of the updates
+func mockOpenshiftClusterInfo() {
+	getClusterInfo := util.GetClusterInfo
+	util.GetClusterInfo = func() util.ClusterInfo {
+		return &commontestutils.ClusterInfoMock{}
+	}
+	DeferCleanup(func() {
+		util.GetClusterInfo = getClusterInfo
+	})
+}
+
 func getFakeClient(hco *v1beta1.HyperConverged) *commontestutils.HcoTestClient {
 	kv, err := operands.NewKubeVirt(hco)
 	Expect(err).ToNot(HaveOccurred())
@@ -1820,41 +2224,32 @@ var (
 func getUpdateError(failure fakeFailure) commontestutils.FakeWriteErrorGenerator {
 	switch failure {
 	case kvUpdateFailure:
-		return func(obj client.Object) error {
-			if _, ok := obj.(*kubevirtcorev1.KubeVirt); ok {
-				return ErrFakeKvError
-			}
-			return nil
-		}
+		return getKindWriteError("KubeVirt", ErrFakeKvError)
 
 	case cdiUpdateFailure:
-		return func(obj client.Object) error {
-			if _, ok := obj.(*cdiv1beta1.CDI); ok {
-				return ErrFakeCdiError
-			}
-			return nil
-		}
+		return getKindWriteError("CDI", ErrFakeCdiError)
 
 	case networkUpdateFailure:
-		return func(obj client.Object) error {
-			if _, ok := obj.(*networkaddonsv1.NetworkAddonsConfig); ok {
-				return ErrFakeNetworkError
-			}
-			return nil
-		}
+		return getKindWriteError("NetworkAddonsConfig", ErrFakeNetworkError)
 
 	case sspUpdateFailure:
-		return func(obj client.Object) error {
-			if _, ok := obj.(*sspv1beta2.SSP); ok {
-				return ErrFakeSspError
-			}
-			return nil
-		}
+		return getKindWriteError("SSP", ErrFakeSspError)
 	default:
 		return nil
 	}
 }
 
+// the operand CRs are applied as unstructured objects, so they are identified by their kind
+func getKindWriteError(kind string, err error) commontestutils.FakeWriteErrorGenerator {
+	return func(obj client.Object) error {
+		gvk, gvkErr := apiutil.GVKForObject(obj, commontestutils.GetScheme())
+		if gvkErr == nil && gvk.Kind == kind {
+			return err
+		}
+		return nil
+	}
+}
+
 func initiateTimeout(_ client.Object) error {
 	time.Sleep(updateDryRunTimeOut + time.Millisecond*100)
 	return nil
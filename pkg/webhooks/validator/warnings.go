@@ -0,0 +1,173 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimetav1 "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/components"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var kubeletConfigListGVK = schema.GroupVersionKind{Group: "machineconfiguration.openshift.io", Version: "v1", Kind: "KubeletConfigList"}
+
+// getWarnings returns the admission warnings for a valid HyperConverged CR: deprecated and ignored fields, and
+// settings that are allowed but risky. Unlike the validation errors, the warnings are returned on each request, so
+// the cluster admin is reminded of them on every apply. exists is nil on create.
+func (wh *WebhookHandler) getWarnings(ctx context.Context, requested, exists *v1beta1.HyperConverged) admission.Warnings {
+	var warnings admission.Warnings
+
+	warnings = append(warnings, getDeprecationWarnings(requested)...)
+	warnings = append(warnings, getJSONPatchAnnotationWarnings(requested)...)
+	warnings = append(warnings, wh.getRiskySettingsWarnings(ctx, requested)...)
+	warnings = append(warnings, wh.getNodePlacementWarnings(ctx, requested, exists)...)
+	warnings = append(warnings, getHostPathProvisionerWarnings(requested)...)
+
+	return warnings
+}
+
+// getHostPathProvisionerWarnings warns that a custom certificate rotation policy is not applied to HPP. The
+// HostPathProvisioner API has no certificate configuration; the HPP operator rotates the certificates of its webhook
+// with its own, fixed, policy.
+func getHostPathProvisionerWarnings(hc *v1beta1.HyperConverged) admission.Warnings {
+	if !ptr.Deref(hc.Spec.FeatureGates.EnableHostPathProvisioner, false) {
+		return nil
+	}
+
+	if equality.Semantic.DeepEqual(hc.Spec.CertConfig, components.GetOperatorCR().Spec.CertConfig) {
+		return nil
+	}
+
+	return admission.Warnings{
+		fmt.Sprintf("%s: the certificate rotation policy is not applied to the HostPathProvisioner; HPP rotates its certificates with its own policy",
+			field.NewPath("spec", "certConfig").String()),
+	}
+}
+
+// getDeprecationWarnings warns about the deprecated settings. The deprecated fields of the common.FieldMigrations
+// registry are not included: the mutating webhook returns a single warning for each one of them.
+func getDeprecationWarnings(hc *v1beta1.HyperConverged) admission.Warnings {
+	var warnings admission.Warnings
+
+	specPath := field.NewPath("spec")
+	fgPath := specPath.Child("featureGates")
+
+	for _, ignored := range []struct {
+		fldPath *field.Path
+		isSet   bool
+	}{
+		{fldPath: specPath.Child("localStorageClassName"), isSet: hc.Spec.LocalStorageClassName != ""},                                //nolint SA1019
+		{fldPath: specPath.Child("vddkInitImage"), isSet: hc.Spec.VddkInitImage != nil},                                               //nolint SA1019
+		{fldPath: specPath.Child("tektonPipelinesNamespace"), isSet: hc.Spec.TektonPipelinesNamespace != nil},                         //nolint SA1019
+		{fldPath: specPath.Child("tektonTasksNamespace"), isSet: hc.Spec.TektonTasksNamespace != nil},                                 //nolint SA1019
+		{fldPath: fgPath.Child("deployTektonTaskResources"), isSet: ptr.Deref(hc.Spec.FeatureGates.DeployTektonTaskResources, false)}, //nolint SA1019
+		{fldPath: fgPath.Child("enableManagedTenantQuota"), isSet: ptr.Deref(hc.Spec.FeatureGates.EnableManagedTenantQuota, false)},   //nolint SA1019
+	} {
+		if ignored.isSet {
+			warnings = append(warnings, fmt.Sprintf("%s: the field is deprecated and ignored", ignored.fldPath.String()))
+		}
+	}
+
+	if !ptr.Deref(hc.Spec.FeatureGates.NonRoot, true) { //nolint SA1019
+		warnings = append(warnings, fmt.Sprintf("%s: the field is deprecated, and will be removed in a future version", fgPath.Child("nonRoot").String()))
+	}
+
+	if os.Getenv(operands.MachineTypeEnvName) != "" {
+		warnings = append(warnings, fmt.Sprintf("the %s environment variable is deprecated, and it overrides AMD64_MACHINETYPE; use AMD64_MACHINETYPE instead", operands.MachineTypeEnvName))
+	}
+
+	return warnings
+}
+
+func getJSONPatchAnnotationWarnings(hc *v1beta1.HyperConverged) admission.Warnings {
+	var warnings admission.Warnings
+
+	for _, annotation := range operands.JSONPatchAnnotationNames {
+		if _, found := hc.Annotations[annotation]; !found {
+			continue
+		}
+
+		warnings = append(warnings, fmt.Sprintf("the %s annotation is not supported, and may break upgrades; use spec.operandOverrides instead", annotation))
+
+		// an invalid annotation is rejected by the validation
+		noOps, _ := operands.GetJSONPatchAnnotationNoOps(hc, annotation)
+		for _, op := range noOps {
+			warnings = append(warnings, fmt.Sprintf("the %s annotation: %s does not modify the operand CR", annotation, op))
+		}
+	}
+
+	return warnings
+}
+
+func (wh *WebhookHandler) getRiskySettingsWarnings(ctx context.Context, hc *v1beta1.HyperConverged) admission.Warnings {
+	var warnings admission.Warnings
+
+	specPath := field.NewPath("spec")
+	ci := hcoutil.GetClusterInfo()
+
+	lmc := hc.Spec.LiveMigrationConfig
+	if ptr.Deref(lmc.AllowPostCopy, false) && ptr.Deref(lmc.AllowAutoConverge, false) {
+		warnings = append(warnings, fmt.Sprintf("%s: allowPostCopy and allowAutoConverge are both enabled; the migrating VMs are throttled before switching to post-copy, and a VM can crash if the network fails during the post-copy phase",
+			specPath.Child("liveMigrationConfig").String()))
+	}
+
+	if hwd := hc.Spec.HigherWorkloadDensity; hwd != nil && hwd.MemoryOvercommitPercentage > 100 && !wh.isSwapConfigured(ctx) {
+		warnings = append(warnings, fmt.Sprintf("%s: memory is overcommitted, but swap is not configured on the nodes; the VMs may be killed when the node runs out of memory",
+			specPath.Child("higherWorkloadDensity", "memoryOvercommitPercentage").String()))
+	}
+
+	if ptr.Deref(hc.Spec.EvictionStrategy, "") == kubevirtcorev1.EvictionStrategyNone && ci.IsInfrastructureHighlyAvailable() {
+		warnings = append(warnings, fmt.Sprintf("%s: the VMs won't be migrated when their node is drained, but shut down, although the cluster infrastructure is highly available",
+			specPath.Child("evictionStrategy").String()))
+	}
+
+	if hc.Spec.TLSSecurityProfile != nil && ci.IsOpenshift() && !isSameTLSProfile(hc.Spec.TLSSecurityProfile, ci.GetTLSSecurityProfile(nil)) {
+		warnings = append(warnings, fmt.Sprintf("%s: the TLS security profile differs from the one of the cluster APIServer CR",
+			specPath.Child("tlsSecurityProfile").String()))
+	}
+
+	return warnings
+}
+
+// isSwapConfigured checks if swap is enabled in any of the KubeletConfigs of the cluster. On clusters without
+// KubeletConfigs, the swap configuration can't be detected, and it is considered as not configured.
+func (wh *WebhookHandler) isSwapConfigured(ctx context.Context) bool {
+	if !hcoutil.GetClusterInfo().IsOpenshift() {
+		return false
+	}
+
+	kubeletConfigs := &unstructured.UnstructuredList{}
+	kubeletConfigs.SetGroupVersionKind(kubeletConfigListGVK)
+	if err := wh.cli.List(ctx, kubeletConfigs); err != nil {
+		if !apimetav1.IsNoMatchError(err) {
+			wh.logger.Error(err, "failed to list the KubeletConfigs")
+		}
+		return false
+	}
+
+	return slices.ContainsFunc(kubeletConfigs.Items, func(kubeletConfig unstructured.Unstructured) bool {
+		swapBehavior, _, _ := unstructured.NestedString(kubeletConfig.Object, "spec", "kubeletConfig", "memorySwap", "swapBehavior")
+		return swapBehavior != "" && swapBehavior != "NoSwap"
+	})
+}
+
+func isSameTLSProfile(a, b *openshiftconfigv1.TLSSecurityProfile) bool {
+	aCiphers, aMinTLSVersion := getTLSProfileSpec(a)
+	bCiphers, bMinTLSVersion := getTLSProfileSpec(b)
+
+	return aMinTLSVersion == bMinTLSVersion && slices.Equal(aCiphers, bCiphers)
+}
@@ -0,0 +1,275 @@
+package validator
+
+import (
+	"context"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/components"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("admission warnings", func() {
+	var (
+		s              *runtime.Scheme
+		decoder        admission.Decoder
+		ctx            context.Context
+		cr             *v1beta1.HyperConverged
+		getClusterInfo func() hcoutil.ClusterInfo
+	)
+
+	BeforeEach(func() {
+		Expect(os.Setenv("OPERATOR_NAMESPACE", HcoValidNamespace)).To(Succeed())
+		s = scheme.Scheme
+		Expect(v1beta1.AddToScheme(s)).To(Succeed())
+		decoder = admission.NewDecoder(s)
+		ctx = context.TODO()
+		cr = commontestutils.NewHco()
+
+		getClusterInfo = hcoutil.GetClusterInfo
+		hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+			return &commontestutils.ClusterInfoMock{}
+		}
+	})
+
+	AfterEach(func() {
+		hcoutil.GetClusterInfo = getClusterInfo
+	})
+
+	newWebhookHandler := func(objs ...client.Object) *WebhookHandler {
+		cli := fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+		return NewWebhookHandler(logger, cli, decoder, HcoValidNamespace, true, nil)
+	}
+
+	It("should not warn about a default HyperConverged CR", func() {
+		wh := newWebhookHandler()
+		Expect(wh.getWarnings(ctx, cr, nil)).To(BeEmpty())
+	})
+
+	It("should return the warnings from Handle", func() {
+		wh := newWebhookHandler()
+		cr.Spec.VddkInitImage = ptr.To("vddk:latest") //nolint SA1019
+
+		v1beta1Codec := serializer.NewCodecFactory(s).LegacyCodec(v1beta1.SchemeGroupVersion)
+		res := wh.Handle(ctx, newRequest(admissionv1.Update, cr, v1beta1Codec, false))
+		Expect(res.Allowed).To(BeTrue())
+		Expect(res.Warnings).To(ConsistOf("spec.vddkInitImage: the field is deprecated and ignored"))
+	})
+
+	Context("deprecated fields", func() {
+		It("should warn about ignored fields", func() {
+			cr.Spec.LocalStorageClassName = "local"                       //nolint SA1019
+			cr.Spec.TektonPipelinesNamespace = ptr.To("pipelines")        //nolint SA1019
+			cr.Spec.TektonTasksNamespace = ptr.To("tasks")                //nolint SA1019
+			cr.Spec.FeatureGates.DeployTektonTaskResources = ptr.To(true) //nolint SA1019
+			cr.Spec.FeatureGates.EnableManagedTenantQuota = ptr.To(true)  //nolint SA1019
+			cr.Spec.FeatureGates.NonRoot = ptr.To(false)                  //nolint SA1019
+
+			Expect(getDeprecationWarnings(cr)).To(ConsistOf(
+				"spec.localStorageClassName: the field is deprecated and ignored",
+				"spec.tektonPipelinesNamespace: the field is deprecated and ignored",
+				"spec.tektonTasksNamespace: the field is deprecated and ignored",
+				"spec.featureGates.deployTektonTaskResources: the field is deprecated and ignored",
+				"spec.featureGates.enableManagedTenantQuota: the field is deprecated and ignored",
+				"spec.featureGates.nonRoot: the field is deprecated, and will be removed in a future version",
+			))
+		})
+
+		It("should not warn about mediatedDevicesTypes, that the mutating webhook warns about", func() {
+			cr.Spec.MediatedDevicesConfiguration = &v1beta1.MediatedDevicesConfiguration{
+				MediatedDevicesTypes: []string{"nvidia-222"}, //nolint SA1019
+				NodeMediatedDeviceTypes: []v1beta1.NodeMediatedDeviceTypesConfig{
+					{
+						NodeSelector:        map[string]string{"testLabel1": "true"},
+						MediatedDeviceTypes: []string{"nvidia-223"},
+					},
+					{
+						NodeSelector:         map[string]string{"testLabel2": "true"},
+						MediatedDevicesTypes: []string{"nvidia-229"}, //nolint SA1019
+					},
+				},
+			}
+
+			Expect(getDeprecationWarnings(cr)).To(BeEmpty())
+		})
+
+		It("should warn about the legacy MACHINETYPE env var", func() {
+			Expect(os.Setenv(operands.MachineTypeEnvName, "pc-q35-rhel8.6.0")).To(Succeed())
+			DeferCleanup(os.Unsetenv, operands.MachineTypeEnvName)
+
+			Expect(getDeprecationWarnings(cr)).To(ConsistOf(
+				"the MACHINETYPE environment variable is deprecated, and it overrides AMD64_MACHINETYPE; use AMD64_MACHINETYPE instead",
+			))
+		})
+
+		DescribeTable("should warn about the legacy MACHINETYPE env var of the webhook deployment", func(machineType string, expectWarning bool) {
+			deploy := components.GetDeploymentWebhook("kubevirt-hyperconverged", "image", "IfNotPresent", "1.13.0", machineType, nil)
+			for _, env := range deploy.Spec.Template.Spec.Containers[0].Env {
+				if env.ValueFrom == nil {
+					GinkgoT().Setenv(env.Name, env.Value)
+				}
+			}
+
+			warnings := getDeprecationWarnings(cr)
+			if expectWarning {
+				Expect(warnings).To(ContainElement(ContainSubstring("the MACHINETYPE environment variable is deprecated")))
+			} else {
+				Expect(warnings).To(BeEmpty())
+			}
+		},
+			Entry("set", "pc-q35-rhel8.6.0", true),
+			Entry("not set", "", false),
+		)
+	})
+
+	Context("HostPathProvisioner", func() {
+		BeforeEach(func() {
+			cr.Spec.FeatureGates.EnableHostPathProvisioner = ptr.To(true)
+		})
+
+		It("should not warn about the default certificate configuration", func() {
+			Expect(getHostPathProvisionerWarnings(cr)).To(BeEmpty())
+		})
+
+		It("should warn that a custom certificate configuration is not applied to HPP", func() {
+			cr.Spec.CertConfig.Server.Duration = &metav1.Duration{Duration: 6 * time.Hour}
+
+			Expect(getHostPathProvisionerWarnings(cr)).To(ConsistOf(
+				"spec.certConfig: the certificate rotation policy is not applied to the HostPathProvisioner; HPP rotates its certificates with its own policy",
+			))
+		})
+
+		It("should not warn if HPP is not deployed", func() {
+			cr.Spec.FeatureGates.EnableHostPathProvisioner = nil
+			cr.Spec.CertConfig.Server.Duration = &metav1.Duration{Duration: 6 * time.Hour}
+
+			Expect(getHostPathProvisionerWarnings(cr)).To(BeEmpty())
+		})
+	})
+
+	It("should warn about the jsonpatch annotations", func() {
+		cr.Annotations = map[string]string{
+			common.JSONPatchKVAnnotationName:  validKvAnnotation,
+			common.JSONPatchSSPAnnotationName: validSspAnnotation,
+		}
+
+		Expect(getJSONPatchAnnotationWarnings(cr)).To(ConsistOf(
+			"the kubevirt.kubevirt.io/jsonpatch annotation is not supported, and may break upgrades; use spec.operandOverrides instead",
+			"the ssp.kubevirt.io/jsonpatch annotation is not supported, and may break upgrades; use spec.operandOverrides instead",
+		))
+	})
+
+	It("should warn about the operations of the jsonpatch annotations that do not modify the operand CR", func() {
+		cr.Annotations = map[string]string{
+			common.JSONPatchCDIAnnotationName: `[
+				{"op": "add", "path": "/spec/config/featureGates/-", "value": "fg1"},
+				{"op": "replace", "path": "/spec/uninstallStrategy", "value": "BlockUninstallIfWorkloadsExist"}
+			]`,
+		}
+
+		Expect(getJSONPatchAnnotationWarnings(cr)).To(ConsistOf(
+			"the containerizeddataimporter.kubevirt.io/jsonpatch annotation is not supported, and may break upgrades; use spec.operandOverrides instead",
+			"the containerizeddataimporter.kubevirt.io/jsonpatch annotation: operation 1 (replace /spec/uninstallStrategy) does not modify the operand CR",
+		))
+	})
+
+	Context("risky settings", func() {
+		It("should warn about allowPostCopy with allowAutoConverge", func() {
+			wh := newWebhookHandler()
+			cr.Spec.LiveMigrationConfig.AllowPostCopy = ptr.To(true)
+			cr.Spec.LiveMigrationConfig.AllowAutoConverge = ptr.To(true)
+
+			Expect(wh.getRiskySettingsWarnings(ctx, cr)).To(ConsistOf(ContainSubstring("spec.liveMigrationConfig: allowPostCopy and allowAutoConverge are both enabled")))
+
+			cr.Spec.LiveMigrationConfig.AllowAutoConverge = ptr.To(false)
+			Expect(wh.getRiskySettingsWarnings(ctx, cr)).To(BeEmpty())
+		})
+
+		It("should warn about evictionStrategy None on highly available infrastructure", func() {
+			wh := newWebhookHandler()
+			cr.Spec.EvictionStrategy = ptr.To(kubevirtcorev1.EvictionStrategyNone)
+
+			Expect(wh.getRiskySettingsWarnings(ctx, cr)).To(ConsistOf(ContainSubstring("spec.evictionStrategy: the VMs won't be migrated")))
+
+			hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+				return &commontestutils.ClusterInfoSNOMock{}
+			}
+			Expect(wh.getRiskySettingsWarnings(ctx, cr)).To(BeEmpty())
+		})
+
+		It("should warn about a TLS security profile that differs from the APIServer one", func() {
+			wh := newWebhookHandler()
+
+			cr.Spec.TLSSecurityProfile = &openshiftconfigv1.TLSSecurityProfile{
+				Type:         openshiftconfigv1.TLSProfileIntermediateType,
+				Intermediate: &openshiftconfigv1.IntermediateTLSProfile{},
+			}
+			Expect(wh.getRiskySettingsWarnings(ctx, cr)).To(BeEmpty())
+
+			cr.Spec.TLSSecurityProfile = &openshiftconfigv1.TLSSecurityProfile{
+				Type:   openshiftconfigv1.TLSProfileModernType,
+				Modern: &openshiftconfigv1.ModernTLSProfile{},
+			}
+			Expect(wh.getRiskySettingsWarnings(ctx, cr)).To(ConsistOf(
+				"spec.tlsSecurityProfile: the TLS security profile differs from the one of the cluster APIServer CR",
+			))
+		})
+
+		Context("memory overcommit", func() {
+			const overcommitWarning = "spec.higherWorkloadDensity.memoryOvercommitPercentage: memory is overcommitted, but swap is not configured on the nodes; the VMs may be killed when the node runs out of memory"
+
+			newKubeletConfig := func(swapBehavior string) *unstructured.Unstructured {
+				kubeletConfig := &unstructured.Unstructured{}
+				kubeletConfig.SetGroupVersionKind(kubeletConfigListGVK.GroupVersion().WithKind("KubeletConfig"))
+				kubeletConfig.SetName("swap-config")
+				Expect(unstructured.SetNestedField(kubeletConfig.Object, swapBehavior, "spec", "kubeletConfig", "memorySwap", "swapBehavior")).To(Succeed())
+				return kubeletConfig
+			}
+
+			BeforeEach(func() {
+				cr.Spec.HigherWorkloadDensity = &v1beta1.HigherWorkloadDensityConfiguration{MemoryOvercommitPercentage: 150}
+			})
+
+			It("should warn if swap is not configured", func() {
+				wh := newWebhookHandler()
+				Expect(wh.getRiskySettingsWarnings(ctx, cr)).To(ConsistOf(overcommitWarning))
+			})
+
+			It("should warn if swap is disabled", func() {
+				wh := newWebhookHandler(newKubeletConfig("NoSwap"))
+				Expect(wh.getRiskySettingsWarnings(ctx, cr)).To(ConsistOf(overcommitWarning))
+			})
+
+			It("should not warn if swap is configured", func() {
+				wh := newWebhookHandler(newKubeletConfig("LimitedSwap"))
+				Expect(wh.getRiskySettingsWarnings(ctx, cr)).To(BeEmpty())
+			})
+
+			It("should not warn if memory is not overcommitted", func() {
+				wh := newWebhookHandler()
+				cr.Spec.HigherWorkloadDensity.MemoryOvercommitPercentage = 100
+				Expect(wh.getRiskySettingsWarnings(ctx, cr)).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -81,6 +81,7 @@ var _ = Describe("Check Default values", Label("defaults"), Serial, func() {
 			AlignCPUs:                        ptr.To(false),
 			EnableApplicationAwareQuota:      ptr.To(false),
 			PrimaryUserDefinedNetworkBinding: ptr.To(false),
+			EnableHostPathProvisioner:        ptr.To(false),
 		}
 
 		DescribeTable("Check that featureGates defaults are behaving as expected", func(ctx context.Context, path string) {
@@ -108,6 +109,7 @@ var _ = Describe("Check Default values", Label("defaults"), Serial, func() {
 			Entry("when removing /spec/featureGates/autoResourceLimits", "/spec/featureGates/autoResourceLimits"),
 			Entry("when removing /spec/featureGates/alignCPUs", "/spec/featureGates/alignCPUs"),
 			Entry("when removing /spec/featureGates/primaryUserDefinedNetworkBinding", "/spec/featureGates/primaryUserDefinedNetworkBinding"),
+			Entry("when removing /spec/featureGates/enableHostPathProvisioner", "/spec/featureGates/enableHostPathProvisioner"),
 			Entry("when removing /spec/featureGates", "/spec/featureGates"),
 			Entry("when removing /spec", "/spec"),
 		)
@@ -118,7 +120,6 @@ var _ = Describe("Check Default values", Label("defaults"), Serial, func() {
 			AllowAutoConverge:                 ptr.To(false),
 			AllowPostCopy:                     ptr.To(false),
 			CompletionTimeoutPerGiB:           ptr.To(int64(800)),
-			ParallelMigrationsPerCluster:      ptr.To(uint32(5)),
 			ParallelOutboundMigrationsPerNode: ptr.To(uint32(2)),
 			ProgressTimeout:                   ptr.To(int64(150)),
 		}
@@ -137,7 +138,6 @@ var _ = Describe("Check Default values", Label("defaults"), Serial, func() {
 			Entry("when removing /spec/liveMigrationConfig/allowAutoConverge", "/spec/liveMigrationConfig/allowAutoConverge"),
 			Entry("when removing /spec/liveMigrationConfig/allowPostCopy", "/spec/liveMigrationConfig/allowPostCopy"),
 			Entry("when removing /spec/liveMigrationConfig/completionTimeoutPerGiB", "/spec/liveMigrationConfig/completionTimeoutPerGiB"),
-			Entry("when removing /spec/liveMigrationConfig/parallelMigrationsPerCluster", "/spec/liveMigrationConfig/parallelMigrationsPerCluster"),
 			Entry("when removing /spec/liveMigrationConfig/parallelOutboundMigrationsPerNode", "/spec/liveMigrationConfig/parallelOutboundMigrationsPerNode"),
 			Entry("when removing /spec/liveMigrationConfig/progressTimeout", "/spec/liveMigrationConfig/progressTimeout"),
 			Entry("when removing /spec/liveMigrationConfig", "/spec/liveMigrationConfig"),
@@ -145,33 +145,10 @@ var _ = Describe("Check Default values", Label("defaults"), Serial, func() {
 		)
 	})
 
-	Context("resourceRequirements defaults", func() {
-		defaultResourceRequirements := v1beta1.OperandResourceRequirements{
-			VmiCPUAllocationRatio: ptr.To(10),
-		}
-
-		DescribeTable("Check that resourceRequirements defaults are behaving as expected", func(ctx context.Context, path string) {
-			patch := []byte(fmt.Sprintf(removePathPatchTmplt, path))
-			Eventually(func(ctx context.Context) error {
-				return tests.PatchHCO(ctx, cli, patch)
-			}).WithTimeout(20 * time.Second).WithPolling(500 * time.Millisecond).WithContext(ctx).Should(Succeed())
-
-			Eventually(func(g Gomega, ctx context.Context) {
-				hc := tests.GetHCO(ctx, cli)
-				g.Expect(reflect.DeepEqual(hc.Spec.ResourceRequirements, &defaultResourceRequirements)).To(BeTrue(), "resourceRequirements should be equal to default")
-			}).WithTimeout(2 * time.Second).WithPolling(100 * time.Millisecond).WithContext(ctx).Should(Succeed())
-		},
-			Entry("when removing /spec/resourceRequirements/vmiCPUAllocationRatio", "/spec/resourceRequirements/vmiCPUAllocationRatio"),
-			Entry("when removing /spec/resourceRequirements", "/spec/resourceRequirements"),
-			Entry("when removing /spec", "/spec"),
-		)
-	})
-
 	Context("workloadUpdateStrategy defaults", func() {
 		defaultWorkloadUpdateStrategy := v1beta1.HyperConvergedWorkloadUpdateStrategy{
 			BatchEvictionInterval: &metav1.Duration{Duration: time.Minute},
 			BatchEvictionSize:     ptr.To(10),
-			WorkloadUpdateMethods: []string{"LiveMigrate"},
 		}
 
 		DescribeTable("Check that workloadUpdateStrategy defaults are behaving as expected", func(ctx context.Context, path string) {
@@ -187,7 +164,6 @@ var _ = Describe("Check Default values", Label("defaults"), Serial, func() {
 		},
 			Entry("when removing /spec/workloadUpdateStrategy/batchEvictionInterval", "/spec/workloadUpdateStrategy/batchEvictionInterval"),
 			Entry("when removing /spec/workloadUpdateStrategy/batchEvictionSize", "/spec/workloadUpdateStrategy/batchEvictionSize"),
-			Entry("when removing /spec/workloadUpdateStrategy/workloadUpdateMethods", "/spec/workloadUpdateStrategy/workloadUpdateMethods"),
 			Entry("when removing /spec/workloadUpdateStrategy", "/spec/workloadUpdateStrategy"),
 			Entry("when removing /spec", "/spec"),
 		)
@@ -16,19 +16,19 @@ import (
 var _ = Describe("Observability Controller", Label(tests.OpenshiftLabel, "observability_controller"), func() {
 	Context("PodDisruptionBudgetAtLimit", func() {
 		It("should be silenced", func() {
-			r := observability.NewReconciler(tests.GetClientConfig())
+			r := observability.NewReconciler(tests.GetClientConfig(), true)
 
 			amApi, err := r.NewAlertmanagerApi()
 			Expect(err).ToNot(HaveOccurred())
 
-			amSilences, err := amApi.ListSilences()
+			amSilences, err := amApi.ListSilences(context.Background())
 			Expect(err).ToNot(HaveOccurred())
 
 			// PodDisruptionBudgetAtLimit silence should have been created by the controller
 			podDisruptionBudgetAtLimitSilence := observability.FindPodDisruptionBudgetAtLimitSilence(amSilences)
 			Expect(podDisruptionBudgetAtLimitSilence).ToNot(BeNil())
 
-			err = amApi.DeleteSilence(podDisruptionBudgetAtLimitSilence.ID)
+			err = amApi.DeleteSilence(context.Background(), podDisruptionBudgetAtLimitSilence.ID)
 			Expect(err).ToNot(HaveOccurred())
 
 			// Restart pod to force reconcile (reconcile periodicity is 1h)
@@ -47,7 +47,7 @@ var _ = Describe("Observability Controller", Label(tests.OpenshiftLabel, "observ
 
 			// Wait for the controller to recreate the silence
 			Eventually(func() bool {
-				amSilences, err := amApi.ListSilences()
+				amSilences, err := amApi.ListSilences(context.Background())
 				Expect(err).ToNot(HaveOccurred())
 
 				return observability.FindPodDisruptionBudgetAtLimitSilence(amSilences) != nil
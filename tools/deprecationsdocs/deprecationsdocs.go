@@ -0,0 +1,33 @@
+package main
+
+import (
+	"os"
+	"text/template"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+const tpl = `# HyperConverged Deprecated Fields
+
+The following fields of the HyperConverged CR are deprecated. When a deprecated field is set and the field that
+replaces it is not, HCO copies the value of the deprecated field to the new field, both at admission and in the stored
+HyperConverged CR, and returns a warning.
+
+| Deprecated Field | Replaced By | Removed In |
+| ---------------- | ----------- | ---------- |
+{{- range . }}
+| ` + "`{{ .OldPath }}`" + ` | ` + "`{{ .NewPath }}`" + ` | {{ .RemovedIn }} |
+{{- end }}
+
+## Deprecating a field
+
+This document is auto-generated from the field migrations registry (` + "`common.FieldMigrations`" + `). To deprecate a
+field that has a replacement, add an entry to the registry, and regenerate this document.
+`
+
+func main() {
+	t := template.Must(template.New("deprecations").Parse(tpl))
+	if err := t.Execute(os.Stdout, common.FieldMigrations); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,145 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2026 Red Hat, Inc.
+ *
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/go-logr/logr"
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
+	csvv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// clusterFacts is a hcoutil.ClusterInfo that is built from the command line, instead of querying the cluster
+type clusterFacts struct {
+	openshift                     bool
+	controlPlaneHighlyAvailable   bool
+	infrastructureHighlyAvailable bool
+	singleStackIPv6               bool
+	domain                        string
+	baseDomain                    string
+	apiServerTLSSecurityProfile   *openshiftconfigv1.TLSSecurityProfile
+}
+
+var _ hcoutil.ClusterInfo = &clusterFacts{}
+
+func isHighlyAvailable(topology string) (bool, error) {
+	switch openshiftconfigv1.TopologyMode(topology) {
+	case openshiftconfigv1.HighlyAvailableTopologyMode:
+		return true, nil
+	case openshiftconfigv1.SingleReplicaTopologyMode:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unknown topology %q; must be one of %s, %s", topology,
+			openshiftconfigv1.HighlyAvailableTopologyMode, openshiftconfigv1.SingleReplicaTopologyMode)
+	}
+}
+
+func getTLSSecurityProfile(profileType string) (*openshiftconfigv1.TLSSecurityProfile, error) {
+	profile := &openshiftconfigv1.TLSSecurityProfile{Type: openshiftconfigv1.TLSProfileType(profileType)}
+	switch profile.Type {
+	case openshiftconfigv1.TLSProfileOldType:
+		profile.Old = &openshiftconfigv1.OldTLSProfile{}
+	case openshiftconfigv1.TLSProfileIntermediateType:
+		profile.Intermediate = &openshiftconfigv1.IntermediateTLSProfile{}
+	case openshiftconfigv1.TLSProfileModernType:
+		profile.Modern = &openshiftconfigv1.ModernTLSProfile{}
+	default:
+		return nil, fmt.Errorf("unknown TLS security profile %q; must be one of %s, %s, %s", profileType,
+			openshiftconfigv1.TLSProfileOldType, openshiftconfigv1.TLSProfileIntermediateType, openshiftconfigv1.TLSProfileModernType)
+	}
+	return profile, nil
+}
+
+func (c *clusterFacts) Init(_ context.Context, _ client.Client, _ logr.Logger) error {
+	return nil
+}
+
+func (c *clusterFacts) IsOpenshift() bool {
+	return c.openshift
+}
+
+func (c *clusterFacts) IsRunningLocally() bool {
+	return false
+}
+
+func (c *clusterFacts) GetDomain() string {
+	return c.domain
+}
+
+func (c *clusterFacts) GetBaseDomain() string {
+	return c.baseDomain
+}
+
+func (c *clusterFacts) IsManagedByOLM() bool {
+	return false
+}
+
+func (c *clusterFacts) IsControlPlaneHighlyAvailable() bool {
+	return c.controlPlaneHighlyAvailable
+}
+
+func (c *clusterFacts) IsInfrastructureHighlyAvailable() bool {
+	return c.infrastructureHighlyAvailable
+}
+
+// IsConsolePluginImageProvided uses the same environment variables as the operator, because the operands read the
+// images from them
+func (c *clusterFacts) IsConsolePluginImageProvided() bool {
+	return os.Getenv(hcoutil.KVUIPluginImageEnvV) != "" && os.Getenv(hcoutil.KVUIProxyImageEnvV) != ""
+}
+
+func (c *clusterFacts) IsMonitoringAvailable() bool {
+	return false
+}
+
+func (c *clusterFacts) IsSingleStackIPv6() bool {
+	return c.singleStackIPv6
+}
+
+func (c *clusterFacts) GetTLSSecurityProfile(hcoTLSSecurityProfile *openshiftconfigv1.TLSSecurityProfile) *openshiftconfigv1.TLSSecurityProfile {
+	if hcoTLSSecurityProfile != nil {
+		return hcoTLSSecurityProfile
+	}
+	return c.apiServerTLSSecurityProfile
+}
+
+func (c *clusterFacts) RefreshAPIServerCR(_ context.Context, _ client.Client) error {
+	return nil
+}
+
+func (c *clusterFacts) GetPod() *corev1.Pod {
+	return nil
+}
+
+func (c *clusterFacts) GetDeployment() *appsv1.Deployment {
+	return nil
+}
+
+func (c *clusterFacts) GetCSV() *csvv1alpha1.ClusterServiceVersion {
+	return nil
+}
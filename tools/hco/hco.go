@@ -0,0 +1,112 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2026 Red Hat, Inc.
+ *
+ */
+
+// hco is a command line tool to inspect what the HyperConverged Cluster Operator does for a given HyperConverged CR.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"go.uber.org/zap/zapcore"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	exitOK         = 0
+	exitDifference = 1
+	exitError      = 2
+)
+
+const usage = `Usage: hco <command> [flags]
+
+Commands:
+  render    print the objects HCO would create for a HyperConverged CR, or what it would change in the cluster
+
+Run 'hco <command> -h' for the flags of each command.
+`
+
+// command holds the dependencies of the commands, so they can be replaced in the tests
+type command struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+
+	// useClusterInfo makes the operands read the cluster facts from ci, and returns a function that restores the
+	// previous cluster info
+	useClusterInfo func(ci hcoutil.ClusterInfo) (restore func())
+}
+
+func main() {
+	logf.SetLogger(zap.New(zap.WriteTo(os.Stderr), zap.ConsoleEncoder(), zap.StacktraceLevel(zapcore.PanicLevel)))
+
+	cmd := &command{
+		stdin:          os.Stdin,
+		stdout:         os.Stdout,
+		stderr:         os.Stderr,
+		useClusterInfo: useGlobalClusterInfo,
+	}
+
+	os.Exit(cmd.run(os.Args[1:]))
+}
+
+func (c *command) run(args []string) int {
+	if len(args) < 1 {
+		fmt.Fprint(c.stderr, usage)
+		return exitError
+	}
+
+	var (
+		exitCode int
+		err      error
+	)
+
+	switch args[0] {
+	case "render":
+		exitCode, err = c.runRender(args[1:])
+	case "-h", "--help", "help":
+		fmt.Fprint(c.stdout, usage)
+	default:
+		fmt.Fprintf(c.stderr, "unknown command %q\n\n%s", args[0], usage)
+		exitCode = exitError
+	}
+
+	if err != nil {
+		fmt.Fprintln(c.stderr, "Error:", err)
+		exitCode = exitError
+	}
+
+	return exitCode
+}
+
+// useGlobalClusterInfo sets ci as the cluster info of the operands, that read it from hcoutil.GetClusterInfo
+func useGlobalClusterInfo(ci hcoutil.ClusterInfo) func() {
+	orig := hcoutil.GetClusterInfo
+	hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+		return ci
+	}
+
+	return func() {
+		hcoutil.GetClusterInfo = orig
+	}
+}
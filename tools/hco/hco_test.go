@@ -0,0 +1,192 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2026 Red Hat, Inc.
+ *
+ */
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const hcManifest = `apiVersion: hco.kubevirt.io/v1beta1
+kind: HyperConverged
+metadata:
+  name: kubevirt-hyperconverged
+`
+
+var _ = Describe("hco tool", func() {
+	var (
+		stdout, stderr *bytes.Buffer
+		clusterInfo    hcoutil.ClusterInfo
+		cmd            *command
+	)
+
+	BeforeEach(func() {
+		stdout, stderr = &bytes.Buffer{}, &bytes.Buffer{}
+		clusterInfo = nil
+		cmd = &command{
+			stdin:  strings.NewReader(hcManifest),
+			stdout: stdout,
+			stderr: stderr,
+			useClusterInfo: func(ci hcoutil.ClusterInfo) func() {
+				clusterInfo = ci
+				return useGlobalClusterInfo(ci)
+			},
+		}
+	})
+
+	Context("commands", func() {
+		It("should print the usage without a command", func() {
+			Expect(cmd.run(nil)).To(Equal(exitError))
+			Expect(stderr.String()).To(Equal(usage))
+		})
+
+		It("should print the usage on help", func() {
+			Expect(cmd.run([]string{"help"})).To(Equal(exitOK))
+			Expect(stdout.String()).To(Equal(usage))
+		})
+
+		It("should fail on an unknown command", func() {
+			Expect(cmd.run([]string{"apply"})).To(Equal(exitError))
+			Expect(stderr.String()).To(HavePrefix(`unknown command "apply"`))
+		})
+	})
+
+	Context("render", func() {
+		It("should fail without the --hc flag", func() {
+			Expect(cmd.run([]string{"render"})).To(Equal(exitError))
+			Expect(stderr.String()).To(ContainSubstring("missing --hc flag"))
+		})
+
+		It("should fail on an unknown flag", func() {
+			Expect(cmd.run([]string{"render", "--hc", "-", "--unknown"})).To(Equal(exitError))
+			Expect(stderr.String()).To(ContainSubstring("flag provided but not defined: -unknown"))
+		})
+
+		It("should print the flags on -h", func() {
+			Expect(cmd.run([]string{"render", "-h"})).To(Equal(exitOK))
+			Expect(stderr.String()).To(HavePrefix(renderUsage))
+			Expect(stderr.String()).To(ContainSubstring("-infrastructure-topology"))
+		})
+
+		DescribeTable("should fail on invalid cluster facts", func(flag, value, message string) {
+			Expect(cmd.run([]string{"render", "--hc", "-", flag, value})).To(Equal(exitError))
+			Expect(stderr.String()).To(ContainSubstring(message))
+			Expect(clusterInfo).To(BeNil())
+		},
+			Entry("control plane topology", "--control-plane-topology", "External", `unknown topology "External"`),
+			Entry("infrastructure topology", "--infrastructure-topology", "External", `unknown topology "External"`),
+			Entry("TLS profile", "--tls-profile", "Custom", `unknown TLS security profile "Custom"`),
+		)
+
+		It("should fail if the manifest is not a HyperConverged CR", func() {
+			cmd.stdin = strings.NewReader("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm\n")
+			Expect(cmd.run([]string{"render", "--hc", "-"})).To(Equal(exitError))
+			Expect(stderr.String()).To(ContainSubstring("ConfigMap is not a HyperConverged CR"))
+		})
+
+		It("should render the objects for the cluster facts of the command line", func() {
+			origClusterInfo := hcoutil.GetClusterInfo
+
+			Expect(cmd.run([]string{"render", "--hc", "-", "--infrastructure-topology", "SingleReplica", "--tls-profile", "Modern"})).To(Equal(exitOK))
+			Expect(stderr.String()).To(BeEmpty())
+
+			Expect(clusterInfo).ToNot(BeNil())
+			Expect(clusterInfo.IsOpenshift()).To(BeFalse())
+			Expect(clusterInfo.IsInfrastructureHighlyAvailable()).To(BeFalse())
+			Expect(clusterInfo.GetTLSSecurityProfile(nil).Modern).ToNot(BeNil())
+
+			Expect(stdout.String()).To(ContainSubstring("kind: KubeVirt\n"))
+			Expect(stdout.String()).To(ContainSubstring("kind: CDI\n"))
+			Expect(stdout.String()).To(ContainSubstring("namespace: kubevirt-hyperconverged\n"))
+
+			By("restoring the cluster info")
+			Expect(hcoutil.GetClusterInfo()).To(BeIdenticalTo(origClusterInfo()))
+		})
+
+		It("should read the HyperConverged CR from a file", func() {
+			hcFile := filepath.Join(GinkgoT().TempDir(), "hc.yaml")
+			Expect(os.WriteFile(hcFile, []byte(hcManifest), 0600)).To(Succeed())
+			cmd.stdin = strings.NewReader("")
+
+			Expect(cmd.run([]string{"render", "--hc", hcFile, "--namespace", "my-namespace"})).To(Equal(exitOK))
+			Expect(stdout.String()).To(ContainSubstring("kind: KubeVirt\n"))
+			Expect(stdout.String()).To(ContainSubstring("namespace: my-namespace\n"))
+		})
+
+		It("should read a v1 HyperConverged CR", func() {
+			cmd.stdin = strings.NewReader(strings.Replace(hcManifest, "hco.kubevirt.io/v1beta1", "hco.kubevirt.io/v1", 1))
+
+			Expect(cmd.run([]string{"render", "--hc", "-"})).To(Equal(exitOK))
+			Expect(stdout.String()).To(ContainSubstring("kind: KubeVirt\n"))
+		})
+
+		Context("--diff", func() {
+			var liveDir string
+
+			BeforeEach(func() {
+				liveDir = GinkgoT().TempDir()
+			})
+
+			render := func() string {
+				out := &bytes.Buffer{}
+				renderCmd := *cmd
+				renderCmd.stdin = strings.NewReader(hcManifest)
+				renderCmd.stdout = out
+				ExpectWithOffset(1, renderCmd.run([]string{"render", "--hc", "-"})).To(Equal(exitOK))
+				return out.String()
+			}
+
+			It("should report the objects to create", func() {
+				Expect(cmd.run([]string{"render", "--hc", "-", "--diff", liveDir})).To(Equal(exitDifference))
+				Expect(stdout.String()).To(ContainSubstring("+ KubeVirt kubevirt-hyperconverged/kubevirt-kubevirt-hyperconverged (create)\n"))
+				Expect(stdout.String()).To(MatchRegexp(`\d+ to create, 0 to update, 0 unchanged\n$`))
+			})
+
+			It("should not report any difference, if the live objects are the rendered ones", func() {
+				Expect(os.WriteFile(filepath.Join(liveDir, "live.yaml"), []byte(render()), 0600)).To(Succeed())
+
+				Expect(cmd.run([]string{"render", "--hc", "-", "--diff", liveDir})).To(Equal(exitOK))
+				Expect(stdout.String()).To(MatchRegexp(`^0 to create, 0 to update, \d+ unchanged\n$`))
+			})
+
+			It("should report the changed fields of the live objects", func() {
+				live := strings.Replace(render(), "uninstallStrategy: BlockUninstallIfWorkloadsExist", "uninstallStrategy: RemoveWorkloads", 1)
+				Expect(os.WriteFile(filepath.Join(liveDir, "live.yaml"), []byte(live), 0600)).To(Succeed())
+
+				Expect(cmd.run([]string{"render", "--hc", "-", "--diff", liveDir})).To(Equal(exitDifference))
+				Expect(stdout.String()).To(ContainSubstring("~ KubeVirt kubevirt-hyperconverged/kubevirt-kubevirt-hyperconverged (update)\n"))
+				Expect(stdout.String()).To(ContainSubstring(`uninstallStrategy: "RemoveWorkloads" -> "BlockUninstallIfWorkloadsExist"`))
+				Expect(stdout.String()).To(MatchRegexp(`^~ .*\n.*\n0 to create, 1 to update, \d+ unchanged\n$`))
+			})
+
+			It("should fail if the live objects can't be read", func() {
+				Expect(cmd.run([]string{"render", "--hc", "-", "--diff", filepath.Join(liveDir, "missing")})).To(Equal(exitError))
+				Expect(stderr.String()).To(ContainSubstring("no such file or directory"))
+			})
+		})
+	})
+})
@@ -0,0 +1,416 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2026 Red Hat, Inc.
+ *
+ */
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	consolev1 "github.com/openshift/api/console/v1"
+	imagev1 "github.com/openshift/api/image/v1"
+	openshiftroutev1 "github.com/openshift/api/route/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	aaqv1alpha1 "kubevirt.io/application-aware-quota/staging/src/kubevirt.io/application-aware-quota-api/pkg/apis/core/v1alpha1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	sspv1beta2 "kubevirt.io/ssp-operator/api/v1beta2"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api"
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+)
+
+const renderUsage = `Usage: hco render --hc <file> [flags]
+
+Prints all the objects that HCO would create for the HyperConverged CR in the given file, for a cluster with the
+given facts. With --diff, prints what HCO would change in the live objects instead.
+
+The operand images and versions are read from the same environment variables as the operator, e.g.
+VIRTIOWIN_CONTAINER, KV_CONSOLE_PLUGIN_IMAGE and KV_CONSOLE_PROXY_IMAGE.
+
+Exit status is 0 if there are no differences, 1 if --diff found differences and 2 on error.
+
+Flags:
+`
+
+var renderSchemeFuncs = []func(*runtime.Scheme) error{
+	api.AddToScheme,
+	schedulingv1.AddToScheme,
+	corev1.AddToScheme,
+	appsv1.AddToScheme,
+	rbacv1.AddToScheme,
+	cdiv1beta1.AddToScheme,
+	networkaddonsv1.AddToScheme,
+	sspv1beta2.AddToScheme,
+	consolev1.Install,
+	openshiftroutev1.Install,
+	kubevirtcorev1.AddToScheme,
+	imagev1.Install,
+	aaqv1alpha1.AddToScheme,
+}
+
+type renderOptions struct {
+	hcFile                 string
+	namespace              string
+	diff                   string
+	openshift              bool
+	controlPlaneTopology   string
+	infrastructureTopology string
+	tlsProfile             string
+	singleStackIPv6        bool
+	domain                 string
+	baseDomain             string
+}
+
+func (c *command) runRender(args []string) (int, error) {
+	opts := renderOptions{}
+
+	flags := flag.NewFlagSet("render", flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), renderUsage)
+		flags.PrintDefaults()
+	}
+	flags.StringVar(&opts.hcFile, "hc", "", "the HyperConverged manifest file (v1 or v1beta1); use - to read it from the standard input")
+	flags.StringVar(&opts.namespace, "namespace", "kubevirt-hyperconverged", "the namespace of the HyperConverged CR, if it is not set in the manifest")
+	flags.StringVar(&opts.diff, "diff", "", "a kubeconfig file, or a directory of live object manifests, to compare the rendered objects with")
+	flags.BoolVar(&opts.openshift, "openshift", false, "render for an OpenShift cluster")
+	flags.StringVar(&opts.controlPlaneTopology, "control-plane-topology", "HighlyAvailable", "the control plane topology: HighlyAvailable or SingleReplica")
+	flags.StringVar(&opts.infrastructureTopology, "infrastructure-topology", "HighlyAvailable", "the infrastructure topology: HighlyAvailable or SingleReplica")
+	flags.StringVar(&opts.tlsProfile, "tls-profile", "Intermediate", "the TLS security profile of the cluster APIServer: Old, Intermediate or Modern")
+	flags.BoolVar(&opts.singleStackIPv6, "single-stack-ipv6", false, "render for a single stack IPv6 cluster")
+	flags.StringVar(&opts.domain, "domain", "apps.example.com", "the ingress domain of the cluster (OpenShift only)")
+	flags.StringVar(&opts.baseDomain, "base-domain", "example.com", "the base domain of the cluster (OpenShift only)")
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK, nil
+		}
+		return exitError, nil
+	}
+
+	if opts.hcFile == "" {
+		return exitError, errors.New("missing --hc flag")
+	}
+
+	ci, err := opts.clusterFacts()
+	if err != nil {
+		return exitError, err
+	}
+	defer c.useClusterInfo(ci)()
+
+	scheme := runtime.NewScheme()
+	for _, f := range renderSchemeFuncs {
+		if err = f(scheme); err != nil {
+			return exitError, err
+		}
+	}
+
+	hc, err := readHyperConverged(opts.hcFile, c.stdin, opts.namespace, scheme)
+	if err != nil {
+		return exitError, err
+	}
+
+	objects, err := operands.Render(hc, scheme, ci)
+	if err != nil {
+		return exitError, err
+	}
+
+	if opts.diff == "" {
+		return exitOK, printObjects(c.stdout, objects)
+	}
+
+	live, err := getLiveObjects(opts.diff, scheme)
+	if err != nil {
+		return exitError, err
+	}
+
+	return printDiff(c.stdout, objects, live)
+}
+
+func (opts renderOptions) clusterFacts() (*clusterFacts, error) {
+	controlPlaneHA, err := isHighlyAvailable(opts.controlPlaneTopology)
+	if err != nil {
+		return nil, err
+	}
+
+	infrastructureHA, err := isHighlyAvailable(opts.infrastructureTopology)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsProfile, err := getTLSSecurityProfile(opts.tlsProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clusterFacts{
+		openshift:                     opts.openshift,
+		controlPlaneHighlyAvailable:   controlPlaneHA,
+		infrastructureHighlyAvailable: infrastructureHA,
+		singleStackIPv6:               opts.singleStackIPv6,
+		domain:                        opts.domain,
+		baseDomain:                    opts.baseDomain,
+		apiServerTLSSecurityProfile:   tlsProfile,
+	}, nil
+}
+
+// readHyperConverged reads the HyperConverged CR from the file, or from stdin if the file is "-", converts it to
+// v1beta1 if needed, and sets the default values, as the API server would do
+func readHyperConverged(fileName string, stdin io.Reader, namespace string, scheme *runtime.Scheme) (*hcov1beta1.HyperConverged, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if fileName == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(fileName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	obj, _, err := serializer.NewCodecFactory(scheme).UniversalDeserializer().Decode(data, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the HyperConverged CR: %w", err)
+	}
+
+	hc := &hcov1beta1.HyperConverged{}
+	switch in := obj.(type) {
+	case *hcov1beta1.HyperConverged:
+		hc = in
+	case *hcov1.HyperConverged:
+		hcov1.SetObjectDefaults_HyperConverged(in)
+		if err = in.ConvertTo(hc); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%s is not a HyperConverged CR", obj.GetObjectKind().GroupVersionKind().Kind)
+	}
+
+	hcov1beta1.SetObjectDefaults_HyperConverged(hc)
+	if hc.Namespace == "" {
+		hc.Namespace = namespace
+	}
+
+	return hc, nil
+}
+
+func printObjects(out io.Writer, objects []operands.RenderedObject) error {
+	for _, obj := range objects {
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return err
+		}
+
+		if _, err = fmt.Fprintf(out, "---\n%s", data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// liveObjects returns the live version of a rendered object, or nil if it does not exist
+type liveObjects func(obj *unstructured.Unstructured) (*unstructured.Unstructured, error)
+
+func getLiveObjects(source string, scheme *runtime.Scheme) (liveObjects, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir() {
+		return readLiveObjects(source)
+	}
+
+	return getClusterObjects(source, scheme)
+}
+
+func getClusterObjects(kubeconfig string, scheme *runtime.Scheme) (liveObjects, error) {
+	cfg, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	cl, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, err
+	}
+
+	return func(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(obj.GroupVersionKind())
+		err := cl.Get(context.Background(), client.ObjectKeyFromObject(obj), live)
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return live, err
+	}, nil
+}
+
+type liveObjectKey struct {
+	groupKind schema.GroupKind
+	client.ObjectKey
+}
+
+func getLiveObjectKey(obj *unstructured.Unstructured) liveObjectKey {
+	return liveObjectKey{groupKind: obj.GroupVersionKind().GroupKind(), ObjectKey: client.ObjectKeyFromObject(obj)}
+}
+
+// readLiveObjects reads all the YAML and JSON files in the directory, e.g. the output of
+// "kubectl get -o yaml". Lists are expanded to their items.
+func readLiveObjects(dir string) (liveObjects, error) {
+	objects := make(map[liveObjectKey]*unstructured.Unstructured)
+
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".yaml", ".yml", ".json":
+		default:
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+		for {
+			var raw json.RawMessage
+			if err = decoder.Decode(&raw); err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return fmt.Errorf("failed to read %s: %w", path, err)
+			}
+			if len(bytes.TrimSpace(raw)) == 0 || bytes.Equal(raw, []byte("null")) {
+				continue
+			}
+
+			// use the unstructured decoder, to read the numbers as int64, like the rendered objects
+			obj := &unstructured.Unstructured{}
+			if err = obj.UnmarshalJSON(raw); err != nil {
+				return fmt.Errorf("failed to read %s: %w", path, err)
+			}
+
+			if !obj.IsList() {
+				objects[getLiveObjectKey(obj)] = obj
+				continue
+			}
+
+			if err = obj.EachListItem(func(item runtime.Object) error {
+				u := item.(*unstructured.Unstructured)
+				objects[getLiveObjectKey(u)] = u
+				return nil
+			}); err != nil {
+				return fmt.Errorf("failed to read %s: %w", path, err)
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return func(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+		return objects[getLiveObjectKey(obj)], nil
+	}, nil
+}
+
+func printDiff(out io.Writer, objects []operands.RenderedObject, getLive liveObjects) (int, error) {
+	created, updated, unchanged := 0, 0, 0
+
+	for _, obj := range objects {
+		live, err := getLive(obj.Unstructured)
+		if err != nil {
+			return exitError, err
+		}
+
+		name := obj.GetName()
+		if ns := obj.GetNamespace(); ns != "" {
+			name = ns + "/" + name
+		}
+
+		if live == nil {
+			created++
+			fmt.Fprintf(out, "+ %s %s (create)\n", obj.GetKind(), name)
+			continue
+		}
+
+		changes, err := obj.Diff(live)
+		if err != nil {
+			return exitError, err
+		}
+		if len(changes) == 0 {
+			unchanged++
+			continue
+		}
+
+		updated++
+		fmt.Fprintf(out, "~ %s %s (update)\n", obj.GetKind(), name)
+		for _, change := range changes {
+			fmt.Fprintf(out, "    %s: %s -> %s\n", change.Path, formatValue(change.Live, "<unset>"), formatValue(change.Rendered, "<removed>"))
+		}
+	}
+
+	fmt.Fprintf(out, "%d to create, %d to update, %d unchanged\n", created, updated, unchanged)
+
+	if created+updated > 0 {
+		return exitDifference, nil
+	}
+	return exitOK, nil
+}
+
+func formatValue(value any, missing string) string {
+	if value == nil {
+		return missing
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
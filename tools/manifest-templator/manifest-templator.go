@@ -123,6 +123,7 @@ func main() {
 			*webhookImage,
 			"IfNotPresent",
 			*hcoKvIoVersion,
+			operatorParams.Machinetype,
 			[]corev1.EnvVar{},
 		),
 		components.GetDeploymentCliDownloads(operatorParams),